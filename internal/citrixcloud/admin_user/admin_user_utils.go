@@ -12,6 +12,7 @@ import (
 
 	ccadmins "github.com/citrix/citrix-daas-rest-go/ccadmins"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -260,7 +261,7 @@ func getAccessPolicies(ctx context.Context, client *citrixdaasclient.CitrixDaasC
 }
 
 func getAdminIdFromAuthToken(client *citrixdaasclient.CitrixDaasClient) (string, error) {
-	authToken, _, err := client.SignIn()
+	authToken, _, err := middleware.SignIn(context.Background(), client)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign in: %w", err)
 	}
@@ -0,0 +1,70 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package admin_role
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &adminRoleListResource{}
+	_ list.ListResourceWithConfigure = &adminRoleListResource{}
+)
+
+// NewAdminRoleListResource is a helper function to simplify the provider implementation.
+func NewAdminRoleListResource() list.ListResource {
+	return &adminRoleListResource{}
+}
+
+// adminRoleListResource is the list resource implementation.
+type adminRoleListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *adminRoleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_role"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *adminRoleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("admin roles")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *adminRoleListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the admin roles of the site.
+func (r *adminRoleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	adminRoles, _ := util.GetAdminRoles(ctx, r.client, &diags)
+	items := []util.ListResourceItem{}
+	for _, adminRole := range adminRoles {
+		if adminRole.GetIsBuiltIn() {
+			// Built-in roles are not managed by Terraform
+			continue
+		}
+		items = append(items, util.ListResourceItem{Id: adminRole.GetId(), DisplayName: adminRole.GetName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewAdminRoleResource, r.client)
+}
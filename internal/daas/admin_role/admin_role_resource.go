@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                   = &adminRoleResource{}
 	_ resource.ResourceWithConfigure      = &adminRoleResource{}
 	_ resource.ResourceWithImportState    = &adminRoleResource{}
+	_ resource.ResourceWithIdentity       = &adminRoleResource{}
 	_ resource.ResourceWithValidateConfig = &adminRoleResource{}
 	_ resource.ResourceWithModifyPlan     = &adminRoleResource{}
 )
@@ -45,6 +46,11 @@ func (r *adminRoleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = AdminRoleModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *adminRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *adminRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -117,6 +123,7 @@ func (r *adminRoleResource) Create(ctx context.Context, req resource.CreateReque
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -125,6 +132,7 @@ func (r *adminRoleResource) Create(ctx context.Context, req resource.CreateReque
 // Read refreshes the Terraform state with the latest data.
 func (r *adminRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state AdminRoleModel
@@ -161,6 +169,7 @@ func (r *adminRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *adminRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan AdminRoleModel
@@ -239,7 +248,7 @@ func (r *adminRoleResource) Delete(ctx context.Context, req resource.DeleteReque
 
 func (r *adminRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func getAdminRole(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, adminRoleName string) (*citrixorchestration.RoleResponseModel, error) {
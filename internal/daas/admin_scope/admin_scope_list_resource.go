@@ -0,0 +1,70 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package admin_scope
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &adminScopeListResource{}
+	_ list.ListResourceWithConfigure = &adminScopeListResource{}
+)
+
+// NewAdminScopeListResource is a helper function to simplify the provider implementation.
+func NewAdminScopeListResource() list.ListResource {
+	return &adminScopeListResource{}
+}
+
+// adminScopeListResource is the list resource implementation.
+type adminScopeListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *adminScopeListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_scope"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *adminScopeListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("admin scopes")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *adminScopeListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the admin scopes of the site.
+func (r *adminScopeListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	adminScopes, _, _ := util.FetchScopes(ctx, r.client, &diags)
+	items := []util.ListResourceItem{}
+	for _, adminScope := range adminScopes {
+		if adminScope.GetIsBuiltIn() {
+			// Built-in scopes are not managed by Terraform
+			continue
+		}
+		items = append(items, util.ListResourceItem{Id: adminScope.GetId(), DisplayName: adminScope.GetName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewAdminScopeResource, r.client)
+}
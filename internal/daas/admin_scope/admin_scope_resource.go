@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                   = &adminScopeResource{}
 	_ resource.ResourceWithConfigure      = &adminScopeResource{}
 	_ resource.ResourceWithImportState    = &adminScopeResource{}
+	_ resource.ResourceWithIdentity       = &adminScopeResource{}
 	_ resource.ResourceWithValidateConfig = &adminScopeResource{}
 	_ resource.ResourceWithModifyPlan     = &adminScopeResource{}
 )
@@ -45,6 +46,11 @@ func (r *adminScopeResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = AdminScopeModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *adminScopeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *adminScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -103,6 +109,7 @@ func (r *adminScopeResource) Create(ctx context.Context, req resource.CreateRequ
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -111,6 +118,7 @@ func (r *adminScopeResource) Create(ctx context.Context, req resource.CreateRequ
 // Read refreshes the Terraform state with the latest data.
 func (r *adminScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state AdminScopeModel
@@ -139,6 +147,7 @@ func (r *adminScopeResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *adminScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan AdminScopeModel
@@ -226,7 +235,7 @@ func (r *adminScopeResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *adminScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func getAdminScope(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, adminScopeName string) (*citrixorchestration.ScopeResponseModel, error) {
@@ -0,0 +1,66 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &applicationGroupListResource{}
+	_ list.ListResourceWithConfigure = &applicationGroupListResource{}
+)
+
+// NewApplicationGroupListResource is a helper function to simplify the provider implementation.
+func NewApplicationGroupListResource() list.ListResource {
+	return &applicationGroupListResource{}
+}
+
+// applicationGroupListResource is the list resource implementation.
+type applicationGroupListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *applicationGroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_group"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *applicationGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("application groups")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *applicationGroupListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the application groups of the site.
+func (r *applicationGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	applicationGroups, _ := util.GetApplicationGroups(ctx, r.client, &diags, "Id,Name,FullName")
+	items := []util.ListResourceItem{}
+	for _, applicationGroup := range applicationGroups {
+		items = append(items, util.ListResourceItem{Id: applicationGroup.GetId(), DisplayName: applicationGroup.GetFullName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewApplicationGroupResource, r.client)
+}
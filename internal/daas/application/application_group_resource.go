@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                   = &applicationGroupResource{}
 	_ resource.ResourceWithConfigure      = &applicationGroupResource{}
 	_ resource.ResourceWithImportState    = &applicationGroupResource{}
+	_ resource.ResourceWithIdentity       = &applicationGroupResource{}
 	_ resource.ResourceWithValidateConfig = &applicationGroupResource{}
 	_ resource.ResourceWithModifyPlan     = &applicationGroupResource{}
 )
@@ -56,6 +57,11 @@ func (r *applicationGroupResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = ApplicationGroupResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *applicationGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *applicationGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
@@ -164,6 +170,7 @@ func (r *applicationGroupResource) Create(ctx context.Context, req resource.Crea
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -172,6 +179,7 @@ func (r *applicationGroupResource) Create(ctx context.Context, req resource.Crea
 // Read refreshes the Terraform state with the latest data.
 func (r *applicationGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state ApplicationGroupResourceModel
@@ -208,6 +216,7 @@ func (r *applicationGroupResource) Read(ctx context.Context, req resource.ReadRe
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *applicationGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan ApplicationGroupResourceModel
@@ -343,7 +352,7 @@ func (r *applicationGroupResource) Delete(ctx context.Context, req resource.Dele
 
 func (r *applicationGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func readApplicationGroup(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.ReadResponse, applicationGroupId string) (*citrixorchestration.ApplicationGroupDetailResponseModel, error) {
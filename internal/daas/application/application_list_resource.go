@@ -0,0 +1,66 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &applicationListResource{}
+	_ list.ListResourceWithConfigure = &applicationListResource{}
+)
+
+// NewApplicationListResource is a helper function to simplify the provider implementation.
+func NewApplicationListResource() list.ListResource {
+	return &applicationListResource{}
+}
+
+// applicationListResource is the list resource implementation.
+type applicationListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *applicationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *applicationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("applications")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *applicationListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the applications of the site.
+func (r *applicationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	applications, _ := util.GetApplications(ctx, r.client, &diags, "Id,Name")
+	items := []util.ListResourceItem{}
+	for _, application := range applications {
+		items = append(items, util.ListResourceItem{Id: application.GetId(), DisplayName: application.GetName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewApplicationResource, r.client)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                   = &applicationResource{}
 	_ resource.ResourceWithConfigure      = &applicationResource{}
 	_ resource.ResourceWithImportState    = &applicationResource{}
+	_ resource.ResourceWithIdentity       = &applicationResource{}
 	_ resource.ResourceWithValidateConfig = &applicationResource{}
 	_ resource.ResourceWithModifyPlan     = &applicationResource{}
 )
@@ -57,6 +59,11 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = ApplicationResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *applicationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *applicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
@@ -70,17 +77,33 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Generate API request body from plan
-	var createInstalledAppRequest citrixorchestration.CreateInstalledAppRequestModel
-	var installedAppProperties = util.ObjectValueToTypedObject[InstalledAppResponseModel](ctx, &resp.Diagnostics, plan.InstalledAppProperties)
-	createInstalledAppRequest.SetCommandLineArguments(installedAppProperties.CommandLineArguments.ValueString())
-	createInstalledAppRequest.SetCommandLineExecutable(installedAppProperties.CommandLineExecutable.ValueString())
-	createInstalledAppRequest.SetWorkingDirectory(installedAppProperties.WorkingDirectory.ValueString())
-
 	var createApplicationRequest citrixorchestration.CreateApplicationRequestModel
 	createApplicationRequest.SetName(plan.Name.ValueString())
 	createApplicationRequest.SetDescription(plan.Description.ValueString())
 	createApplicationRequest.SetPublishedName(plan.PublishedName.ValueString())
-	createApplicationRequest.SetInstalledAppProperties(createInstalledAppRequest)
+
+	applicationType := citrixorchestration.ApplicationType(plan.ApplicationType.ValueString())
+	createApplicationRequest.SetApplicationType(applicationType)
+	switch applicationType {
+	case citrixorchestration.APPLICATIONTYPE_PUBLISHED_CONTENT:
+		publishedContentProperties := util.ObjectValueToTypedObject[PublishedContentPropertiesModel](ctx, &resp.Diagnostics, plan.PublishedContentProperties)
+		createApplicationRequest.SetContentLocation(publishedContentProperties.ContentLocation.ValueString())
+	case citrixorchestration.APPLICATIONTYPE_APP_V:
+		appPackageProperties := util.ObjectValueToTypedObject[AppPackagePropertiesModel](ctx, &resp.Diagnostics, plan.AppPackageProperties)
+		appVAppRequest, err := getAppVAppRequestModel(ctx, r.client, &resp.Diagnostics, appPackageProperties)
+		if err != nil {
+			return
+		}
+		createApplicationRequest.SetAppVAppProperties(appVAppRequest)
+	default:
+		var createInstalledAppRequest citrixorchestration.CreateInstalledAppRequestModel
+		var installedAppProperties = util.ObjectValueToTypedObject[InstalledAppResponseModel](ctx, &resp.Diagnostics, plan.InstalledAppProperties)
+		createInstalledAppRequest.SetCommandLineArguments(installedAppProperties.CommandLineArguments.ValueString())
+		createInstalledAppRequest.SetCommandLineExecutable(installedAppProperties.CommandLineExecutable.ValueString())
+		createInstalledAppRequest.SetWorkingDirectory(installedAppProperties.WorkingDirectory.ValueString())
+		createApplicationRequest.SetInstalledAppProperties(createInstalledAppRequest)
+	}
+
 	createApplicationRequest.SetApplicationFolder(plan.ApplicationFolderPath.ValueString())
 	createApplicationRequest.SetIcon(plan.Icon.ValueString())
 	createApplicationRequest.SetClientFolder(plan.ApplicationCategoryPath.ValueString())
@@ -188,14 +211,20 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	fileTypeAssociations, err := updateApplicationFileTypeAssociations(ctx, r.client, &resp.Diagnostics, application.GetId(), plan.FileTypeAssociations, util.TypedArrayToObjectList[FileTypeAssociationModel](ctx, &resp.Diagnostics, nil))
+	if err != nil {
+		return
+	}
+
 	tags := getApplicationTags(ctx, &resp.Diagnostics, r.client, applicationPath)
 
 	// Map response body to schema and populate Computed attribute values
-	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, application, getApplicationGroups, applicationDeliveryGroups, tags)
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, application, getApplicationGroups, applicationDeliveryGroups, fileTypeAssociations, tags)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -204,6 +233,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 // Read refreshes the Terraform state with the latest data.
 func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state ApplicationResourceModel
@@ -227,9 +257,17 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	var fileTypeAssociations []citrixorchestration.FtaResponseModel
+	if !state.FileTypeAssociations.IsNull() {
+		fileTypeAssociations, err = getApplicationFileTypeAssociations(ctx, r.client, &resp.Diagnostics, state.Id.ValueString())
+		if err != nil {
+			return
+		}
+	}
+
 	tags := getApplicationTags(ctx, &resp.Diagnostics, r.client, state.Id.ValueString())
 
-	state = state.RefreshPropertyValues(ctx, &resp.Diagnostics, application, applicationGroups, applicationDeliveryGroups, tags)
+	state = state.RefreshPropertyValues(ctx, &resp.Diagnostics, application, applicationGroups, applicationDeliveryGroups, fileTypeAssociations, tags)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -242,6 +280,7 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *applicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan ApplicationResourceModel
@@ -323,13 +362,25 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	applicationGroups := util.StringListToStringArray(ctx, &resp.Diagnostics, plan.ApplicationGroups)
 	editApplicationRequestBody.SetApplicationGroups(applicationGroups)
 
-	var editInstalledAppRequest citrixorchestration.EditInstalledAppRequestModel
-	var installedAppProperties = util.ObjectValueToTypedObject[InstalledAppResponseModel](ctx, &resp.Diagnostics, plan.InstalledAppProperties)
-	editInstalledAppRequest.SetCommandLineArguments(installedAppProperties.CommandLineArguments.ValueString())
-	editInstalledAppRequest.SetCommandLineExecutable(installedAppProperties.CommandLineExecutable.ValueString())
-	editInstalledAppRequest.SetWorkingDirectory(installedAppProperties.WorkingDirectory.ValueString())
-
-	editApplicationRequestBody.SetInstalledAppProperties(editInstalledAppRequest)
+	switch citrixorchestration.ApplicationType(plan.ApplicationType.ValueString()) {
+	case citrixorchestration.APPLICATIONTYPE_PUBLISHED_CONTENT:
+		publishedContentProperties := util.ObjectValueToTypedObject[PublishedContentPropertiesModel](ctx, &resp.Diagnostics, plan.PublishedContentProperties)
+		editApplicationRequestBody.SetContentLocation(publishedContentProperties.ContentLocation.ValueString())
+	case citrixorchestration.APPLICATIONTYPE_APP_V:
+		appPackageProperties := util.ObjectValueToTypedObject[AppPackagePropertiesModel](ctx, &resp.Diagnostics, plan.AppPackageProperties)
+		appVAppRequest, err := getAppVAppRequestModel(ctx, r.client, &resp.Diagnostics, appPackageProperties)
+		if err != nil {
+			return
+		}
+		editApplicationRequestBody.SetAppVAppProperties(appVAppRequest)
+	default:
+		var editInstalledAppRequest citrixorchestration.EditInstalledAppRequestModel
+		var installedAppProperties = util.ObjectValueToTypedObject[InstalledAppResponseModel](ctx, &resp.Diagnostics, plan.InstalledAppProperties)
+		editInstalledAppRequest.SetCommandLineArguments(installedAppProperties.CommandLineArguments.ValueString())
+		editInstalledAppRequest.SetCommandLineExecutable(installedAppProperties.CommandLineExecutable.ValueString())
+		editInstalledAppRequest.SetWorkingDirectory(installedAppProperties.WorkingDirectory.ValueString())
+		editApplicationRequestBody.SetInstalledAppProperties(editInstalledAppRequest)
+	}
 
 	deliveryGroups := buildDeliveryGroupsPriorityRequestModel(ctx, &resp.Diagnostics, plan)
 	editApplicationRequestBody.SetDeliveryGroups(deliveryGroups)
@@ -375,10 +426,15 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	fileTypeAssociations, err := updateApplicationFileTypeAssociations(ctx, r.client, &resp.Diagnostics, applicationId, plan.FileTypeAssociations, state.FileTypeAssociations)
+	if err != nil {
+		return
+	}
+
 	tags := getApplicationTags(ctx, &resp.Diagnostics, r.client, applicationId)
 
 	// Update resource state with updated property values
-	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, application, getApplicationGroups, applicationDeliveryGroups, tags)
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, application, getApplicationGroups, applicationDeliveryGroups, fileTypeAssociations, tags)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -421,7 +477,7 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 
 func (r *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *applicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -465,6 +521,7 @@ func (r *applicationResource) ValidateConfig(ctx context.Context, req resource.V
 	tflog.Debug(ctx, "Validate Config - "+schemaType, configValuesForSchema)
 
 	validateDeliveryGroupsPriority(ctx, &resp.Diagnostics, data)
+	validateApplicationTypeProperties(ctx, &resp.Diagnostics, data)
 }
 
 func (r *applicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -653,6 +710,233 @@ func validateDeliveryGroupsPriority(ctx context.Context, diagnostics *diag.Diagn
 	}
 }
 
+// validateApplicationTypeProperties validates that only the properties of the configured application type are set.
+func validateApplicationTypeProperties(ctx context.Context, diagnostics *diag.Diagnostics, data ApplicationResourceModel) {
+	if data.ApplicationType.IsUnknown() {
+		return
+	}
+
+	applicationType := citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP
+	if !data.ApplicationType.IsNull() {
+		applicationType = citrixorchestration.ApplicationType(data.ApplicationType.ValueString())
+	}
+
+	typeProperties := map[string]types.Object{
+		"installed_app_properties":     data.InstalledAppProperties,
+		"published_content_properties": data.PublishedContentProperties,
+		"app_package_properties":       data.AppPackageProperties,
+	}
+	requiredProperties := map[citrixorchestration.ApplicationType]string{
+		citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP: "installed_app_properties",
+		citrixorchestration.APPLICATIONTYPE_PUBLISHED_CONTENT: "published_content_properties",
+		citrixorchestration.APPLICATIONTYPE_APP_V:             "app_package_properties",
+	}
+	for _, attributeName := range []string{"installed_app_properties", "published_content_properties", "app_package_properties"} {
+		properties := typeProperties[attributeName]
+		if attributeName == requiredProperties[applicationType] {
+			if properties.IsNull() {
+				diagnostics.AddAttributeError(
+					path.Root(attributeName),
+					"Missing Attribute Configuration",
+					fmt.Sprintf("`%s` must be specified when `application_type` is `%s`.", attributeName, applicationType),
+				)
+			}
+		} else if !properties.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("`%s` cannot be specified when `application_type` is `%s`.", attributeName, applicationType),
+			)
+		}
+	}
+
+	if data.FileTypeAssociations.IsNull() || data.FileTypeAssociations.IsUnknown() {
+		return
+	}
+
+	if applicationType == citrixorchestration.APPLICATIONTYPE_PUBLISHED_CONTENT {
+		diagnostics.AddAttributeError(
+			path.Root("file_type_associations"),
+			"Invalid Attribute Combination",
+			"`file_type_associations` cannot be specified for published content applications.",
+		)
+		return
+	}
+
+	extensions := map[string]bool{}
+	for _, fileTypeAssociation := range util.ObjectListToTypedArray[FileTypeAssociationModel](ctx, diagnostics, data.FileTypeAssociations) {
+		if fileTypeAssociation.Extension.IsUnknown() {
+			continue
+		}
+		if extensions[fileTypeAssociation.GetKey()] {
+			diagnostics.AddAttributeError(
+				path.Root("file_type_associations"),
+				"Invalid configuration in file_type_associations",
+				fmt.Sprintf("File type association for extension `%s` is specified more than once.", fileTypeAssociation.Extension.ValueString()),
+			)
+			return
+		}
+		extensions[fileTypeAssociation.GetKey()] = true
+	}
+}
+
+// getAppVAppRequestModel builds the App-V application properties of an application from the application in the App-V package.
+func getAppVAppRequestModel(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, appPackageProperties AppPackagePropertiesModel) (citrixorchestration.AppVAppRequestModel, error) {
+	packageId := appPackageProperties.PackageId.ValueString()
+	packageApplicationId := appPackageProperties.PackageApplicationId.ValueString()
+
+	var appVAppRequest citrixorchestration.AppVAppRequestModel
+	getAppVApplicationRequest := client.ApiClient.AppVPackagesAPIsDAAS.AppVPackagesGetAppVPackageApplication(ctx, packageId, packageApplicationId)
+	appVApplication, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.AppVApplicationDetailResponseModel](getAppVApplicationRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading Application "+packageApplicationId+" of App-V Package "+packageId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return appVAppRequest, err
+	}
+
+	appVAppRequest.SetId(appVApplication.GetId())
+	appVAppRequest.SetIdentifier(appVApplication.GetIdentifier())
+	appVAppRequest.SetPackageId(appVApplication.GetPackageId())
+	appVAppRequest.SetPackageName(appVApplication.GetPackageName())
+	appVAppRequest.SetPackageVersion(appVApplication.GetPackageVersion())
+	appVAppRequest.SetPackageVersionId(appVApplication.GetPackageVersionId())
+	appVAppRequest.SetPublishingServer(appVApplication.GetPublishingServer())
+	appVAppRequest.SetSequenceLocation(appVApplication.GetSequenceLocation())
+	appVAppRequest.SetTargetInPackage(appVApplication.GetTargetInPackage())
+	if appVApplication.GetServerMachineConfigurationUid() != "" {
+		appVAppRequest.SetServerMachineConfigurationUid(appVApplication.GetServerMachineConfigurationUid())
+	}
+	return appVAppRequest, nil
+}
+
+func getApplicationFileTypeAssociations(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, applicationId string) ([]citrixorchestration.FtaResponseModel, error) {
+	getFileTypeAssociationsRequest := client.ApiClient.ApplicationsAPIsDAAS.ApplicationsGetApplicationFtas(ctx, applicationId)
+	fileTypeAssociations, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.FtaResponseModelCollection](getFileTypeAssociationsRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Reading File Type Associations of Application "+applicationId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return nil, err
+	}
+
+	return fileTypeAssociations.GetItems(), nil
+}
+
+// updateApplicationFileTypeAssociations enables the planned file type associations, disables the ones planned as disabled
+// or removed from the plan, and returns the resulting file type associations of the application.
+func updateApplicationFileTypeAssociations(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, applicationId string, plan types.List, state types.List) ([]citrixorchestration.FtaResponseModel, error) {
+	if plan.IsNull() && state.IsNull() {
+		return nil, nil
+	}
+
+	remoteFileTypeAssociations, err := getApplicationFileTypeAssociations(ctx, client, diagnostics, applicationId)
+	if err != nil {
+		return nil, err
+	}
+	remoteFileTypeAssociationsMap := map[string]citrixorchestration.FtaResponseModel{}
+	for _, remoteFileTypeAssociation := range remoteFileTypeAssociations {
+		remoteFileTypeAssociationsMap[getFileTypeAssociationKey(remoteFileTypeAssociation)] = remoteFileTypeAssociation
+	}
+
+	changed := false
+	plannedExtensions := map[string]bool{}
+	for _, fileTypeAssociation := range util.ObjectListToTypedArray[FileTypeAssociationModel](ctx, diagnostics, plan) {
+		plannedExtensions[fileTypeAssociation.GetKey()] = true
+		remoteFileTypeAssociation, exists := remoteFileTypeAssociationsMap[fileTypeAssociation.GetKey()]
+		if fileTypeAssociation.Enabled.ValueBool() {
+			if exists && remoteFileTypeAssociation.GetEnabled() && isFileTypeAssociationUpToDate(fileTypeAssociation, remoteFileTypeAssociation) {
+				continue
+			}
+			err = enableApplicationFileTypeAssociation(ctx, client, diagnostics, applicationId, fileTypeAssociation)
+		} else if exists && remoteFileTypeAssociation.GetEnabled() {
+			err = disableApplicationFileTypeAssociation(ctx, client, diagnostics, applicationId, remoteFileTypeAssociation.GetExtensionName())
+		} else {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+
+	// Disable the file type associations removed from the plan
+	for _, fileTypeAssociation := range util.ObjectListToTypedArray[FileTypeAssociationModel](ctx, diagnostics, state) {
+		if plannedExtensions[fileTypeAssociation.GetKey()] {
+			continue
+		}
+		if remoteFileTypeAssociation, exists := remoteFileTypeAssociationsMap[fileTypeAssociation.GetKey()]; exists && remoteFileTypeAssociation.GetEnabled() {
+			err = disableApplicationFileTypeAssociation(ctx, client, diagnostics, applicationId, remoteFileTypeAssociation.GetExtensionName())
+			if err != nil {
+				return nil, err
+			}
+			changed = true
+		}
+	}
+
+	if !changed {
+		return remoteFileTypeAssociations, nil
+	}
+	return getApplicationFileTypeAssociations(ctx, client, diagnostics, applicationId)
+}
+
+// isFileTypeAssociationUpToDate checks whether the configured values of the file type association match the remote ones.
+func isFileTypeAssociationUpToDate(fileTypeAssociation FileTypeAssociationModel, remote citrixorchestration.FtaResponseModel) bool {
+	if !fileTypeAssociation.ContentType.IsNull() && !fileTypeAssociation.ContentType.IsUnknown() && fileTypeAssociation.ContentType.ValueString() != remote.GetContentType() {
+		return false
+	}
+	if !fileTypeAssociation.HandlerArguments.IsNull() && !fileTypeAssociation.HandlerArguments.IsUnknown() && fileTypeAssociation.HandlerArguments.ValueString() != remote.GetOpenArguments() {
+		return false
+	}
+	return true
+}
+
+func enableApplicationFileTypeAssociation(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, applicationId string, fileTypeAssociation FileTypeAssociationModel) error {
+	var ftaRequest citrixorchestration.FtaRequestModel
+	ftaRequest.SetExtensionName(fileTypeAssociation.Extension.ValueString())
+	if !fileTypeAssociation.ContentType.IsNull() && !fileTypeAssociation.ContentType.IsUnknown() {
+		ftaRequest.SetContentType(fileTypeAssociation.ContentType.ValueString())
+	}
+	if !fileTypeAssociation.HandlerArguments.IsNull() && !fileTypeAssociation.HandlerArguments.IsUnknown() {
+		ftaRequest.SetOpenArguments(fileTypeAssociation.HandlerArguments.ValueString())
+	}
+
+	errorTitle := "Error enabling File Type Association " + fileTypeAssociation.Extension.ValueString() + " of Application " + applicationId
+	enableFileTypeAssociationRequest := client.ApiClient.ApplicationsAPIsDAAS.ApplicationsEnableApplicationFta(ctx, applicationId)
+	enableFileTypeAssociationRequest = enableFileTypeAssociationRequest.FtaRequestModel(ftaRequest)
+	httpResp, err := citrixdaasclient.AddRequestData(enableFileTypeAssociationRequest, client).Async(true).Execute()
+	if err != nil {
+		diagnostics.AddError(
+			errorTitle,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, errorTitle, diagnostics, 5)
+}
+
+func disableApplicationFileTypeAssociation(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, applicationId string, extension string) error {
+	errorTitle := "Error disabling File Type Association " + extension + " of Application " + applicationId
+	disableFileTypeAssociationRequest := client.ApiClient.ApplicationsAPIsDAAS.ApplicationsDisableApplicationFta(ctx, applicationId, extension)
+	httpResp, err := citrixdaasclient.AddRequestData(disableFileTypeAssociationRequest, client).Async(true).Execute()
+	if err != nil {
+		diagnostics.AddError(
+			errorTitle,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, errorTitle, diagnostics, 5)
+}
+
 func getApplicationTags(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, applicationId string) []string {
 	getTagsRequest := client.ApiClient.ApplicationsAPIsDAAS.ApplicationsGetApplicationTags(ctx, applicationId)
 	getTagsRequest = getTagsRequest.Fields("Id,Name,Description")
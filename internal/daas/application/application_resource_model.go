@@ -72,8 +72,8 @@ type InstalledAppResponseModel struct {
 
 func (InstalledAppResponseModel) GetSchema() schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
-		Description: "The install application properties.",
-		Required:    true,
+		Description: "The install application properties. Required when `application_type` is `HostedOnDesktop`.",
+		Optional:    true,
 		Attributes: map[string]schema.Attribute{
 			"command_line_arguments": schema.StringAttribute{
 				Description: "The command-line arguments to use when launching the executable.",
@@ -105,32 +105,166 @@ func (InstalledAppResponseModel) GetAttributes() map[string]schema.Attribute {
 	return InstalledAppResponseModel{}.GetSchema().Attributes
 }
 
+// PublishedContentPropertiesModel maps the properties of a published content application.
+type PublishedContentPropertiesModel struct {
+	ContentLocation types.String `tfsdk:"content_location"`
+}
+
+func (PublishedContentPropertiesModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "The published content properties. Required when `application_type` is `PublishedContent`.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"content_location": schema.StringAttribute{
+				Description: "The location of the published content, either a URL such as `https://intranet.example.com` or a UNC path such as `\\\\fileserver\\share\\document.pdf`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.PublishedContentLocationRegex), "must be a URL or a UNC path"),
+				},
+			},
+		},
+	}
+}
+
+func (PublishedContentPropertiesModel) GetAttributes() map[string]schema.Attribute {
+	return PublishedContentPropertiesModel{}.GetSchema().Attributes
+}
+
+// AppPackagePropertiesModel maps the properties of an application delivered from an App-V package.
+type AppPackagePropertiesModel struct {
+	PackageId            types.String `tfsdk:"package_id"`
+	PackageApplicationId types.String `tfsdk:"package_application_id"`
+}
+
+func (AppPackagePropertiesModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "The app package properties. Required when `application_type` is `AppV`.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"package_id": schema.StringAttribute{
+				Description: "Id of the App-V package that contains the application.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"package_application_id": schema.StringAttribute{
+				Description: "Id of the application within the App-V package.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (AppPackagePropertiesModel) GetAttributes() map[string]schema.Attribute {
+	return AppPackagePropertiesModel{}.GetSchema().Attributes
+}
+
+// FileTypeAssociationModel maps a file type association of an application.
+type FileTypeAssociationModel struct {
+	Extension        types.String `tfsdk:"extension"`
+	ContentType      types.String `tfsdk:"content_type"`
+	HandlerArguments types.String `tfsdk:"handler_arguments"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+}
+
+func (FileTypeAssociationModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"extension": schema.StringAttribute{
+				Description: "The file extension, including the leading dot. For example, `.pdf`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\..+$`), "must start with a dot"),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The MIME content type of the file type. For example, `application/pdf`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"handler_arguments": schema.StringAttribute{
+				Description: "The arguments passed to the application when it opens a file of this type. For example, `\"%1\"`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Indicates whether the file type association is enabled. Default is `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (FileTypeAssociationModel) GetAttributes() map[string]schema.Attribute {
+	return FileTypeAssociationModel{}.GetSchema().Attributes
+}
+
+func (r FileTypeAssociationModel) GetKey() string {
+	return strings.ToLower(r.Extension.ValueString())
+}
+
+func (r FileTypeAssociationModel) RefreshListItem(_ context.Context, _ *diag.Diagnostics, remote citrixorchestration.FtaResponseModel) util.ResourceModelWithAttributes {
+	r.Extension = types.StringValue(remote.GetExtensionName())
+	if remote.GetContentType() != "" {
+		r.ContentType = types.StringValue(remote.GetContentType())
+	} else {
+		r.ContentType = types.StringNull()
+	}
+	if remote.GetOpenArguments() != "" {
+		r.HandlerArguments = types.StringValue(remote.GetOpenArguments())
+	} else {
+		r.HandlerArguments = types.StringNull()
+	}
+	r.Enabled = types.BoolValue(remote.GetEnabled())
+	return r
+}
+
+func getFileTypeAssociationKey(remote citrixorchestration.FtaResponseModel) string {
+	return strings.ToLower(remote.GetExtensionName())
+}
+
 // ApplicationResourceModel maps the resource schema data.
 type ApplicationResourceModel struct {
-	Id                        types.String `tfsdk:"id"`
-	Name                      types.String `tfsdk:"name"`
-	PublishedName             types.String `tfsdk:"published_name"`
-	Description               types.String `tfsdk:"description"`
-	InstalledAppProperties    types.Object `tfsdk:"installed_app_properties"` // InstalledAppResponseModel
-	ApplicationGroups         types.List   `tfsdk:"application_groups"`       // List[string]
-	DeliveryGroups            types.List   `tfsdk:"delivery_groups"`          // List[string]
-	DeliveryGroupsPriority    types.Set    `tfsdk:"delivery_groups_priority"` // List[DeliveryGroupPriorityModel]
-	ApplicationFolderPath     types.String `tfsdk:"application_folder_path"`
-	Icon                      types.String `tfsdk:"icon"`
-	LimitVisibilityToUsers    types.Set    `tfsdk:"limit_visibility_to_users"` // Set[string]
-	ApplicationCategoryPath   types.String `tfsdk:"application_category_path"`
-	Metadata                  types.List   `tfsdk:"metadata"` // List[NameValueStringPairModel]
-	Tags                      types.Set    `tfsdk:"tags"`     // Set[string]
-	Enabled                   types.Bool   `tfsdk:"enabled"`
-	MaxTotalInstances         types.Int32  `tfsdk:"max_total_instances"`
-	ShortcutAddedToDesktop    types.Bool   `tfsdk:"shortcut_added_to_desktop"`
-	ShortcutAddedToStartMenu  types.Bool   `tfsdk:"shortcut_added_to_start_menu"`
-	LimitToOneInstancePerUser types.Bool   `tfsdk:"limit_to_one_instance_per_user"`
-	Visible                   types.Bool   `tfsdk:"visible"`
-	BrowserName               types.String `tfsdk:"browser_name"`
-	CpuPriorityLevel          types.String `tfsdk:"cpu_priority_level"`
-	HomeZoneMode              types.String `tfsdk:"home_zone_mode"`
-	HomeZone                  types.String `tfsdk:"home_zone"`
+	Id                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	PublishedName              types.String `tfsdk:"published_name"`
+	Description                types.String `tfsdk:"description"`
+	ApplicationType            types.String `tfsdk:"application_type"`
+	InstalledAppProperties     types.Object `tfsdk:"installed_app_properties"`     // InstalledAppResponseModel
+	PublishedContentProperties types.Object `tfsdk:"published_content_properties"` // PublishedContentPropertiesModel
+	AppPackageProperties       types.Object `tfsdk:"app_package_properties"`       // AppPackagePropertiesModel
+	FileTypeAssociations       types.List   `tfsdk:"file_type_associations"`       // List[FileTypeAssociationModel]
+	ApplicationGroups          types.List   `tfsdk:"application_groups"`           // List[string]
+	DeliveryGroups             types.List   `tfsdk:"delivery_groups"`              // List[string]
+	DeliveryGroupsPriority     types.Set    `tfsdk:"delivery_groups_priority"`     // List[DeliveryGroupPriorityModel]
+	ApplicationFolderPath      types.String `tfsdk:"application_folder_path"`
+	Icon                       types.String `tfsdk:"icon"`
+	LimitVisibilityToUsers     types.Set    `tfsdk:"limit_visibility_to_users"` // Set[string]
+	ApplicationCategoryPath    types.String `tfsdk:"application_category_path"`
+	Metadata                   types.List   `tfsdk:"metadata"` // List[NameValueStringPairModel]
+	Tags                       types.Set    `tfsdk:"tags"`     // Set[string]
+	Enabled                    types.Bool   `tfsdk:"enabled"`
+	MaxTotalInstances          types.Int32  `tfsdk:"max_total_instances"`
+	ShortcutAddedToDesktop     types.Bool   `tfsdk:"shortcut_added_to_desktop"`
+	ShortcutAddedToStartMenu   types.Bool   `tfsdk:"shortcut_added_to_start_menu"`
+	LimitToOneInstancePerUser  types.Bool   `tfsdk:"limit_to_one_instance_per_user"`
+	Visible                    types.Bool   `tfsdk:"visible"`
+	BrowserName                types.String `tfsdk:"browser_name"`
+	CpuPriorityLevel           types.String `tfsdk:"cpu_priority_level"`
+	HomeZoneMode               types.String `tfsdk:"home_zone_mode"`
+	HomeZone                   types.String `tfsdk:"home_zone"`
 }
 
 // Schema defines the schema for the data source.
@@ -159,7 +293,41 @@ func (ApplicationResourceModel) GetSchema() schema.Schema {
 				Computed:    true,
 				Default:     stringdefault.StaticString(""),
 			},
-			"installed_app_properties": InstalledAppResponseModel{}.GetSchema(),
+			"application_type": schema.StringAttribute{
+				Description: "The type of the application. Allowed values are `HostedOnDesktop` for applications installed on the VDA, `PublishedContent` for published URLs and UNC paths, and `AppV` for applications delivered from an App-V package. Default is `HostedOnDesktop`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP),
+						string(citrixorchestration.APPLICATIONTYPE_PUBLISHED_CONTENT),
+						string(citrixorchestration.APPLICATIONTYPE_APP_V),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// State written before application_type was added has no value, which is an installed application
+							resp.RequiresReplace = !req.StateValue.IsNull() || req.PlanValue.ValueString() != string(citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP)
+						},
+						"Force replace when application_type is changed. Setting the default application type on an application without a type in state is an update.",
+						"Force replace when application_type is changed. Setting the default application type on an application without a type in state is an update.",
+					),
+				},
+			},
+			"installed_app_properties":     InstalledAppResponseModel{}.GetSchema(),
+			"published_content_properties": PublishedContentPropertiesModel{}.GetSchema(),
+			"app_package_properties":       AppPackagePropertiesModel{}.GetSchema(),
+			"file_type_associations": schema.ListNestedAttribute{
+				NestedObject: FileTypeAssociationModel{}.GetSchema(),
+				Description: "The file type associations of the application. Users opening files of these types are directed to the application." +
+					"\n\n-> **Note** When omitted, the file type associations of the application are not managed by Terraform.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
 			"application_groups": schema.ListAttribute{
 				ElementType: types.StringType,
 				Description: "The application group IDs to which the application should be added.",
@@ -348,7 +516,7 @@ func (ApplicationResourceModel) GetAttributesNamesToMask() map[string]bool {
 	}
 }
 
-func (r ApplicationResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, application *citrixorchestration.ApplicationDetailResponseModel, applicationGroups *citrixorchestration.ApplicationGroupResponseModelCollection, applicationDeliveryGroups *citrixorchestration.ApplicationDeliveryGroupResponseModelCollection, tags []string) ApplicationResourceModel {
+func (r ApplicationResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, application *citrixorchestration.ApplicationDetailResponseModel, applicationGroups *citrixorchestration.ApplicationGroupResponseModelCollection, applicationDeliveryGroups *citrixorchestration.ApplicationDeliveryGroupResponseModelCollection, fileTypeAssociations []citrixorchestration.FtaResponseModel, tags []string) ApplicationResourceModel {
 	// Overwrite application with refreshed state
 	r.Id = types.StringValue(application.GetId())
 	r.Name = types.StringValue(application.GetName())
@@ -422,7 +590,39 @@ func (r ApplicationResourceModel) RefreshPropertyValues(ctx context.Context, dia
 		r.DeliveryGroups = types.ListNull(types.StringType)
 	}
 
-	r.InstalledAppProperties = r.updatePlanWithInstalledAppProperties(ctx, diagnostics, application)
+	applicationType := getApplicationType(application)
+	r.ApplicationType = types.StringValue(string(applicationType))
+	if applicationType == citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP {
+		r.InstalledAppProperties = r.updatePlanWithInstalledAppProperties(ctx, diagnostics, application)
+	} else if attributesMap, err := util.ResourceAttributeMapFromObject(InstalledAppResponseModel{}); err == nil {
+		r.InstalledAppProperties = types.ObjectNull(attributesMap)
+	} else {
+		diagnostics.AddWarning("Error when creating null InstalledAppResponseModel", err.Error())
+	}
+
+	if applicationType == citrixorchestration.APPLICATIONTYPE_PUBLISHED_CONTENT {
+		publishedContentProperties := PublishedContentPropertiesModel{
+			ContentLocation: types.StringValue(application.GetContentLocation()),
+		}
+		r.PublishedContentProperties = util.TypedObjectToObjectValue(ctx, diagnostics, publishedContentProperties)
+	} else if attributesMap, err := util.ResourceAttributeMapFromObject(PublishedContentPropertiesModel{}); err == nil {
+		r.PublishedContentProperties = types.ObjectNull(attributesMap)
+	} else {
+		diagnostics.AddWarning("Error when creating null PublishedContentPropertiesModel", err.Error())
+	}
+
+	if applicationType == citrixorchestration.APPLICATIONTYPE_APP_V {
+		r.AppPackageProperties = r.updatePlanWithAppPackageProperties(ctx, diagnostics, application)
+	} else if attributesMap, err := util.ResourceAttributeMapFromObject(AppPackagePropertiesModel{}); err == nil {
+		r.AppPackageProperties = types.ObjectNull(attributesMap)
+	} else {
+		diagnostics.AddWarning("Error when creating null AppPackagePropertiesModel", err.Error())
+	}
+
+	// File type associations are only refreshed when they are managed by Terraform
+	if !r.FileTypeAssociations.IsNull() {
+		r.FileTypeAssociations = util.RefreshListValueProperties[FileTypeAssociationModel, citrixorchestration.FtaResponseModel](ctx, diagnostics, r.FileTypeAssociations, getManagedFileTypeAssociations(ctx, diagnostics, r.FileTypeAssociations, fileTypeAssociations), getFileTypeAssociationKey)
+	}
 
 	effectiveMetadata := util.GetEffectiveMetadata(util.ObjectListToTypedArray[util.NameValueStringPairModel](ctx, diagnostics, r.Metadata), application.GetMetadata())
 
@@ -453,3 +653,57 @@ func (r ApplicationResourceModel) updatePlanWithInstalledAppProperties(ctx conte
 
 	return util.TypedObjectToObjectValue(ctx, diagnostics, installedAppProperties)
 }
+
+// getApplicationType returns the type of the application, applications without a reported type are installed applications.
+func getApplicationType(application *citrixorchestration.ApplicationDetailResponseModel) citrixorchestration.ApplicationType {
+	if application.ApplicationType == nil {
+		return citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP
+	}
+	return application.GetApplicationType()
+}
+
+func (r ApplicationResourceModel) updatePlanWithAppPackageProperties(ctx context.Context, diagnostics *diag.Diagnostics, application *citrixorchestration.ApplicationDetailResponseModel) types.Object {
+	appPackageProperties := util.ObjectValueToTypedObject[AppPackagePropertiesModel](ctx, diagnostics, r.AppPackageProperties)
+
+	appVAppProperties := application.GetAppVAppProperties()
+	appPackageProperties.PackageId = types.StringValue(appVAppProperties.GetPackageId())
+	// Keep the configured application id when the application response does not report it
+	if appVAppProperties.GetId() != "" || appPackageProperties.PackageApplicationId.IsNull() {
+		appPackageProperties.PackageApplicationId = types.StringValue(appVAppProperties.GetId())
+	}
+
+	return util.TypedObjectToObjectValue(ctx, diagnostics, appPackageProperties)
+}
+
+// getManagedFileTypeAssociations returns the remote file type associations that are refreshed into state: the enabled
+// ones and the ones configured in state. Disabling a file type association that was not imported from the VDA deletes
+// it, so file type associations configured as disabled but missing remotely are reported as disabled.
+func getManagedFileTypeAssociations(ctx context.Context, diagnostics *diag.Diagnostics, state types.List, remote []citrixorchestration.FtaResponseModel) []citrixorchestration.FtaResponseModel {
+	stateFileTypeAssociations := map[string]FileTypeAssociationModel{}
+	for _, fileTypeAssociation := range util.ObjectListToTypedArray[FileTypeAssociationModel](ctx, diagnostics, state) {
+		stateFileTypeAssociations[fileTypeAssociation.GetKey()] = fileTypeAssociation
+	}
+
+	managedFileTypeAssociations := []citrixorchestration.FtaResponseModel{}
+	for _, fileTypeAssociation := range remote {
+		key := getFileTypeAssociationKey(fileTypeAssociation)
+		if _, exists := stateFileTypeAssociations[key]; exists || fileTypeAssociation.GetEnabled() {
+			managedFileTypeAssociations = append(managedFileTypeAssociations, fileTypeAssociation)
+			delete(stateFileTypeAssociations, key)
+		}
+	}
+
+	for _, fileTypeAssociation := range util.ObjectListToTypedArray[FileTypeAssociationModel](ctx, diagnostics, state) {
+		if _, notFoundRemotely := stateFileTypeAssociations[fileTypeAssociation.GetKey()]; !notFoundRemotely || fileTypeAssociation.Enabled.ValueBool() {
+			continue
+		}
+		disabledFileTypeAssociation := citrixorchestration.FtaResponseModel{}
+		disabledFileTypeAssociation.SetExtensionName(fileTypeAssociation.Extension.ValueString())
+		disabledFileTypeAssociation.SetContentType(fileTypeAssociation.ContentType.ValueString())
+		disabledFileTypeAssociation.SetOpenArguments(fileTypeAssociation.HandlerArguments.ValueString())
+		disabledFileTypeAssociation.SetEnabled(false)
+		managedFileTypeAssociations = append(managedFileTypeAssociations, disabledFileTypeAssociation)
+	}
+
+	return managedFileTypeAssociations
+}
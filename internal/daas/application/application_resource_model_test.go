@@ -0,0 +1,184 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func newTestFileTypeAssociation(extension string, openArguments string, enabled bool) citrixorchestration.FtaResponseModel {
+	fileTypeAssociation := citrixorchestration.FtaResponseModel{}
+	fileTypeAssociation.SetExtensionName(extension)
+	fileTypeAssociation.SetOpenArguments(openArguments)
+	fileTypeAssociation.SetEnabled(enabled)
+	return fileTypeAssociation
+}
+
+func TestFileTypeAssociationsRefresh(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	diagnostics := diag.Diagnostics{}
+	state := util.TypedArrayToObjectList(ctx, &diagnostics, []FileTypeAssociationModel{
+		{Extension: types.StringValue(".PDF"), ContentType: types.StringNull(), HandlerArguments: types.StringNull(), Enabled: types.BoolValue(true)},
+		{Extension: types.StringValue(".txt"), ContentType: types.StringNull(), HandlerArguments: types.StringNull(), Enabled: types.BoolValue(false)},
+		{Extension: types.StringValue(".doc"), ContentType: types.StringNull(), HandlerArguments: types.StringNull(), Enabled: types.BoolValue(true)},
+	})
+	remote := []citrixorchestration.FtaResponseModel{
+		newTestFileTypeAssociation(".pdf", "\"%1\"", true),
+		newTestFileTypeAssociation(".xml", "\"%1\"", true),
+		newTestFileTypeAssociation(".csv", "\"%1\"", false),
+	}
+
+	refreshed := util.RefreshListValueProperties[FileTypeAssociationModel, citrixorchestration.FtaResponseModel](ctx, &diagnostics, state, getManagedFileTypeAssociations(ctx, &diagnostics, state, remote), getFileTypeAssociationKey)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	// .doc was deleted remotely, .txt is disabled and not imported, .xml was enabled outside of Terraform and .csv is imported but disabled
+	expected := []string{".pdf=true", ".txt=false", ".xml=true"}
+	fileTypeAssociations := util.ObjectListToTypedArray[FileTypeAssociationModel](ctx, &diagnostics, refreshed)
+	if len(fileTypeAssociations) != len(expected) {
+		t.Fatalf("expected %d file type associations, got %d", len(expected), len(fileTypeAssociations))
+	}
+	for index, fileTypeAssociation := range fileTypeAssociations {
+		actual := fileTypeAssociation.Extension.ValueString() + "=" + fileTypeAssociation.Enabled.String()
+		if actual != expected[index] {
+			t.Errorf("expected file type association %s, got %s", expected[index], actual)
+		}
+	}
+	if fileTypeAssociations[0].HandlerArguments.ValueString() != "\"%1\"" {
+		t.Errorf("expected handler arguments to be refreshed, got %s", fileTypeAssociations[0].HandlerArguments.String())
+	}
+}
+
+func TestValidateApplicationTypeProperties(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	setupDiagnostics := diag.Diagnostics{}
+	installedAppProperties := util.TypedObjectToObjectValue(ctx, &setupDiagnostics, InstalledAppResponseModel{
+		CommandLineExecutable: types.StringValue("notepad.exe"),
+		CommandLineArguments:  types.StringNull(),
+		WorkingDirectory:      types.StringNull(),
+	})
+	publishedContentProperties := util.TypedObjectToObjectValue(ctx, &setupDiagnostics, PublishedContentPropertiesModel{
+		ContentLocation: types.StringValue("https://intranet.example.com"),
+	})
+	nullAppPackageProperties, _ := util.ResourceAttributeMapFromObject(AppPackagePropertiesModel{})
+	nullInstalledAppProperties, _ := util.ResourceAttributeMapFromObject(InstalledAppResponseModel{})
+	nullPublishedContentProperties, _ := util.ResourceAttributeMapFromObject(PublishedContentPropertiesModel{})
+	nullFileTypeAssociations := util.TypedArrayToObjectList[FileTypeAssociationModel](ctx, &setupDiagnostics, nil)
+	if setupDiagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", setupDiagnostics)
+	}
+
+	tests := []struct {
+		name                       string
+		applicationType            types.String
+		installedAppProperties     types.Object
+		publishedContentProperties types.Object
+		expectError                bool
+	}{
+		{"installed application", types.StringNull(), installedAppProperties, types.ObjectNull(nullPublishedContentProperties), false},
+		{"installed application without properties", types.StringNull(), types.ObjectNull(nullInstalledAppProperties), types.ObjectNull(nullPublishedContentProperties), true},
+		{"published content", types.StringValue("PublishedContent"), types.ObjectNull(nullInstalledAppProperties), publishedContentProperties, false},
+		{"published content with installed properties", types.StringValue("PublishedContent"), installedAppProperties, publishedContentProperties, true},
+		{"unknown application type", types.StringUnknown(), installedAppProperties, publishedContentProperties, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostics := diag.Diagnostics{}
+			validateApplicationTypeProperties(ctx, &diagnostics, ApplicationResourceModel{
+				ApplicationType:            test.applicationType,
+				InstalledAppProperties:     test.installedAppProperties,
+				PublishedContentProperties: test.publishedContentProperties,
+				AppPackageProperties:       types.ObjectNull(nullAppPackageProperties),
+				FileTypeAssociations:       nullFileTypeAssociations,
+			})
+			if diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error %t, got diagnostics %v", test.expectError, diagnostics)
+			}
+		})
+	}
+}
+
+func TestApplicationTypeRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := ApplicationResourceModel{}.GetSchema()
+	applicationTypeSchema, ok := resourceSchema.Attributes["application_type"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected application_type to be a string attribute")
+	}
+	// The plan modifier only decides on replacement for existing applications
+	existing := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+	if diags := existing.SetAttribute(ctx, path.Root("id"), "app-1"); diags.HasError() {
+		t.Fatalf("error building the application: %v", diags)
+	}
+
+	hostedOnDesktop := types.StringValue(string(citrixorchestration.APPLICATIONTYPE_HOSTED_ON_DESKTOP))
+	publishedContent := types.StringValue(string(citrixorchestration.APPLICATIONTYPE_PUBLISHED_CONTENT))
+	tests := map[string]struct {
+		stateValue      types.String
+		planValue       types.String
+		requiresReplace bool
+	}{
+		"null state with default type": {
+			stateValue: types.StringNull(),
+			planValue:  hostedOnDesktop,
+		},
+		"null state with another type": {
+			stateValue:      types.StringNull(),
+			planValue:       publishedContent,
+			requiresReplace: true,
+		},
+		"unchanged type": {
+			stateValue: publishedContent,
+			planValue:  publishedContent,
+		},
+		"changed type": {
+			stateValue:      publishedContent,
+			planValue:       hostedOnDesktop,
+			requiresReplace: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:        path.Root("application_type"),
+				State:       existing,
+				Plan:        tfsdk.Plan{Schema: resourceSchema, Raw: existing.Raw},
+				StateValue:  test.stateValue,
+				PlanValue:   test.planValue,
+				ConfigValue: test.planValue,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: test.planValue}
+			for _, planModifier := range applicationTypeSchema.PlanModifiers {
+				planModifier.PlanModifyString(ctx, req, resp)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.RequiresReplace != test.requiresReplace {
+				t.Errorf("expected requires replace to be %t, got %t", test.requiresReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
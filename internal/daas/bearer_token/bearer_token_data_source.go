@@ -57,29 +57,19 @@ func (d *BearerTokenDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	cwsAuthToken, httpResp, err := d.client.SignIn()
-	var token string
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error fetching Bearer Token",
-			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-				"\nError message: "+err.Error(),
-		)
-		return
-	}
-
-	if cwsAuthToken == "" {
-		resp.Diagnostics.AddError(
-			"Error fetching Bearer Token",
-			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-				"\nError message: Bearer token is empty.",
-		)
+	token, _ := signInForBearerToken(ctx, d.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	token = strings.Split(cwsAuthToken, "=")[1]
 
 	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, token)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// getBearerTokenFromAuthHeader extracts the bearer token from a `CWSAuth bearer=<token>` authorization header value.
+func getBearerTokenFromAuthHeader(authHeader string) string {
+	_, token, _ := strings.Cut(authHeader, "=")
+	return token
+}
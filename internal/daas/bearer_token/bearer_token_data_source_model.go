@@ -17,7 +17,8 @@ type BearerTokenDataSourceModel struct {
 func (BearerTokenDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		Description: "CVAD --- Data source to get bearer token.",
+		Description: "CVAD --- Data source to get bearer token." +
+			"\n\n~> **Please Note** The bearer token is stored in plain text in the Terraform state. Use the `citrix_bearer_token` ephemeral resource to keep the bearer token out of the plan and state files.",
 
 		Attributes: map[string]schema.Attribute{
 			"bearer_token": schema.StringAttribute{
@@ -0,0 +1,131 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package bearer_token
+
+import (
+	"context"
+	"time"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &BearerTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &BearerTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &BearerTokenEphemeralResource{}
+)
+
+func NewBearerTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &BearerTokenEphemeralResource{}
+}
+
+type BearerTokenEphemeralResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (r *BearerTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bearer_token"
+}
+
+func (r *BearerTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = BearerTokenEphemeralResourceModel{}.GetSchema()
+}
+
+func (r *BearerTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+func (r *BearerTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if r.client != nil && r.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data BearerTokenEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, expiresAt := signInForBearerToken(ctx, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, token, expiresAt)
+	resp.RenewAt = getBearerTokenRenewAt(ctx, expiresAt)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Renew signs in again once the bearer token is about to expire, so that the provider client holds a valid token
+// for the remainder of the Terraform operation.
+func (r *BearerTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if r.client != nil && r.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	_, expiresAt := signInForBearerToken(ctx, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RenewAt = getBearerTokenRenewAt(ctx, expiresAt)
+}
+
+func getBearerTokenRenewAt(ctx context.Context, expiresAt string) time.Time {
+	expirationTime, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to parse bearer token expiration time, bearer token will not be renewed: "+err.Error())
+		return time.Time{}
+	}
+	return expirationTime.Add(-middleware.TokenRefreshMargin)
+}
+
+// signInForBearerToken returns the bearer token of the provider client along with its expiration time. The token is
+// shared with the provider client, which signs in again at the same time the ephemeral resource is renewed.
+func signInForBearerToken(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics) (string, string) {
+	cwsAuthToken, httpResp, err := middleware.SignIn(ctx, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error fetching Bearer Token",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+err.Error(),
+		)
+		return "", ""
+	}
+
+	token := getBearerTokenFromAuthHeader(cwsAuthToken)
+	if token == "" {
+		diagnostics.AddError(
+			"Error fetching Bearer Token",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: Bearer token is empty.",
+		)
+		return "", ""
+	}
+
+	expiresAt := ""
+	if expirationTime, ok := middleware.GetTokenExpiration(client); ok {
+		expiresAt = expirationTime.UTC().Format(time.RFC3339)
+	}
+	return token, expiresAt
+}
@@ -0,0 +1,46 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package bearer_token
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BearerTokenEphemeralResourceModel struct {
+	BearerToken types.String `tfsdk:"bearer_token"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (BearerTokenEphemeralResourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "CVAD --- Ephemeral resource to get bearer token. The bearer token is never persisted in the Terraform plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"bearer_token": schema.StringAttribute{
+				Description: "Value of the bearer token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Time the bearer token expires at, in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r BearerTokenEphemeralResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, bearerToken string, expiresAt string) BearerTokenEphemeralResourceModel {
+	r.BearerToken = types.StringValue(bearerToken)
+	if expiresAt != "" {
+		r.ExpiresAt = types.StringValue(expiresAt)
+	} else {
+		r.ExpiresAt = types.StringNull()
+	}
+
+	return r
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/policies"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -408,7 +409,7 @@ func validateOnPremSiteSettings(client *citrixdaasclient.CitrixDaasClient, diagn
 }
 
 func getAuthTokenForOnPremSiteSettingsRequest(client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, siteId string, operation string) (string, error) {
-	cwsAuthToken, httpResp, err := client.SignIn()
+	cwsAuthToken, httpResp, err := middleware.SignIn(context.Background(), client)
 	if err != nil {
 		diagnostics.AddError(
 			fmt.Sprintf("Error %s Site Settings for site %s", operation, siteId),
@@ -114,21 +114,7 @@ func (r DeliveryGroupDataSourceModel) RefreshPropertyValues(ctx context.Context,
 
 	res := []vda.VdaModel{}
 	for _, model := range vdas {
-		machineName := model.GetName()
-		hosting := model.GetHosting()
-		hostedMachineId := hosting.GetHostedMachineId()
-		machineCatalog := model.GetMachineCatalog()
-		machineCatalogId := machineCatalog.GetId()
-		deliveryGroup := model.GetDeliveryGroup()
-		deliveryGroupId := deliveryGroup.GetId()
-
-		res = append(res, vda.VdaModel{
-			Id:                       types.StringValue(model.GetId()),
-			MachineName:              types.StringValue(machineName),
-			HostedMachineId:          types.StringValue(hostedMachineId),
-			AssociatedMachineCatalog: types.StringValue(machineCatalogId),
-			AssociatedDeliveryGroup:  types.StringValue(deliveryGroupId),
-		})
+		res = append(res, vda.VdaModel{}.RefreshPropertyValues(ctx, diagnostics, model))
 	}
 
 	r.Vdas = res
@@ -0,0 +1,66 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package delivery_group
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &deliveryGroupListResource{}
+	_ list.ListResourceWithConfigure = &deliveryGroupListResource{}
+)
+
+// NewDeliveryGroupListResource is a helper function to simplify the provider implementation.
+func NewDeliveryGroupListResource() list.ListResource {
+	return &deliveryGroupListResource{}
+}
+
+// deliveryGroupListResource is the list resource implementation.
+type deliveryGroupListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *deliveryGroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delivery_group"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *deliveryGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("delivery groups")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *deliveryGroupListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the delivery groups of the site.
+func (r *deliveryGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	deliveryGroups, _ := util.GetDeliveryGroups(ctx, r.client, &diags, "Id,Name,FullName")
+	items := []util.ListResourceItem{}
+	for _, deliveryGroup := range deliveryGroups {
+		items = append(items, util.ListResourceItem{Id: deliveryGroup.GetId(), DisplayName: deliveryGroup.GetFullName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewDeliveryGroupResource, r.client)
+}
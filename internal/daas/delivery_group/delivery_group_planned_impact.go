@@ -0,0 +1,88 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package delivery_group
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// getDeliveryGroupPlannedImpact computes the machines, users and sessions affected by updating the delivery group from state to plan.
+// Machines are removed from the delivery group when the machine count of an associated machine catalog is reduced or the
+// machine catalog is no longer associated. Users lose access when they are removed from the allow list or added to the block list.
+func getDeliveryGroupPlannedImpact(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, plan DeliveryGroupResourceModel, state DeliveryGroupResourceModel) (util.PlannedImpact, error) {
+	impact := util.PlannedImpact{}
+
+	if !plan.AssociatedMachineCatalogs.IsUnknown() && !plan.AssociatedMachineCatalogs.Equal(state.AssociatedMachineCatalogs) {
+		associatedMachineCatalogs := util.ObjectSetToTypedArray[DeliveryGroupMachineCatalogModel](ctx, diagnostics, plan.AssociatedMachineCatalogs)
+		associatedMachineCatalogsKnown := !slices.ContainsFunc(associatedMachineCatalogs, func(associatedMachineCatalog DeliveryGroupMachineCatalogModel) bool {
+			return associatedMachineCatalog.MachineCatalog.IsUnknown() || associatedMachineCatalog.MachineCount.IsUnknown()
+		})
+
+		if associatedMachineCatalogsKnown {
+			deliveryGroupMachines, err := util.GetDeliveryGroupMachines(ctx, client, diagnostics, state.Id.ValueString())
+			if err != nil {
+				return impact, err
+			}
+
+			machineIdsToRemove := getDeliveryGroupMachineIdsToRemove(createExistingCatalogsAndMachinesMap(deliveryGroupMachines), associatedMachineCatalogs)
+			for _, machine := range deliveryGroupMachines {
+				if slices.Contains(machineIdsToRemove, machine.GetId()) {
+					impact.ReassignMachine(machine)
+				}
+			}
+		}
+	}
+
+	for _, user := range getDeliveryGroupUsersLosingAccess(ctx, diagnostics, plan, state) {
+		impact.RemoveUser(user)
+	}
+
+	return impact, nil
+}
+
+// getDeliveryGroupUsersLosingAccess returns the users of the delivery group level restricted_access_users that are removed
+// from the allow list or added to the block list.
+func getDeliveryGroupUsersLosingAccess(ctx context.Context, diagnostics *diag.Diagnostics, plan DeliveryGroupResourceModel, state DeliveryGroupResourceModel) []string {
+	if plan.RestrictedAccessUsers.IsUnknown() || plan.RestrictedAccessUsers.IsNull() {
+		// Without restricted access users, all users have access to the delivery group
+		return []string{}
+	}
+
+	restrictedAccessUsersPlan := util.ObjectValueToTypedObject[RestrictedAccessUsers](ctx, diagnostics, plan.RestrictedAccessUsers)
+	restrictedAccessUsersState := RestrictedAccessUsers{}
+	if !state.RestrictedAccessUsers.IsNull() {
+		restrictedAccessUsersState = util.ObjectValueToTypedObject[RestrictedAccessUsers](ctx, diagnostics, state.RestrictedAccessUsers)
+	}
+	if restrictedAccessUsersPlan.AllowList.IsUnknown() || restrictedAccessUsersPlan.BlockList.IsUnknown() {
+		return []string{}
+	}
+
+	containsUser := func(users []string, user string) bool {
+		return slices.ContainsFunc(users, func(existing string) bool {
+			return strings.EqualFold(existing, user)
+		})
+	}
+
+	usersLosingAccess := []string{}
+	allowListPlan := util.StringSetToStringArray(ctx, diagnostics, restrictedAccessUsersPlan.AllowList)
+	for _, user := range util.StringSetToStringArray(ctx, diagnostics, restrictedAccessUsersState.AllowList) {
+		if !containsUser(allowListPlan, user) {
+			usersLosingAccess = append(usersLosingAccess, user)
+		}
+	}
+
+	blockListState := util.StringSetToStringArray(ctx, diagnostics, restrictedAccessUsersState.BlockList)
+	for _, user := range util.StringSetToStringArray(ctx, diagnostics, restrictedAccessUsersPlan.BlockList) {
+		if !containsUser(blockListState, user) && !containsUser(usersLosingAccess, user) {
+			usersLosingAccess = append(usersLosingAccess, user)
+		}
+	}
+
+	return usersLosingAccess
+}
@@ -0,0 +1,198 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package delivery_group
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &deliveryGroupRebootAction{}
+	_ action.ActionWithConfigure      = &deliveryGroupRebootAction{}
+	_ action.ActionWithValidateConfig = &deliveryGroupRebootAction{}
+)
+
+// NewDeliveryGroupRebootAction is a helper function to simplify the provider implementation.
+func NewDeliveryGroupRebootAction() action.Action {
+	return &deliveryGroupRebootAction{}
+}
+
+// deliveryGroupRebootAction is the action implementation.
+type deliveryGroupRebootAction struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// DeliveryGroupRebootActionModel maps the action schema data.
+type DeliveryGroupRebootActionModel struct {
+	DeliveryGroupId           types.String `tfsdk:"delivery_group_id"`
+	RebootDurationMinutes     types.Int64  `tfsdk:"reboot_duration_minutes"`
+	IgnoreMaintenanceMode     types.Bool   `tfsdk:"ignore_maintenance_mode"`
+	RestrictToTag             types.String `tfsdk:"restrict_to_tag"`
+	RebootNotificationToUsers types.Object `tfsdk:"reboot_notification_to_users"` // DeliveryGroupRebootNotificationToUsers
+}
+
+// Metadata returns the action type name.
+func (a *deliveryGroupRebootAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delivery_group_reboot"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *deliveryGroupRebootAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// Schema defines the schema for the action.
+func (a *deliveryGroupRebootAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "CVAD --- Starts a reboot cycle for the machines of a delivery group.",
+		Attributes: map[string]schema.Attribute{
+			"delivery_group_id": schema.StringAttribute{
+				Description: "Id of the delivery group.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"reboot_duration_minutes": schema.Int64Attribute{
+				Description: "Restart all machines within x minutes. 0 means restarting all machines at the same time. When omitted, all machines are restarted at the same time.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ignore_maintenance_mode": schema.BoolAttribute{
+				Description: "Whether the reboot cycle ignores machines in the maintenance mode. When omitted, machines in maintenance mode are rebooted.",
+				Optional:    true,
+			},
+			"restrict_to_tag": schema.StringAttribute{
+				Description: "Restrict the reboot cycle to machines with tag specified in Guid.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"reboot_notification_to_users": schema.SingleNestedAttribute{
+				Description: "The reboot notification for the reboot cycle.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"notification_duration_minutes": schema.Int64Attribute{
+						Description: "Send notification to users X minutes before user is logged off. Can only be `0`, `1`, `5` or `15`. `0` means no notification.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(0, 1, 5, 15),
+						},
+					},
+					"notification_title": schema.StringAttribute{
+						Description: "The title to be displayed to users before they are logged off.",
+						Required:    true,
+					},
+					"notification_message": schema.StringAttribute{
+						Description: "The message to be displayed to users before they are logged off.",
+						Required:    true,
+					},
+					"notification_repeat_every_5_minutes": schema.BoolAttribute{
+						Description: "Repeat notification every 5 minutes. " +
+							"\n\n~> **Please Note** notification repeat is available only when `notification_duration_minutes` is set to `15`.",
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *deliveryGroupRebootAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var data DeliveryGroupRebootActionModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RebootNotificationToUsers.IsNull() && !data.RebootNotificationToUsers.IsUnknown() {
+		notification := util.ObjectValueToTypedObject[DeliveryGroupRebootNotificationToUsers](ctx, &resp.Diagnostics, data.RebootNotificationToUsers)
+		notification.ValidateConfig(&resp.Diagnostics)
+	}
+}
+
+// Invoke starts the reboot cycle and waits for the job to complete.
+func (a *deliveryGroupRebootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if a.client == nil || a.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var config DeliveryGroupRebootActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deliveryGroup, err := util.GetDeliveryGroup(ctx, a.client, &resp.Diagnostics, config.DeliveryGroupId.ValueString())
+	if err != nil {
+		return
+	}
+	deliveryGroupName := deliveryGroup.GetName()
+
+	rebootCycleRequest := getRebootCycleRequestModel(ctx, &resp.Diagnostics, config)
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Starting reboot cycle for Delivery Group " + deliveryGroupName})
+
+	rebootCycleApiRequest := a.client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsStartDeliveryGroupRebootCycle(ctx, deliveryGroup.GetId())
+	rebootCycleApiRequest = rebootCycleApiRequest.RebootCycleRequestModel(rebootCycleRequest)
+	httpResp, err := citrixdaasclient.AddRequestData(rebootCycleApiRequest, a.client).Async(true).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rebooting machines of Delivery Group "+deliveryGroupName,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	// The job runs for the duration of the reboot cycle, leave some room for the last machines to come back
+	maxTimeout := int32(config.RebootDurationMinutes.ValueInt64()) + 10
+	err = util.ProcessAsyncJobResponse(ctx, a.client, httpResp, "Error rebooting machines of Delivery Group "+deliveryGroupName, &resp.Diagnostics, maxTimeout)
+	if err != nil {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Reboot cycle completed for Delivery Group " + deliveryGroupName})
+}
+
+// getRebootCycleRequestModel builds the reboot cycle request of the action configuration.
+func getRebootCycleRequestModel(ctx context.Context, diagnostics *diag.Diagnostics, config DeliveryGroupRebootActionModel) citrixorchestration.RebootCycleRequestModel {
+	var rebootCycleRequest citrixorchestration.RebootCycleRequestModel
+	rebootCycleRequest.SetRebootDurationMinutes(int32(config.RebootDurationMinutes.ValueInt64()))
+	rebootCycleRequest.SetIgnoreMaintenanceMode(config.IgnoreMaintenanceMode.ValueBool())
+	if !config.RestrictToTag.IsNull() {
+		rebootCycleRequest.SetRestrictToTag(config.RestrictToTag.ValueString())
+	}
+	if !config.RebootNotificationToUsers.IsNull() {
+		notification := util.ObjectValueToTypedObject[DeliveryGroupRebootNotificationToUsers](ctx, diagnostics, config.RebootNotificationToUsers)
+		setRebootNotificationToUsers(&rebootCycleRequest, notification)
+	}
+	return rebootCycleRequest
+}
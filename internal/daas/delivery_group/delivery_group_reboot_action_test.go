@@ -0,0 +1,139 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package delivery_group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func newRebootActionModel(t *testing.T, notification *DeliveryGroupRebootNotificationToUsers) DeliveryGroupRebootActionModel {
+	t.Helper()
+	ctx := context.Background()
+	model := DeliveryGroupRebootActionModel{
+		DeliveryGroupId:       types.StringValue("0f3c6b4e-7f0d-4d8b-9b55-0b8c4a1f2e3d"),
+		RebootDurationMinutes: types.Int64Value(30),
+		IgnoreMaintenanceMode: types.BoolValue(true),
+		RestrictToTag:         types.StringNull(),
+	}
+	if notification == nil {
+		attributes, err := util.ResourceAttributeMapFromObject(DeliveryGroupRebootNotificationToUsers{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		model.RebootNotificationToUsers = types.ObjectNull(attributes)
+		return model
+	}
+
+	diagnostics := diag.Diagnostics{}
+	model.RebootNotificationToUsers = util.TypedObjectToObjectValue(ctx, &diagnostics, *notification)
+	if diagnostics.HasError() {
+		t.Fatalf("error building the notification: %v", diagnostics)
+	}
+	return model
+}
+
+func TestDeliveryGroupRebootActionValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		notification  *DeliveryGroupRebootNotificationToUsers
+		expectedError bool
+	}{
+		"no notification": {},
+		"repeated 15 minute notification": {
+			notification: &DeliveryGroupRebootNotificationToUsers{
+				NotificationDurationMinutes:     types.Int64Value(15),
+				NotificationTitle:               types.StringValue("Reboot"),
+				NotificationMessage:             types.StringValue("Save your work"),
+				NotificationRepeatEvery5Minutes: types.BoolValue(true),
+			},
+		},
+		"repeated 5 minute notification": {
+			notification: &DeliveryGroupRebootNotificationToUsers{
+				NotificationDurationMinutes:     types.Int64Value(5),
+				NotificationTitle:               types.StringValue("Reboot"),
+				NotificationMessage:             types.StringValue("Save your work"),
+				NotificationRepeatEvery5Minutes: types.BoolValue(true),
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &deliveryGroupRebootAction{}
+			schemaResp := &action.SchemaResponse{}
+			a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+			// The configuration cannot be set from a model, so the value is built with a state of the same schema
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if diags := state.Set(ctx, newRebootActionModel(t, test.notification)); diags.HasError() {
+				t.Fatalf("error building the action configuration: %v", diags)
+			}
+
+			resp := &action.ValidateConfigResponse{}
+			a.ValidateConfig(ctx, action.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+			if resp.Diagnostics.HasError() != test.expectedError {
+				t.Errorf("expected error %t, got diagnostics %v", test.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGetRebootCycleRequestModel(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		notification *DeliveryGroupRebootNotificationToUsers
+		restrictTag  types.String
+		expected     string
+	}{
+		"without notification": {
+			restrictTag: types.StringNull(),
+			expected:    `{"IgnoreMaintenanceMode":true,"RebootDurationMinutes":30}`,
+		},
+		"restricted to a tag with a repeated notification": {
+			notification: &DeliveryGroupRebootNotificationToUsers{
+				NotificationDurationMinutes:     types.Int64Value(15),
+				NotificationTitle:               types.StringValue("Reboot"),
+				NotificationMessage:             types.StringValue("Save your work"),
+				NotificationRepeatEvery5Minutes: types.BoolValue(true),
+			},
+			restrictTag: types.StringValue("5b6f1c1e-3a4d-4c55-8a2b-6f7e8d9c0a1b"),
+			expected:    `{"IgnoreMaintenanceMode":true,"RebootDurationMinutes":30,"RestrictToTag":"5b6f1c1e-3a4d-4c55-8a2b-6f7e8d9c0a1b","WarningDurationMinutes":15,"WarningMessage":"Save your work","WarningRepeatIntervalMinutes":5,"WarningTitle":"Reboot"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := newRebootActionModel(t, test.notification)
+			config.RestrictToTag = test.restrictTag
+			diagnostics := diag.Diagnostics{}
+			request := getRebootCycleRequestModel(ctx, &diagnostics, config)
+			if diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diagnostics)
+			}
+
+			actual, err := request.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(actual) != test.expected {
+				t.Errorf("expected reboot cycle request %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                   = &deliveryGroupResource{}
 	_ resource.ResourceWithConfigure      = &deliveryGroupResource{}
 	_ resource.ResourceWithImportState    = &deliveryGroupResource{}
+	_ resource.ResourceWithIdentity       = &deliveryGroupResource{}
 	_ resource.ResourceWithValidateConfig = &deliveryGroupResource{}
 	_ resource.ResourceWithModifyPlan     = &deliveryGroupResource{}
 )
@@ -56,6 +57,11 @@ func (r *deliveryGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = DeliveryGroupResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *deliveryGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 func (r *deliveryGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
@@ -65,6 +71,9 @@ func (r *deliveryGroupResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if plan.PlannedImpact.IsUnknown() {
+		plan.PlannedImpact = util.GetPlannedImpactNull()
+	}
 
 	// Get machine catalogs and verify all of them have the same session support
 	associatedMachineCatalogs := util.ObjectSetToTypedArray[DeliveryGroupMachineCatalogModel](ctx, &resp.Diagnostics, plan.AssociatedMachineCatalogs)
@@ -288,6 +297,7 @@ func (r *deliveryGroupResource) Create(ctx context.Context, req resource.CreateR
 // Read refreshes the Terraform state with the latest data.
 func (r *deliveryGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state DeliveryGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -356,6 +366,7 @@ func (r *deliveryGroupResource) Read(ctx context.Context, req resource.ReadReque
 
 func (r *deliveryGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan DeliveryGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -476,6 +487,9 @@ func (r *deliveryGroupResource) Update(ctx context.Context, req resource.UpdateR
 
 	tags := getDeliveryGroupTags(ctx, &resp.Diagnostics, r.client, deliveryGroupId)
 
+	if plan.PlannedImpact.IsUnknown() {
+		plan.PlannedImpact = util.GetPlannedImpactNull()
+	}
 	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, r.client, updatedDeliveryGroup, deliveryGroupDesktops, deliveryGroupPowerTimeSchemes, deliveryGroupMachines, deliveryGroupRebootSchedule, deliveryGroupAutoscalePlugins, tags)
 
 	diags = resp.State.Set(ctx, plan)
@@ -521,7 +535,7 @@ func (r *deliveryGroupResource) Delete(ctx context.Context, req resource.DeleteR
 
 func (r *deliveryGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *deliveryGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -742,6 +756,18 @@ func (r *deliveryGroupResource) ModifyPlan(ctx context.Context, req resource.Mod
 		}
 	}
 
+	if create {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("planned_impact"), util.GetPlannedImpactNull())...)
+	} else if plan.PlannedImpact.IsUnknown() {
+		// The planned impact is computed once at plan time, Update carries the planned value into the state
+		plannedImpact, err := getDeliveryGroupPlannedImpact(ctx, r.client, &resp.Diagnostics, plan, state)
+		if err != nil {
+			return
+		}
+		plannedImpact.AddWarning(&resp.Diagnostics, "Delivery Group "+state.Name.ValueString())
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("planned_impact"), plannedImpact.ToModel(ctx, &resp.Diagnostics))...)
+	}
+
 	if r.client.AuthConfig.OnPremises && !plan.DefaultDesktopIcon.IsNull() && plan.DefaultDesktopIcon.ValueString() != "1" {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error %s Delivery Group", operation),
@@ -853,6 +879,16 @@ func (r *deliveryGroupResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return
 	}
 
+	isValid, errMsg = validateSessionPrelaunchAndLingeringSettings(ctx, &resp.Diagnostics, plan, associatedMachineCatalogProperties.SessionSupport)
+
+	if !isValid {
+		resp.Diagnostics.AddError(
+			"Error "+operation+" Delivery Group "+plan.Name.ValueString(),
+			"Error message: "+errMsg,
+		)
+		return
+	}
+
 	if !plan.AutoscaleSettings.IsNull() && len(associatedMachineCatalogs) > 0 && !associatedMachineCatalogProperties.IsPowerManaged {
 		resp.Diagnostics.AddError(
 			"Error "+operation+" Delivery Group "+plan.Name.ValueString(),
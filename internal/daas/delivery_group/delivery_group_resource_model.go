@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -833,6 +834,123 @@ func (DeliveryGroupAppProtection) GetAttributes() map[string]schema.Attribute {
 	return DeliveryGroupAppProtection{}.GetSchema().Attributes
 }
 
+// getSessionTimeoutAndLoadThresholdAttributes returns the timeout and load threshold attributes shared by session prelaunch and session lingering.
+func getSessionTimeoutAndLoadThresholdAttributes(sessionKind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether %s sessions are enabled. Defaults to `true`.", sessionKind),
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+		"disconnect_timeout_minutes": schema.Int32Attribute{
+			Description: fmt.Sprintf("Time in minutes after which a %s session is disconnected.", sessionKind),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+		"terminate_timeout_minutes": schema.Int32Attribute{
+			Description: fmt.Sprintf("Time in minutes after which a %s session is terminated.", sessionKind),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+		"max_average_load_threshold": schema.Int32Attribute{
+			Description: fmt.Sprintf("Average load percentage across the delivery group above which the oldest %s sessions are terminated to reduce load.", sessionKind),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int32{
+				int32validator.Between(0, 100),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+		"max_load_per_machine_threshold": schema.Int32Attribute{
+			Description: fmt.Sprintf("Load percentage of a machine above which the oldest %s sessions on that machine are terminated to reduce load.", sessionKind),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int32{
+				int32validator.Between(0, 100),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// DeliveryGroupSessionPrelaunch maps the session prelaunch settings of a delivery group.
+type DeliveryGroupSessionPrelaunch struct {
+	Enabled                    types.Bool  `tfsdk:"enabled"`
+	AssociatedUsers            types.Set   `tfsdk:"associated_users"` //Set[string]
+	DisconnectTimeoutMinutes   types.Int32 `tfsdk:"disconnect_timeout_minutes"`
+	TerminateTimeoutMinutes    types.Int32 `tfsdk:"terminate_timeout_minutes"`
+	MaxAverageLoadThreshold    types.Int32 `tfsdk:"max_average_load_threshold"`
+	MaxLoadPerMachineThreshold types.Int32 `tfsdk:"max_load_per_machine_threshold"`
+}
+
+func (DeliveryGroupSessionPrelaunch) GetSchema() schema.SingleNestedAttribute {
+	attributes := getSessionTimeoutAndLoadThresholdAttributes("prelaunched")
+	attributes["associated_users"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Description: "Users and groups for whom a session is prelaunched when they log on to Citrix Workspace app. When omitted, sessions are prelaunched for all users of the delivery group." +
+			"\n\n-> **Note** Users must be in SID, SAM account name (`DOMAIN\\UserOrGroupName`), UPN (`user@domain.com`), or Azure AD OID (`OID:/azuread/<object_id>`) format",
+		Optional: true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(
+				validator.String(
+					stringvalidator.RegexMatches(regexp.MustCompile(util.SamUpnSidOidRegex), "must be in SID, SAM account name (`DOMAIN\\UserOrGroupName`), UPN (`user@domain.com`), or Azure AD OID (`OID:/azuread/<object_id>`) format"),
+				),
+			),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: "Session prelaunch settings of the delivery group. A prelaunched session is started when a user logs on to Citrix Workspace app, so that applications launch faster." +
+			"\n\n~> **Please Note** Session prelaunch is only supported for delivery groups that deliver applications from `MultiSession` machine catalogs.",
+		Optional:   true,
+		Attributes: attributes,
+	}
+}
+
+func (DeliveryGroupSessionPrelaunch) GetAttributes() map[string]schema.Attribute {
+	return DeliveryGroupSessionPrelaunch{}.GetSchema().Attributes
+}
+
+// DeliveryGroupSessionLingering maps the session lingering settings of a delivery group.
+type DeliveryGroupSessionLingering struct {
+	Enabled                    types.Bool  `tfsdk:"enabled"`
+	DisconnectTimeoutMinutes   types.Int32 `tfsdk:"disconnect_timeout_minutes"`
+	TerminateTimeoutMinutes    types.Int32 `tfsdk:"terminate_timeout_minutes"`
+	MaxAverageLoadThreshold    types.Int32 `tfsdk:"max_average_load_threshold"`
+	MaxLoadPerMachineThreshold types.Int32 `tfsdk:"max_load_per_machine_threshold"`
+}
+
+func (DeliveryGroupSessionLingering) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Session lingering settings of the delivery group. A lingering session is kept active after the user closes all applications, so that applications launched again start faster." +
+			"\n\n~> **Please Note** Session lingering is only supported for delivery groups that deliver applications from `MultiSession` machine catalogs.",
+		Optional:   true,
+		Attributes: getSessionTimeoutAndLoadThresholdAttributes("lingering"),
+	}
+}
+
+func (DeliveryGroupSessionLingering) GetAttributes() map[string]schema.Attribute {
+	return DeliveryGroupSessionLingering{}.GetSchema().Attributes
+}
+
 var _ util.RefreshableListItemWithAttributes[citrixorchestration.AdvancedAccessPolicyResponseModel] = DeliveryGroupAppProtectionApplyContextuallyModel{}
 
 type DeliveryGroupAppProtectionApplyContextuallyModel struct {
@@ -1183,6 +1301,7 @@ type DeliveryGroupResourceModel struct {
 	AutoscaleSettings           types.Object `tfsdk:"autoscale_settings"`          // DeliveryGroupPowerManagementSettings
 	RebootSchedules             types.List   `tfsdk:"reboot_schedules"`            // List[DeliveryGroupRebootSchedule]
 	TotalMachines               types.Int64  `tfsdk:"total_machines"`
+	PlannedImpact               types.Object `tfsdk:"planned_impact"` // util.PlannedImpactModel
 	MinimumFunctionalLevel      types.String `tfsdk:"minimum_functional_level"`
 	StoreFrontServers           types.Set    `tfsdk:"storefront_servers"` //Set[string]
 	Scopes                      types.Set    `tfsdk:"scopes"`             //Set[String]
@@ -1203,6 +1322,8 @@ type DeliveryGroupResourceModel struct {
 	LoadBalancingType           types.String `tfsdk:"load_balancing_type"`
 	AutoscalePlugins            types.List   `tfsdk:"autoscale_plugins"` //List[DeliveryGroupAutoscalePluginModel]
 	SettlementPeriodBeforeUse   types.Int64  `tfsdk:"settlement_period_before_use"`
+	SessionPrelaunch            types.Object `tfsdk:"session_prelaunch"` // DeliveryGroupSessionPrelaunch
+	SessionLingering            types.Object `tfsdk:"session_lingering"` // DeliveryGroupSessionLingering
 }
 
 func (DeliveryGroupResourceModel) GetSchema() schema.Schema {
@@ -1330,6 +1451,7 @@ func (DeliveryGroupResourceModel) GetSchema() schema.Schema {
 				Description: "The total number of machines in the delivery group.",
 				Computed:    true,
 			},
+			"planned_impact": util.PlannedImpactModel{}.GetSchema(),
 			"minimum_functional_level": schema.StringAttribute{
 				Description: "Specifies the minimum functional level for the VDA machines in the delivery group. Defaults to `L7_20`.",
 				Optional:    true,
@@ -1491,15 +1613,18 @@ func (DeliveryGroupResourceModel) GetSchema() schema.Schema {
 					int64validator.AtLeast(0),
 				},
 			},
+			"session_prelaunch": DeliveryGroupSessionPrelaunch{}.GetSchema(),
+			"session_lingering": DeliveryGroupSessionLingering{}.GetSchema(),
 		},
 	}
 }
 
 func (DeliveryGroupResourceModel) GetAttributesNamesToMask() map[string]bool {
 	return map[string]bool{
-		"allow_list": true,
-		"block_list": true,
-		"users":      true,
+		"allow_list":       true,
+		"block_list":       true,
+		"users":            true,
+		"associated_users": true,
 	}
 }
 
@@ -1560,6 +1685,7 @@ func (r DeliveryGroupResourceModel) RefreshPropertyValues(ctx context.Context, d
 	r = r.updatePlanWithAppProtection(ctx, diagnostics, deliveryGroup)
 	r = r.updatePlanWithAssignMachinesToUsers(ctx, diagnostics, dgMachines)
 	r = r.updatePlanWithAutoscalePlugins(ctx, diagnostics, deliveryGroupAutoscalePlugins)
+	r = r.updatePlanWithSessionPrelaunchAndLingering(ctx, diagnostics, deliveryGroup)
 
 	var defaultAccessPolicies []citrixorchestration.AdvancedAccessPolicyResponseModel
 	var customAccessPolicies []citrixorchestration.AdvancedAccessPolicyResponseModel
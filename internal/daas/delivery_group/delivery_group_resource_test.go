@@ -0,0 +1,147 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package delivery_group_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/delivery_group"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDeliveryGroupModifyPlanPlannedImpact(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeorchestration.NewClient(t)
+
+	catalog := map[string]any{"ProvisioningType": "MCS", "SessionSupport": "SingleSession", "AllocationType": "Random", "IsPowerManaged": true}
+	webCatalog := map[string]any{"Name": "web"}
+	appCatalog := map[string]any{"Name": "app"}
+	for key, value := range catalog {
+		webCatalog[key] = value
+		appCatalog[key] = value
+	}
+	webCatalogId := server.AddObject("MachineCatalogs", webCatalog)
+	appCatalogId := server.AddObject("MachineCatalogs", appCatalog)
+	deliveryGroupId := server.AddObject("DeliveryGroups", map[string]any{"Name": "group"})
+	server.AddObject("Machines", map[string]any{"Name": `DOMAIN\web-01`, "MachineCatalog": webCatalogId, "DeliveryGroup": deliveryGroupId, "SessionCount": 1})
+	server.AddObject("Machines", map[string]any{
+		"Name":           `DOMAIN\app-01`,
+		"MachineCatalog": appCatalogId,
+		"DeliveryGroup":  deliveryGroupId,
+		"SessionCount":   2,
+		"AssignedUsers":  []any{map[string]any{"SamName": `DOMAIN\user3`}},
+	})
+
+	r := delivery_group.NewDeliveryGroupResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	// newValue builds a delivery group with the associated machine catalogs and the allowed and blocked users. The planned
+	// impact is left unknown for planned changes.
+	newValue := func(t *testing.T, planned bool, catalogIds []string, allowList []string, blockList []string) tftypes.Value {
+		t.Helper()
+		diagnostics := diag.Diagnostics{}
+		associatedMachineCatalogs := []delivery_group.DeliveryGroupMachineCatalogModel{}
+		for _, catalogId := range catalogIds {
+			associatedMachineCatalogs = append(associatedMachineCatalogs, delivery_group.DeliveryGroupMachineCatalogModel{
+				MachineCatalog: types.StringValue(catalogId),
+				MachineCount:   types.Int64Value(1),
+			})
+		}
+		restrictedAccessUsers := delivery_group.RestrictedAccessUsers{
+			AllowList: util.StringArrayToStringSet(ctx, &diagnostics, allowList),
+			BlockList: util.StringArrayToStringSet(ctx, &diagnostics, blockList),
+		}
+
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)}
+		diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), deliveryGroupId)...)
+		diagnostics.Append(state.SetAttribute(ctx, path.Root("name"), "group")...)
+		diagnostics.Append(state.SetAttribute(ctx, path.Root("associated_machine_catalogs"), util.TypedArrayToObjectSet(ctx, &diagnostics, associatedMachineCatalogs))...)
+		diagnostics.Append(state.SetAttribute(ctx, path.Root("restricted_access_users"), util.TypedObjectToObjectValue(ctx, &diagnostics, restrictedAccessUsers))...)
+		if planned {
+			// Terraform marks the computed planned impact as unknown when the delivery group changes
+			plannedImpactType := schemaResp.Schema.Attributes["planned_impact"].GetType().(types.ObjectType)
+			diagnostics.Append(state.SetAttribute(ctx, path.Root("planned_impact"), types.ObjectUnknown(plannedImpactType.AttrTypes))...)
+		}
+		if diagnostics.HasError() {
+			t.Fatalf("error building the delivery group: %v", diagnostics)
+		}
+		return state.Raw
+	}
+	current := newValue(t, false, []string{webCatalogId, appCatalogId}, []string{`DOMAIN\user1`, `DOMAIN\user2`}, []string{})
+
+	tests := map[string]struct {
+		state                        tftypes.Value
+		plan                         tftypes.Value
+		expectedWarnings             []string
+		expectedAffectedSessionCount types.Int64
+	}{
+		"create": {
+			state:                        tftypes.NewValue(schemaType, nil),
+			plan:                         newValue(t, true, []string{webCatalogId}, []string{`DOMAIN\user1`}, []string{`DOMAIN\user4`}),
+			expectedAffectedSessionCount: types.Int64Null(),
+		},
+		"unchanged": {
+			state:                        current,
+			plan:                         current,
+			expectedAffectedSessionCount: types.Int64Null(),
+		},
+		"machine catalog and users removed": {
+			state: current,
+			plan:  newValue(t, true, []string{webCatalogId}, []string{`DOMAIN\user1`}, []string{`DOMAIN\user4`}),
+			expectedWarnings: []string{
+				`1 machine(s) will be removed from the delivery group: DOMAIN\app-01.`,
+				`3 user(s) will lose access: DOMAIN\user3, DOMAIN\user2, DOMAIN\user4.`,
+				"2 session(s) will be affected.",
+			},
+			expectedAffectedSessionCount: types.Int64Value(2),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: test.plan}}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: test.state},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: test.plan},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			// The planned impact is known in the plan, so that Update carries it into the state unchanged
+			var affectedSessionCount types.Int64
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("planned_impact").AtName("affected_session_count"), &affectedSessionCount)...)
+			if !affectedSessionCount.Equal(test.expectedAffectedSessionCount) {
+				t.Errorf("expected %s affected sessions in the planned impact, got %s", test.expectedAffectedSessionCount, affectedSessionCount)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(test.expectedWarnings) == 0 {
+				if len(warnings) != 0 {
+					t.Errorf("expected no planned impact, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != "Planned impact on Delivery Group group" {
+				t.Fatalf("expected a single planned impact warning, got %v", warnings)
+			}
+			for _, expected := range test.expectedWarnings {
+				if !strings.Contains(warnings[0].Detail(), expected) {
+					t.Errorf("expected the planned impact to contain %q, got %q", expected, warnings[0].Detail())
+				}
+			}
+		})
+	}
+}
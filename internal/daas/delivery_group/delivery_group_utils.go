@@ -5,6 +5,7 @@ package delivery_group
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -205,6 +206,60 @@ func validatePowerManagementSettings(ctx context.Context, diags *diag.Diagnostic
 	return true, ""
 }
 
+// validateSessionPrelaunchAndLingeringSettings checks the session prelaunch and session lingering settings that are
+// enabled. Disabled settings are accepted on any delivery group, so that they can be turned off before the delivery
+// group is changed to deliver desktops or single session machines.
+func validateSessionPrelaunchAndLingeringSettings(ctx context.Context, diags *diag.Diagnostics, plan DeliveryGroupResourceModel, sessionSupport citrixorchestration.SessionSupport) (bool, string) {
+	var sessionPrelaunch *DeliveryGroupSessionPrelaunch
+	if !plan.SessionPrelaunch.IsNull() && !plan.SessionPrelaunch.IsUnknown() {
+		sessionPrelaunchModel := util.ObjectValueToTypedObject[DeliveryGroupSessionPrelaunch](ctx, diags, plan.SessionPrelaunch)
+		if sessionPrelaunchModel.Enabled.ValueBool() {
+			sessionPrelaunch = &sessionPrelaunchModel
+		}
+	}
+	var sessionLingering *DeliveryGroupSessionLingering
+	if !plan.SessionLingering.IsNull() && !plan.SessionLingering.IsUnknown() {
+		sessionLingeringModel := util.ObjectValueToTypedObject[DeliveryGroupSessionLingering](ctx, diags, plan.SessionLingering)
+		if sessionLingeringModel.Enabled.ValueBool() {
+			sessionLingering = &sessionLingeringModel
+		}
+	}
+
+	if sessionPrelaunch == nil && sessionLingering == nil {
+		return true, ""
+	}
+
+	if sessionSupport == citrixorchestration.SESSIONSUPPORT_SINGLE_SESSION {
+		return false, "session_prelaunch and session_lingering cannot be enabled for a SingleSession catalog"
+	}
+
+	if strings.EqualFold(plan.DeliveryType.ValueString(), string(citrixorchestration.DELIVERYKIND_DESKTOPS_ONLY)) {
+		return false, "session_prelaunch and session_lingering cannot be enabled when delivery_type is DesktopsOnly"
+	}
+
+	if sessionPrelaunch != nil && !isDisconnectTimeoutWithinTerminateTimeout(sessionPrelaunch.DisconnectTimeoutMinutes, sessionPrelaunch.TerminateTimeoutMinutes) {
+		return false, "session_prelaunch disconnect_timeout_minutes cannot be greater than terminate_timeout_minutes"
+	}
+
+	if sessionLingering != nil && !isDisconnectTimeoutWithinTerminateTimeout(sessionLingering.DisconnectTimeoutMinutes, sessionLingering.TerminateTimeoutMinutes) {
+		return false, "session_lingering disconnect_timeout_minutes cannot be greater than terminate_timeout_minutes"
+	}
+
+	return true, ""
+}
+
+// isDisconnectTimeoutWithinTerminateTimeout checks that a session is not disconnected after it has been terminated.
+// A timeout of 0 disables the corresponding action, so it is not compared.
+func isDisconnectTimeoutWithinTerminateTimeout(disconnectTimeout types.Int32, terminateTimeout types.Int32) bool {
+	if disconnectTimeout.IsNull() || disconnectTimeout.IsUnknown() || terminateTimeout.IsNull() || terminateTimeout.IsUnknown() {
+		return true
+	}
+	if disconnectTimeout.ValueInt32() == 0 || terminateTimeout.ValueInt32() == 0 {
+		return true
+	}
+	return disconnectTimeout.ValueInt32() <= terminateTimeout.ValueInt32()
+}
+
 func validateAndReturnMachineCatalogSessionSupport(ctx context.Context, client citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, dgMachineCatalogs []DeliveryGroupMachineCatalogModel, addErrorIfCatalogNotFound bool) (AssociatedMachineCatalogProperties, error) {
 	var provisioningType *citrixorchestration.ProvisioningType
 	var sessionSupport citrixorchestration.SessionSupport
@@ -390,15 +445,12 @@ func addRemoveMachinesFromDeliveryGroup(ctx context.Context, client *citrixdaasc
 	}
 
 	existingAssociatedMachineCatalogsMap := createExistingCatalogsAndMachinesMap(deliveryGroupMachines)
+	associatedMachineCatalogs := util.ObjectSetToTypedArray[DeliveryGroupMachineCatalogModel](ctx, diagnostics, plan.AssociatedMachineCatalogs)
 
-	requestedAssociatedMachineCatalogsMap := map[string]bool{}
-	for _, associatedMachineCatalog := range util.ObjectSetToTypedArray[DeliveryGroupMachineCatalogModel](ctx, diagnostics, plan.AssociatedMachineCatalogs) {
-		requestedAssociatedMachineCatalogsMap[associatedMachineCatalog.MachineCatalog.ValueString()] = true
-
+	for _, associatedMachineCatalog := range associatedMachineCatalogs {
 		associatedMachineCatalogId := associatedMachineCatalog.MachineCatalog.ValueString()
 		requestedCount := int(associatedMachineCatalog.MachineCount.ValueInt64())
-		machineCatalogMachines := existingAssociatedMachineCatalogsMap[associatedMachineCatalogId]
-		existingCount := len(machineCatalogMachines)
+		existingCount := len(existingAssociatedMachineCatalogsMap[associatedMachineCatalogId])
 
 		if requestedCount > existingCount {
 			// add machines
@@ -408,35 +460,39 @@ func addRemoveMachinesFromDeliveryGroup(ctx context.Context, client *citrixdaasc
 				return err
 			}
 		}
+	}
 
-		if requestedCount < existingCount {
-			// remove machines
-			machinesToRemoveCount := existingCount - requestedCount
-			machineCatalogMachines := existingAssociatedMachineCatalogsMap[associatedMachineCatalogId]
-			machinesToRemove := machineCatalogMachines[0:machinesToRemoveCount]
+	machinesToRemove := getDeliveryGroupMachineIdsToRemove(existingAssociatedMachineCatalogsMap, associatedMachineCatalogs)
+	return removeMachinesFromDeliveryGroup(ctx, client, diagnostics, deliveryGroupId, machinesToRemove)
+}
 
-			err := removeMachinesFromDeliveryGroup(ctx, client, diagnostics, deliveryGroupId, machinesToRemove)
+// getDeliveryGroupMachineIdsToRemove returns the ids of the machines to remove from the delivery group, so that each
+// associated machine catalog keeps the requested machine count and machine catalogs no longer associated are emptied.
+func getDeliveryGroupMachineIdsToRemove(existingAssociatedMachineCatalogsMap map[string][]string, associatedMachineCatalogs []DeliveryGroupMachineCatalogModel) []string {
+	machinesToRemove := []string{}
 
-			if err != nil {
-				return err
-			}
+	requestedAssociatedMachineCatalogsMap := map[string]bool{}
+	for _, associatedMachineCatalog := range associatedMachineCatalogs {
+		associatedMachineCatalogId := associatedMachineCatalog.MachineCatalog.ValueString()
+		requestedAssociatedMachineCatalogsMap[associatedMachineCatalogId] = true
+
+		requestedCount := int(associatedMachineCatalog.MachineCount.ValueInt64())
+		machineCatalogMachines := existingAssociatedMachineCatalogsMap[associatedMachineCatalogId]
+		if requestedCount < len(machineCatalogMachines) {
+			// remove machines
+			machinesToRemoveCount := len(machineCatalogMachines) - requestedCount
+			machinesToRemove = append(machinesToRemove, machineCatalogMachines[0:machinesToRemoveCount]...)
 		}
 	}
 
-	for key := range existingAssociatedMachineCatalogsMap {
+	for _, key := range slices.Sorted(maps.Keys(existingAssociatedMachineCatalogsMap)) {
 		if !requestedAssociatedMachineCatalogsMap[key] {
 			// remove all machines from this catalog
-			machinesToRemove := existingAssociatedMachineCatalogsMap[key]
-
-			err := removeMachinesFromDeliveryGroup(ctx, client, diagnostics, deliveryGroupId, machinesToRemove)
-
-			if err != nil {
-				return err
-			}
+			machinesToRemove = append(machinesToRemove, existingAssociatedMachineCatalogsMap[key]...)
 		}
 	}
 
-	return nil
+	return machinesToRemove
 }
 
 func validatePowerTimeSchemes(ctx context.Context, diagnostics *diag.Diagnostics, powerTimeSchemes []DeliveryGroupPowerTimeScheme) {
@@ -634,21 +690,25 @@ func validateRebootSchedules(ctx context.Context, diagnostics *diag.Diagnostics,
 
 		if !rebootSchedule.DeliveryGroupRebootNotificationToUsers.IsNull() {
 			notification := util.ObjectValueToTypedObject[DeliveryGroupRebootNotificationToUsers](ctx, diagnostics, rebootSchedule.DeliveryGroupRebootNotificationToUsers)
-			if !notification.NotificationDurationMinutes.IsUnknown() &&
-				!notification.NotificationDurationMinutes.IsNull() &&
-				!notification.NotificationRepeatEvery5Minutes.IsUnknown() &&
-				!notification.NotificationRepeatEvery5Minutes.IsNull() &&
-				notification.NotificationDurationMinutes.ValueInt64() != 15 {
-				diagnostics.AddAttributeError(
-					path.Root("notification_repeat_every_5_minutes"),
-					"Incorrect Attribute Configuration",
-					"NotificationRepeatEvery5Minutes can only be set to true when NotificationDurationMinutes is 15 minutes",
-				)
-			}
+			notification.ValidateConfig(diagnostics)
 		}
 	}
 }
 
+func (notification DeliveryGroupRebootNotificationToUsers) ValidateConfig(diagnostics *diag.Diagnostics) {
+	if !notification.NotificationDurationMinutes.IsUnknown() &&
+		!notification.NotificationDurationMinutes.IsNull() &&
+		!notification.NotificationRepeatEvery5Minutes.IsUnknown() &&
+		!notification.NotificationRepeatEvery5Minutes.IsNull() &&
+		notification.NotificationDurationMinutes.ValueInt64() != 15 {
+		diagnostics.AddAttributeError(
+			path.Root("notification_repeat_every_5_minutes"),
+			"Incorrect Attribute Configuration",
+			"NotificationRepeatEvery5Minutes can only be set to true when NotificationDurationMinutes is 15 minutes",
+		)
+	}
+}
+
 func getRequestModelForDeliveryGroupCreate(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, plan DeliveryGroupResourceModel, associatedMachineCatalogProperties AssociatedMachineCatalogProperties) (citrixorchestration.CreateDeliveryGroupRequestModel, error) {
 	desktops := util.ObjectListToTypedArray[DeliveryGroupDesktop](ctx, diagnostics, plan.Desktops)
 	deliveryGroupDesktopsArray, err := verifyUsersAndParseDeliveryGroupDesktopsToClientModel(ctx, diagnostics, client, desktops, []DeliveryGroupDesktop{})
@@ -879,6 +939,18 @@ func getRequestModelForDeliveryGroupCreate(ctx context.Context, diagnostics *dia
 
 	body.SetSettlementPeriodBeforeUseSeconds(int32(plan.SettlementPeriodBeforeUse.ValueInt64()))
 
+	if !plan.SessionPrelaunch.IsNull() {
+		prelaunchSettings, err := getSessionPrelaunchRequestModel(ctx, diagnostics, client, plan.SessionPrelaunch)
+		if err != nil {
+			return body, err
+		}
+		body.SetPrelaunchSettings(prelaunchSettings)
+	}
+
+	if !plan.SessionLingering.IsNull() {
+		body.SetLingerSettings(getSessionLingeringRequestModel(ctx, diagnostics, plan.SessionLingering))
+	}
+
 	return body, nil
 }
 
@@ -1217,6 +1289,28 @@ func getRequestModelForDeliveryGroupUpdate(ctx context.Context, diagnostics *dia
 
 	editDeliveryGroupRequestBody.SetSettlementPeriodBeforeUseSeconds(int32(plan.SettlementPeriodBeforeUse.ValueInt64()))
 
+	if !plan.SessionPrelaunch.IsNull() {
+		prelaunchSettings, err := getSessionPrelaunchRequestModel(ctx, diagnostics, client, plan.SessionPrelaunch)
+		if err != nil {
+			return editDeliveryGroupRequestBody, err
+		}
+		editDeliveryGroupRequestBody.SetPrelaunchSettings(prelaunchSettings)
+	} else if !state.SessionPrelaunch.IsNull() {
+		// Block removed from configuration, disable session prelaunch
+		prelaunchSettings := citrixorchestration.FastApplicationSettingsRequestModel{}
+		prelaunchSettings.SetEnabled(false)
+		editDeliveryGroupRequestBody.SetPrelaunchSettings(prelaunchSettings)
+	}
+
+	if !plan.SessionLingering.IsNull() {
+		editDeliveryGroupRequestBody.SetLingerSettings(getSessionLingeringRequestModel(ctx, diagnostics, plan.SessionLingering))
+	} else if !state.SessionLingering.IsNull() {
+		// Block removed from configuration, disable session lingering
+		lingerSettings := citrixorchestration.FastApplicationSettingsRequestModel{}
+		lingerSettings.SetEnabled(false)
+		editDeliveryGroupRequestBody.SetLingerSettings(lingerSettings)
+	}
+
 	return editDeliveryGroupRequestBody, nil
 }
 
@@ -1321,15 +1415,7 @@ func parseDeliveryGroupRebootScheduleToClientModel(ctx context.Context, diags *d
 
 		if !rebootSchedule.DeliveryGroupRebootNotificationToUsers.IsNull() {
 			notification := util.ObjectValueToTypedObject[DeliveryGroupRebootNotificationToUsers](ctx, diags, rebootSchedule.DeliveryGroupRebootNotificationToUsers)
-
-			rebootScheduleRequest.SetWarningDurationMinutes(int32(notification.NotificationDurationMinutes.ValueInt64())) //can only be 1 5 15, or 0 means no warning
-			rebootScheduleRequest.SetWarningTitle(notification.NotificationTitle.ValueString())
-			rebootScheduleRequest.SetWarningMessage(notification.NotificationMessage.ValueString())
-			if notification.NotificationRepeatEvery5Minutes.ValueBool() {
-				rebootScheduleRequest.SetWarningRepeatIntervalMinutes(5)
-			} else {
-				rebootScheduleRequest.SetWarningRepeatIntervalMinutes(0)
-			}
+			setRebootNotificationToUsers(&rebootScheduleRequest, notification)
 		}
 
 		res = append(res, rebootScheduleRequest)
@@ -1338,6 +1424,25 @@ func parseDeliveryGroupRebootScheduleToClientModel(ctx context.Context, diags *d
 	return res
 }
 
+// rebootWarningRequestModel is implemented by the request models of the reboot schedules and reboot cycles.
+type rebootWarningRequestModel interface {
+	SetWarningDurationMinutes(v int32)
+	SetWarningTitle(v string)
+	SetWarningMessage(v string)
+	SetWarningRepeatIntervalMinutes(v int32)
+}
+
+func setRebootNotificationToUsers(request rebootWarningRequestModel, notification DeliveryGroupRebootNotificationToUsers) {
+	request.SetWarningDurationMinutes(int32(notification.NotificationDurationMinutes.ValueInt64())) //can only be 1 5 15, or 0 means no warning
+	request.SetWarningTitle(notification.NotificationTitle.ValueString())
+	request.SetWarningMessage(notification.NotificationMessage.ValueString())
+	if notification.NotificationRepeatEvery5Minutes.ValueBool() {
+		request.SetWarningRepeatIntervalMinutes(5)
+	} else {
+		request.SetWarningRepeatIntervalMinutes(0)
+	}
+}
+
 func (schedule DeliveryGroupRebootSchedule) RefreshListItem(ctx context.Context, diags *diag.Diagnostics, rebootSchedule citrixorchestration.RebootScheduleResponseModel) util.ResourceModelWithAttributes {
 	schedule.Name = types.StringValue(rebootSchedule.GetName())
 	if rebootSchedule.GetDescription() != "" {
@@ -1793,6 +1898,94 @@ func (r DeliveryGroupResourceModel) updatePlanWithAppProtection(ctx context.Cont
 	return r
 }
 
+func (r DeliveryGroupResourceModel) updatePlanWithSessionPrelaunchAndLingering(ctx context.Context, diagnostics *diag.Diagnostics, deliveryGroup *citrixorchestration.DeliveryGroupDetailResponseModel) DeliveryGroupResourceModel {
+	prelaunchSettings := deliveryGroup.GetPrelaunchSettings()
+	// The settings are only refreshed when they are managed, the delivery group has default settings even when they are omitted
+	if !r.SessionPrelaunch.IsNull() {
+		sessionPrelaunch := util.ObjectValueToTypedObject[DeliveryGroupSessionPrelaunch](ctx, diagnostics, r.SessionPrelaunch)
+		sessionPrelaunch.Enabled = types.BoolValue(prelaunchSettings.GetEnabled())
+		sessionPrelaunch.DisconnectTimeoutMinutes = types.Int32Value(prelaunchSettings.GetMaxTimeBeforeDisconnectMinutes())
+		sessionPrelaunch.TerminateTimeoutMinutes = types.Int32Value(prelaunchSettings.GetMaxTimeBeforeTerminateMinutes())
+		sessionPrelaunch.MaxAverageLoadThreshold = types.Int32Value(prelaunchSettings.GetMaxAverageLoadThreshold())
+		sessionPrelaunch.MaxLoadPerMachineThreshold = types.Int32Value(prelaunchSettings.GetMaxLoadPerMachineThreshold())
+		if prelaunchSettings.GetIncludedUserFilterEnabled() {
+			sessionPrelaunch.AssociatedUsers = util.RefreshUsersList(ctx, diagnostics, sessionPrelaunch.AssociatedUsers, prelaunchSettings.GetIncludedUsers())
+		} else {
+			sessionPrelaunch.AssociatedUsers = types.SetNull(types.StringType)
+		}
+		r.SessionPrelaunch = util.TypedObjectToObjectValue(ctx, diagnostics, sessionPrelaunch)
+	} else {
+		if attributes, err := util.ResourceAttributeMapFromObject(DeliveryGroupSessionPrelaunch{}); err == nil {
+			r.SessionPrelaunch = types.ObjectNull(attributes)
+		} else {
+			diagnostics.AddWarning("Error when creating null DeliveryGroupSessionPrelaunch", err.Error())
+		}
+	}
+
+	lingerSettings := deliveryGroup.GetLingerSettings()
+	if !r.SessionLingering.IsNull() {
+		sessionLingering := DeliveryGroupSessionLingering{}
+		sessionLingering.Enabled = types.BoolValue(lingerSettings.GetEnabled())
+		sessionLingering.DisconnectTimeoutMinutes = types.Int32Value(lingerSettings.GetMaxTimeBeforeDisconnectMinutes())
+		sessionLingering.TerminateTimeoutMinutes = types.Int32Value(lingerSettings.GetMaxTimeBeforeTerminateMinutes())
+		sessionLingering.MaxAverageLoadThreshold = types.Int32Value(lingerSettings.GetMaxAverageLoadThreshold())
+		sessionLingering.MaxLoadPerMachineThreshold = types.Int32Value(lingerSettings.GetMaxLoadPerMachineThreshold())
+		r.SessionLingering = util.TypedObjectToObjectValue(ctx, diagnostics, sessionLingering)
+	} else {
+		if attributes, err := util.ResourceAttributeMapFromObject(DeliveryGroupSessionLingering{}); err == nil {
+			r.SessionLingering = types.ObjectNull(attributes)
+		} else {
+			diagnostics.AddWarning("Error when creating null DeliveryGroupSessionLingering", err.Error())
+		}
+	}
+
+	return r
+}
+
+func getSessionPrelaunchRequestModel(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, sessionPrelaunchObject types.Object) (citrixorchestration.FastApplicationSettingsRequestModel, error) {
+	sessionPrelaunch := util.ObjectValueToTypedObject[DeliveryGroupSessionPrelaunch](ctx, diagnostics, sessionPrelaunchObject)
+	prelaunchSettings := getFastApplicationSettingsRequestModel(sessionPrelaunch.Enabled, sessionPrelaunch.DisconnectTimeoutMinutes, sessionPrelaunch.TerminateTimeoutMinutes, sessionPrelaunch.MaxAverageLoadThreshold, sessionPrelaunch.MaxLoadPerMachineThreshold)
+
+	associatedUsers := util.StringSetToStringArray(ctx, diagnostics, sessionPrelaunch.AssociatedUsers)
+	associatedUserIds := []string{}
+	if len(associatedUsers) > 0 {
+		var err error
+		associatedUserIds, _, err = util.GetUserIdsUsingIdentity(ctx, client, diagnostics, associatedUsers, "Error fetching user details for delivery group session prelaunch")
+		if err != nil {
+			return prelaunchSettings, err
+		}
+	}
+	prelaunchSettings.SetIncludedUserFilterEnabled(len(associatedUserIds) > 0)
+	prelaunchSettings.SetIncludedUsers(associatedUserIds)
+
+	return prelaunchSettings, nil
+}
+
+func getSessionLingeringRequestModel(ctx context.Context, diagnostics *diag.Diagnostics, sessionLingeringObject types.Object) citrixorchestration.FastApplicationSettingsRequestModel {
+	sessionLingering := util.ObjectValueToTypedObject[DeliveryGroupSessionLingering](ctx, diagnostics, sessionLingeringObject)
+	return getFastApplicationSettingsRequestModel(sessionLingering.Enabled, sessionLingering.DisconnectTimeoutMinutes, sessionLingering.TerminateTimeoutMinutes, sessionLingering.MaxAverageLoadThreshold, sessionLingering.MaxLoadPerMachineThreshold)
+}
+
+// getFastApplicationSettingsRequestModel builds the settings shared by session prelaunch and session lingering.
+// Timeouts and thresholds not set in the plan are omitted so that the remote defaults are kept.
+func getFastApplicationSettingsRequestModel(enabled types.Bool, disconnectTimeout types.Int32, terminateTimeout types.Int32, maxAverageLoadThreshold types.Int32, maxLoadPerMachineThreshold types.Int32) citrixorchestration.FastApplicationSettingsRequestModel {
+	settings := citrixorchestration.FastApplicationSettingsRequestModel{}
+	settings.SetEnabled(enabled.ValueBool())
+	if !disconnectTimeout.IsNull() && !disconnectTimeout.IsUnknown() {
+		settings.SetMaxTimeBeforeDisconnectMinutes(disconnectTimeout.ValueInt32())
+	}
+	if !terminateTimeout.IsNull() && !terminateTimeout.IsUnknown() {
+		settings.SetMaxTimeBeforeTerminateMinutes(terminateTimeout.ValueInt32())
+	}
+	if !maxAverageLoadThreshold.IsNull() && !maxAverageLoadThreshold.IsUnknown() {
+		settings.SetMaxAverageLoadThreshold(maxAverageLoadThreshold.ValueInt32())
+	}
+	if !maxLoadPerMachineThreshold.IsNull() && !maxLoadPerMachineThreshold.IsUnknown() {
+		settings.SetMaxLoadPerMachineThreshold(maxLoadPerMachineThreshold.ValueInt32())
+	}
+	return settings
+}
+
 func updateDeliveryGroupAndDesktopUsers(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, deliveryGroup *citrixorchestration.DeliveryGroupDetailResponseModel, deliveryGroupDesktops *citrixorchestration.DesktopResponseModelCollection) (*citrixorchestration.DeliveryGroupDetailResponseModel, *citrixorchestration.DesktopResponseModelCollection, error) {
 	updatedDeliveryGroupDesktops := []citrixorchestration.DesktopResponseModel{}
 	for _, desktop := range deliveryGroupDesktops.GetItems() {
@@ -2691,6 +2884,7 @@ func (r *deliveryGroupResource) updateDeliveryGroupState(ctx context.Context, re
 	// Set state to fully populated data
 	diags := resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -278,3 +279,213 @@ func TestValidateRebootSchedulesMultiple(t *testing.T) {
 		t.Fatalf("expected exactly 2 accumulated errors across 2 schedules, got %d: %s", got, diags)
 	}
 }
+
+func TestGetDeliveryGroupMachineIdsToRemove(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string][]string{
+		"catalog-a": {"a-1", "a-2", "a-3"},
+		"catalog-b": {"b-1"},
+		"catalog-c": {"c-1", "c-2"},
+	}
+	associatedMachineCatalog := func(catalog string, count int64) DeliveryGroupMachineCatalogModel {
+		return DeliveryGroupMachineCatalogModel{
+			MachineCatalog: types.StringValue(catalog),
+			MachineCount:   types.Int64Value(count),
+		}
+	}
+
+	tests := map[string]struct {
+		requested []DeliveryGroupMachineCatalogModel
+		expected  []string
+	}{
+		"unchanged": {
+			requested: []DeliveryGroupMachineCatalogModel{associatedMachineCatalog("catalog-a", 3), associatedMachineCatalog("catalog-b", 1), associatedMachineCatalog("catalog-c", 2)},
+			expected:  []string{},
+		},
+		"reduced machine count": {
+			requested: []DeliveryGroupMachineCatalogModel{associatedMachineCatalog("catalog-a", 1), associatedMachineCatalog("catalog-b", 1), associatedMachineCatalog("catalog-c", 2)},
+			expected:  []string{"a-1", "a-2"},
+		},
+		"increased machine count": {
+			requested: []DeliveryGroupMachineCatalogModel{associatedMachineCatalog("catalog-a", 5), associatedMachineCatalog("catalog-b", 1), associatedMachineCatalog("catalog-c", 2)},
+			expected:  []string{},
+		},
+		"catalogs no longer associated": {
+			requested: []DeliveryGroupMachineCatalogModel{associatedMachineCatalog("catalog-b", 1)},
+			expected:  []string{"a-1", "a-2", "a-3", "c-1", "c-2"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			machineIds := getDeliveryGroupMachineIdsToRemove(existing, test.requested)
+			if strings.Join(machineIds, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected machines %v, got %v", test.expected, machineIds)
+			}
+		})
+	}
+}
+
+func TestValidateSessionPrelaunchAndLingeringSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var setupDiags diag.Diagnostics
+	sessionPrelaunch := func(enabled bool, disconnectTimeout types.Int32, terminateTimeout types.Int32) types.Object {
+		return util.TypedObjectToObjectValue(ctx, &setupDiags, DeliveryGroupSessionPrelaunch{
+			Enabled:                    types.BoolValue(enabled),
+			AssociatedUsers:            types.SetNull(types.StringType),
+			DisconnectTimeoutMinutes:   disconnectTimeout,
+			TerminateTimeoutMinutes:    terminateTimeout,
+			MaxAverageLoadThreshold:    types.Int32Unknown(),
+			MaxLoadPerMachineThreshold: types.Int32Unknown(),
+		})
+	}
+	sessionLingering := func(enabled bool, disconnectTimeout types.Int32, terminateTimeout types.Int32) types.Object {
+		return util.TypedObjectToObjectValue(ctx, &setupDiags, DeliveryGroupSessionLingering{
+			Enabled:                    types.BoolValue(enabled),
+			DisconnectTimeoutMinutes:   disconnectTimeout,
+			TerminateTimeoutMinutes:    terminateTimeout,
+			MaxAverageLoadThreshold:    types.Int32Value(80),
+			MaxLoadPerMachineThreshold: types.Int32Value(90),
+		})
+	}
+	nullSessionPrelaunchAttributes, _ := util.ResourceAttributeMapFromObject(DeliveryGroupSessionPrelaunch{})
+	nullSessionLingeringAttributes, _ := util.ResourceAttributeMapFromObject(DeliveryGroupSessionLingering{})
+	nullSessionPrelaunch := types.ObjectNull(nullSessionPrelaunchAttributes)
+	nullSessionLingering := types.ObjectNull(nullSessionLingeringAttributes)
+	if setupDiags.HasError() {
+		t.Fatalf("failed to construct session settings: %s", setupDiags)
+	}
+
+	tests := map[string]struct {
+		sessionPrelaunch types.Object
+		sessionLingering types.Object
+		deliveryType     types.String
+		sessionSupport   citrixorchestration.SessionSupport
+		errorContains    string
+	}{
+		"no session settings on single session catalog": {
+			sessionPrelaunch: nullSessionPrelaunch,
+			sessionLingering: nullSessionLingering,
+			deliveryType:     types.StringValue("DesktopsOnly"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_SINGLE_SESSION,
+		},
+		"prelaunch and lingering on multi session catalog": {
+			sessionPrelaunch: sessionPrelaunch(true, types.Int32Value(60), types.Int32Value(120)),
+			sessionLingering: sessionLingering(true, types.Int32Unknown(), types.Int32Value(120)),
+			deliveryType:     types.StringValue("AppsOnly"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_MULTI_SESSION,
+		},
+		"disabled disconnect timeout": {
+			sessionPrelaunch: sessionPrelaunch(true, types.Int32Value(60), types.Int32Value(0)),
+			sessionLingering: nullSessionLingering,
+			deliveryType:     types.StringUnknown(),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_MULTI_SESSION,
+		},
+		"disabled prelaunch on single session catalog": {
+			sessionPrelaunch: sessionPrelaunch(false, types.Int32Value(180), types.Int32Value(120)),
+			sessionLingering: nullSessionLingering,
+			deliveryType:     types.StringValue("AppsOnly"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_SINGLE_SESSION,
+		},
+		"disabled lingering for desktops only": {
+			sessionPrelaunch: nullSessionPrelaunch,
+			sessionLingering: sessionLingering(false, types.Int32Value(60), types.Int32Value(120)),
+			deliveryType:     types.StringValue("DesktopsOnly"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_MULTI_SESSION,
+		},
+		"prelaunch on single session catalog": {
+			sessionPrelaunch: sessionPrelaunch(true, types.Int32Value(60), types.Int32Value(120)),
+			sessionLingering: nullSessionLingering,
+			deliveryType:     types.StringValue("AppsOnly"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_SINGLE_SESSION,
+			errorContains:    "SingleSession catalog",
+		},
+		"lingering for desktops only": {
+			sessionPrelaunch: nullSessionPrelaunch,
+			sessionLingering: sessionLingering(true, types.Int32Value(60), types.Int32Value(120)),
+			deliveryType:     types.StringValue("DesktopsOnly"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_MULTI_SESSION,
+			errorContains:    "DesktopsOnly",
+		},
+		"prelaunch disconnect after terminate": {
+			sessionPrelaunch: sessionPrelaunch(true, types.Int32Value(180), types.Int32Value(120)),
+			sessionLingering: nullSessionLingering,
+			deliveryType:     types.StringValue("AppsOnly"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_MULTI_SESSION,
+			errorContains:    "session_prelaunch disconnect_timeout_minutes",
+		},
+		"lingering disconnect after terminate": {
+			sessionPrelaunch: nullSessionPrelaunch,
+			sessionLingering: sessionLingering(true, types.Int32Value(180), types.Int32Value(120)),
+			deliveryType:     types.StringValue("DesktopsAndApps"),
+			sessionSupport:   citrixorchestration.SESSIONSUPPORT_MULTI_SESSION,
+			errorContains:    "session_lingering disconnect_timeout_minutes",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			isValid, errMsg := validateSessionPrelaunchAndLingeringSettings(ctx, &diags, DeliveryGroupResourceModel{
+				DeliveryType:     test.deliveryType,
+				SessionPrelaunch: test.sessionPrelaunch,
+				SessionLingering: test.sessionLingering,
+			}, test.sessionSupport)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+			if test.errorContains == "" {
+				if !isValid {
+					t.Fatalf("expected valid settings, got: %s", errMsg)
+				}
+			} else if isValid || !strings.Contains(errMsg, test.errorContains) {
+				t.Fatalf("expected error containing %q, got valid=%t: %s", test.errorContains, isValid, errMsg)
+			}
+		})
+	}
+}
+
+func TestUpdatePlanWithSessionPrelaunchAndLingering(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	settings := citrixorchestration.FastApplicationSettingsResponseModel{}
+	settings.SetEnabled(true)
+	settings.SetMaxTimeBeforeDisconnectMinutes(60)
+	settings.SetMaxTimeBeforeTerminateMinutes(120)
+	deliveryGroup := &citrixorchestration.DeliveryGroupDetailResponseModel{}
+	deliveryGroup.SetPrelaunchSettings(settings)
+	deliveryGroup.SetLingerSettings(settings)
+
+	var diags diag.Diagnostics
+	nullSessionPrelaunchAttributes, _ := util.ResourceAttributeMapFromObject(DeliveryGroupSessionPrelaunch{})
+	sessionLingering := util.TypedObjectToObjectValue(ctx, &diags, DeliveryGroupSessionLingering{
+		Enabled:                    types.BoolValue(true),
+		DisconnectTimeoutMinutes:   types.Int32Unknown(),
+		TerminateTimeoutMinutes:    types.Int32Value(120),
+		MaxAverageLoadThreshold:    types.Int32Unknown(),
+		MaxLoadPerMachineThreshold: types.Int32Unknown(),
+	})
+	r := DeliveryGroupResourceModel{
+		SessionPrelaunch: types.ObjectNull(nullSessionPrelaunchAttributes),
+		SessionLingering: sessionLingering,
+	}.updatePlanWithSessionPrelaunchAndLingering(ctx, &diags, deliveryGroup)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if !r.SessionPrelaunch.IsNull() {
+		t.Errorf("expected session_prelaunch to stay unset when it is not managed, got %s", r.SessionPrelaunch)
+	}
+	refreshedSessionLingering := util.ObjectValueToTypedObject[DeliveryGroupSessionLingering](ctx, &diags, r.SessionLingering)
+	if refreshedSessionLingering.DisconnectTimeoutMinutes.ValueInt32() != 60 {
+		t.Errorf("expected session_lingering to be refreshed from the delivery group, got %s", r.SessionLingering)
+	}
+}
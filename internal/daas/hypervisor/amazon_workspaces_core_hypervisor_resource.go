@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &amazonWorkSpacesCoreHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &amazonWorkSpacesCoreHypervisorResource{}
 	_ resource.ResourceWithImportState    = &amazonWorkSpacesCoreHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &amazonWorkSpacesCoreHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &amazonWorkSpacesCoreHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &amazonWorkSpacesCoreHypervisorResource{}
 )
@@ -46,6 +47,11 @@ func (r *amazonWorkSpacesCoreHypervisorResource) Schema(_ context.Context, _ res
 	resp.Schema = AmazonWorkSpacesCoreHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *amazonWorkSpacesCoreHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *amazonWorkSpacesCoreHypervisorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -121,6 +127,7 @@ func (r *amazonWorkSpacesCoreHypervisorResource) Create(ctx context.Context, req
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -129,6 +136,7 @@ func (r *amazonWorkSpacesCoreHypervisorResource) Create(ctx context.Context, req
 // Read refreshes the Terraform state with the latest data.
 func (r *amazonWorkSpacesCoreHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state AmazonWorkSpacesCoreHypervisorResourceModel
@@ -167,6 +175,7 @@ func (r *amazonWorkSpacesCoreHypervisorResource) Read(ctx context.Context, req r
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *amazonWorkSpacesCoreHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan AmazonWorkSpacesCoreHypervisorResourceModel
@@ -302,7 +311,7 @@ func (r *amazonWorkSpacesCoreHypervisorResource) Delete(ctx context.Context, req
 
 func (r *amazonWorkSpacesCoreHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *amazonWorkSpacesCoreHypervisorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
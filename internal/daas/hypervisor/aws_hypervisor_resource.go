@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &awsHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &awsHypervisorResource{}
 	_ resource.ResourceWithImportState    = &awsHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &awsHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &awsHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &awsHypervisorResource{}
 )
@@ -46,6 +47,11 @@ func (r *awsHypervisorResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = AwsHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *awsHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *awsHypervisorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -123,6 +129,7 @@ func (r *awsHypervisorResource) Create(ctx context.Context, req resource.CreateR
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -131,6 +138,7 @@ func (r *awsHypervisorResource) Create(ctx context.Context, req resource.CreateR
 // Read refreshes the Terraform state with the latest data.
 func (r *awsHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state AwsHypervisorResourceModel
@@ -169,6 +177,7 @@ func (r *awsHypervisorResource) Read(ctx context.Context, req resource.ReadReque
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *awsHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan AwsHypervisorResourceModel
@@ -299,7 +308,7 @@ func (r *awsHypervisorResource) Delete(ctx context.Context, req resource.DeleteR
 
 func (r *awsHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *awsHypervisorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -29,6 +29,7 @@ var (
 	_ resource.Resource                   = &azureHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &azureHypervisorResource{}
 	_ resource.ResourceWithImportState    = &azureHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &azureHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &azureHypervisorResource{}
 )
 
@@ -52,6 +53,11 @@ func (r *azureHypervisorResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = AzureHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *azureHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *azureHypervisorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -150,6 +156,7 @@ func (r *azureHypervisorResource) Create(ctx context.Context, req resource.Creat
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -158,6 +165,7 @@ func (r *azureHypervisorResource) Create(ctx context.Context, req resource.Creat
 // Read refreshes the Terraform state with the latest data.
 func (r *azureHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state AzureHypervisorResourceModel
@@ -196,6 +204,7 @@ func (r *azureHypervisorResource) Read(ctx context.Context, req resource.ReadReq
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *azureHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan AzureHypervisorResourceModel
@@ -368,7 +377,7 @@ func (r *azureHypervisorResource) Delete(ctx context.Context, req resource.Delet
 
 func (r *azureHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func getMetadataForAzureRmHypervisor(plan AzureHypervisorResourceModel) []citrixorchestration.NameValueStringPairModel {
@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &gcpHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &gcpHypervisorResource{}
 	_ resource.ResourceWithImportState    = &gcpHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &gcpHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &gcpHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &gcpHypervisorResource{}
 )
@@ -46,6 +47,11 @@ func (r *gcpHypervisorResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = GcpHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *gcpHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *gcpHypervisorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -123,6 +129,7 @@ func (r *gcpHypervisorResource) Create(ctx context.Context, req resource.CreateR
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -131,6 +138,7 @@ func (r *gcpHypervisorResource) Create(ctx context.Context, req resource.CreateR
 // Read refreshes the Terraform state with the latest data.
 func (r *gcpHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state GcpHypervisorResourceModel
@@ -169,6 +177,7 @@ func (r *gcpHypervisorResource) Read(ctx context.Context, req resource.ReadReque
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *gcpHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan GcpHypervisorResourceModel
@@ -299,7 +308,7 @@ func (r *gcpHypervisorResource) Delete(ctx context.Context, req resource.DeleteR
 
 func (r *gcpHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *gcpHypervisorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                   = &hpeMoonshotHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &hpeMoonshotHypervisorResource{}
 	_ resource.ResourceWithImportState    = &hpeMoonshotHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &hpeMoonshotHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &hpeMoonshotHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &hpeMoonshotHypervisorResource{}
 )
@@ -51,10 +52,15 @@ func (r *hpeMoonshotHypervisorResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = HpeMoonshotHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *hpeMoonshotHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (*hpeMoonshotHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // Create implements resource.Resource.
@@ -119,6 +125,7 @@ func (r *hpeMoonshotHypervisorResource) Create(ctx context.Context, req resource
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -127,6 +134,7 @@ func (r *hpeMoonshotHypervisorResource) Create(ctx context.Context, req resource
 // Read implements resource.Resource.
 func (r *hpeMoonshotHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state HpeMoonshotHypervisorResourceModel
@@ -165,6 +173,7 @@ func (r *hpeMoonshotHypervisorResource) Read(ctx context.Context, req resource.R
 // Update implements resource.Resource.
 func (r *hpeMoonshotHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan HpeMoonshotHypervisorResourceModel
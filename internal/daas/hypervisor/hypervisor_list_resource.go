@@ -0,0 +1,114 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package hypervisor
+
+import (
+	"context"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &hypervisorListResource{}
+	_ list.ListResourceWithConfigure = &hypervisorListResource{}
+)
+
+func NewAzureHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_azure_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM, newResource: NewAzureHypervisorResource}
+}
+
+func NewAwsHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_aws_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS, newResource: NewAwsHypervisorResource}
+}
+
+func NewAmazonWorkSpacesCoreHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_amazon_workspaces_core_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE, newResource: NewAmazonWorkSpacesCoreHypervisorResource}
+}
+
+func NewGcpHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_gcp_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM, newResource: NewGcpHypervisorResource}
+}
+
+func NewVsphereHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_vsphere_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER, newResource: NewVsphereHypervisorResource}
+}
+
+func NewXenserverHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_xenserver_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER, newResource: NewXenserverHypervisorResource}
+}
+
+func NewSCVMMHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_scvmm_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM, newResource: NewSCVMMHypervisorResource}
+}
+
+func NewOpenShiftHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_openshift_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT, newResource: NewOpenShiftHypervisorResource}
+}
+
+func NewNutanixHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_nutanix_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM, pluginId: util.NUTANIX_PLUGIN_ID, newResource: NewNutanixHypervisorResource}
+}
+
+func NewHpeMoonshotHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_hpe_moonshot_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM, pluginId: util.HPE_MOONSHOT_PLUGIN_ID, newResource: NewHpeMoonshotHypervisorResource}
+}
+
+func NewRemotePCWakeOnLANHypervisorListResource() list.ListResource {
+	return &hypervisorListResource{typeName: "_remote_pc_wake_on_lan_hypervisor", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM, pluginId: util.REMOTE_PC_WAKE_ON_LAN_PLUGIN_ID, newResource: NewRemotePCWakeOnLANHypervisorResource}
+}
+
+// hypervisorListResource lists the hypervisors of a single connection type.
+// Custom connections are further distinguished by their plugin id.
+type hypervisorListResource struct {
+	client         *citrixdaasclient.CitrixDaasClient
+	typeName       string
+	connectionType citrixorchestration.HypervisorConnectionType
+	pluginId       string
+	newResource    func() resource.Resource
+}
+
+// Metadata returns the list resource type name.
+func (r *hypervisorListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *hypervisorListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("hypervisors")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *hypervisorListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the hypervisors of the site with a matching connection type.
+func (r *hypervisorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	hypervisors, _ := util.GetHypervisors(ctx, r.client, &diags)
+	items := []util.ListResourceItem{}
+	for _, hypervisor := range hypervisors {
+		if !util.IsHypervisorOfConnectionType(hypervisor, r.connectionType, r.pluginId) {
+			continue
+		}
+		items = append(items, util.ListResourceItem{Id: hypervisor.GetId(), DisplayName: hypervisor.GetName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, r.newResource, r.client)
+}
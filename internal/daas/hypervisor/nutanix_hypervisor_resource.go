@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                   = &nutanixHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &nutanixHypervisorResource{}
 	_ resource.ResourceWithImportState    = &nutanixHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &nutanixHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &nutanixHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &nutanixHypervisorResource{}
 )
@@ -51,10 +52,15 @@ func (r *nutanixHypervisorResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = NutanixHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *nutanixHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (*nutanixHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // Create implements resource.Resource.
@@ -113,6 +119,7 @@ func (r *nutanixHypervisorResource) Create(ctx context.Context, req resource.Cre
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -121,6 +128,7 @@ func (r *nutanixHypervisorResource) Create(ctx context.Context, req resource.Cre
 // Read implements resource.Resource.
 func (r *nutanixHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state NutanixHypervisorResourceModel
@@ -159,6 +167,7 @@ func (r *nutanixHypervisorResource) Read(ctx context.Context, req resource.ReadR
 // Update implements resource.Resource.
 func (r *nutanixHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan NutanixHypervisorResourceModel
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                   = &openshiftHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &openshiftHypervisorResource{}
 	_ resource.ResourceWithImportState    = &openshiftHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &openshiftHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &openshiftHypervisorResource{}
 )
 
@@ -47,6 +48,11 @@ func (r *openshiftHypervisorResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = OpenShiftHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *openshiftHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *openshiftHypervisorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -104,6 +110,7 @@ func (r *openshiftHypervisorResource) Create(ctx context.Context, req resource.C
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -112,6 +119,7 @@ func (r *openshiftHypervisorResource) Create(ctx context.Context, req resource.C
 // Read refreshes the Terraform state with the latest data.
 func (r *openshiftHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state OpenShiftHypervisorResourceModel
@@ -150,6 +158,7 @@ func (r *openshiftHypervisorResource) Read(ctx context.Context, req resource.Rea
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *openshiftHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan OpenShiftHypervisorResourceModel
@@ -236,7 +245,7 @@ func (r *openshiftHypervisorResource) Delete(ctx context.Context, req resource.D
 
 func (r *openshiftHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *openshiftHypervisorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                   = &remotePCWakeOnLANHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &remotePCWakeOnLANHypervisorResource{}
 	_ resource.ResourceWithImportState    = &remotePCWakeOnLANHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &remotePCWakeOnLANHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &remotePCWakeOnLANHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &remotePCWakeOnLANHypervisorResource{}
 )
@@ -51,10 +52,15 @@ func (r *remotePCWakeOnLANHypervisorResource) Schema(_ context.Context, _ resour
 	resp.Schema = RemotePCWakeOnLANHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *remotePCWakeOnLANHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (*remotePCWakeOnLANHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // Create implements resource.Resource.
@@ -105,6 +111,7 @@ func (r *remotePCWakeOnLANHypervisorResource) Create(ctx context.Context, req re
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -113,6 +120,7 @@ func (r *remotePCWakeOnLANHypervisorResource) Create(ctx context.Context, req re
 // Read implements resource.Resource.
 func (r *remotePCWakeOnLANHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from current state
 	var state RemotePCWakeOnLANHypervisorResourceModel
@@ -153,6 +161,7 @@ func (r *remotePCWakeOnLANHypervisorResource) Read(ctx context.Context, req reso
 // Update implements resource.Resource.
 func (r *remotePCWakeOnLANHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan RemotePCWakeOnLANHypervisorResourceModel
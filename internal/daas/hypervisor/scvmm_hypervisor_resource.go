@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                   = &scvmmHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &scvmmHypervisorResource{}
 	_ resource.ResourceWithImportState    = &scvmmHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &scvmmHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &scvmmHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &scvmmHypervisorResource{}
 )
@@ -51,9 +52,14 @@ func (*scvmmHypervisorResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = SCVMMMHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (*scvmmHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (*scvmmHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *scvmmHypervisorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -133,6 +139,7 @@ func (r *scvmmHypervisorResource) Create(ctx context.Context, req resource.Creat
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -141,6 +148,7 @@ func (r *scvmmHypervisorResource) Create(ctx context.Context, req resource.Creat
 // Read implements resource.Resource.
 func (r *scvmmHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state SCVMMMHypervisorResourceModel
@@ -179,6 +187,7 @@ func (r *scvmmHypervisorResource) Read(ctx context.Context, req resource.ReadReq
 // Update implements resource.Resource.
 func (r *scvmmHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan SCVMMMHypervisorResourceModel
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                   = &vsphereHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &vsphereHypervisorResource{}
 	_ resource.ResourceWithImportState    = &vsphereHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &vsphereHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &vsphereHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &vsphereHypervisorResource{}
 )
@@ -51,10 +52,15 @@ func (r *vsphereHypervisorResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = VsphereHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *vsphereHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (*vsphereHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // Create implements resource.Resource.
@@ -117,6 +123,7 @@ func (r *vsphereHypervisorResource) Create(ctx context.Context, req resource.Cre
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -125,6 +132,7 @@ func (r *vsphereHypervisorResource) Create(ctx context.Context, req resource.Cre
 // Read implements resource.Resource.
 func (r *vsphereHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state VsphereHypervisorResourceModel
@@ -163,6 +171,7 @@ func (r *vsphereHypervisorResource) Read(ctx context.Context, req resource.ReadR
 // Update implements resource.Resource.
 func (r *vsphereHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan VsphereHypervisorResourceModel
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                   = &xenserverHypervisorResource{}
 	_ resource.ResourceWithConfigure      = &xenserverHypervisorResource{}
 	_ resource.ResourceWithImportState    = &xenserverHypervisorResource{}
+	_ resource.ResourceWithIdentity       = &xenserverHypervisorResource{}
 	_ resource.ResourceWithValidateConfig = &xenserverHypervisorResource{}
 	_ resource.ResourceWithModifyPlan     = &xenserverHypervisorResource{}
 )
@@ -51,10 +52,15 @@ func (r *xenserverHypervisorResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = XenserverHypervisorResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *xenserverHypervisorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (*xenserverHypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // Create implements resource.Resource.
@@ -118,6 +124,7 @@ func (r *xenserverHypervisorResource) Create(ctx context.Context, req resource.C
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -126,6 +133,7 @@ func (r *xenserverHypervisorResource) Create(ctx context.Context, req resource.C
 // Read implements resource.Resource.
 func (r *xenserverHypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state XenserverHypervisorResourceModel
@@ -164,6 +172,7 @@ func (r *xenserverHypervisorResource) Read(ctx context.Context, req resource.Rea
 // Update implements resource.Resource.
 func (r *xenserverHypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan XenserverHypervisorResourceModel
@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &amazonWorkSpacesCoreHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &amazonWorkSpacesCoreHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &amazonWorkSpacesCoreHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &amazonWorkSpacesCoreHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &amazonWorkSpacesCoreHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &amazonWorkSpacesCoreHypervisorResourcePoolResource{}
 )
@@ -45,6 +46,11 @@ func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Schema(_ context.Co
 	resp.Schema = AmazonWorkSpacesHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -125,6 +131,7 @@ func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Create(ctx context.
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -132,6 +139,7 @@ func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Create(ctx context.
 
 func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state AmazonWorkSpacesHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -162,6 +170,7 @@ func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Read(ctx context.Co
 
 func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan AmazonWorkSpacesHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -218,12 +227,17 @@ func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) Update(ctx context.
 func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -232,7 +246,7 @@ func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) ImportState(ctx con
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -261,17 +275,17 @@ func (r *amazonWorkSpacesCoreHypervisorResourcePoolResource) ImportState(ctx con
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &awsHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &awsHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &awsHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &awsHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &awsHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &awsHypervisorResourcePoolResource{}
 )
@@ -45,6 +46,11 @@ func (r *awsHypervisorResourcePoolResource) Schema(_ context.Context, _ resource
 	resp.Schema = AwsHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *awsHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *awsHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -125,6 +131,7 @@ func (r *awsHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -132,6 +139,7 @@ func (r *awsHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 
 func (r *awsHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state AwsHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -162,6 +170,7 @@ func (r *awsHypervisorResourcePoolResource) Read(ctx context.Context, req resour
 
 func (r *awsHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan AwsHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -218,12 +227,17 @@ func (r *awsHypervisorResourcePoolResource) Update(ctx context.Context, req reso
 func (r *awsHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -232,7 +246,7 @@ func (r *awsHypervisorResourcePoolResource) ImportState(ctx context.Context, req
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -261,17 +275,17 @@ func (r *awsHypervisorResourcePoolResource) ImportState(ctx context.Context, req
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
@@ -339,4 +353,26 @@ func (r *awsHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, req
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
 	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AwsHypervisorResourcePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateResourcePoolHypervisorResources(ctx, r.client, &resp.Diagnostics, plan.Hypervisor, func(*citrixorchestration.HypervisorDetailResponseModel) (string, bool) {
+		if plan.Vpc.IsUnknown() || plan.AvailabilityZone.IsUnknown() {
+			return "", false
+		}
+		return fmt.Sprintf("%s.virtualprivatecloud/%s.availabilityzone", plan.Vpc.ValueString(), plan.AvailabilityZone.ValueString()), true
+	}, []resourcePoolResourceList{
+		{Attribute: "subnets", ResourceType: util.NetworkResourceType, Description: "Subnet", Plan: plan.Subnets, State: state.Subnets},
+	})
 }
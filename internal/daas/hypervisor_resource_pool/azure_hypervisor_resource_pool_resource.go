@@ -12,6 +12,7 @@ import (
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +23,7 @@ var (
 	_ resource.Resource                   = &azureHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &azureHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &azureHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &azureHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &azureHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &azureHypervisorResourcePoolResource{}
 )
@@ -45,6 +47,11 @@ func (r *azureHypervisorResourcePoolResource) Schema(_ context.Context, _ resour
 	resp.Schema = AzureHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *azureHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *azureHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -151,6 +158,7 @@ func (r *azureHypervisorResourcePoolResource) Create(ctx context.Context, req re
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -158,6 +166,7 @@ func (r *azureHypervisorResourcePoolResource) Create(ctx context.Context, req re
 
 func (r *azureHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state AzureHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -188,6 +197,7 @@ func (r *azureHypervisorResourcePoolResource) Read(ctx context.Context, req reso
 
 func (r *azureHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan AzureHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -247,12 +257,17 @@ func (r *azureHypervisorResourcePoolResource) Update(ctx context.Context, req re
 func (r *azureHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -261,7 +276,7 @@ func (r *azureHypervisorResourcePoolResource) ImportState(ctx context.Context, r
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -290,17 +305,17 @@ func (r *azureHypervisorResourcePoolResource) ImportState(ctx context.Context, r
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
@@ -372,4 +387,36 @@ func (r *azureHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, re
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
 	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AzureHypervisorResourcePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateResourcePoolHypervisorResources(ctx, r.client, &resp.Diagnostics, plan.Hypervisor, func(hypervisor *citrixorchestration.HypervisorDetailResponseModel) (string, bool) {
+		// The subnets are listed under the virtual network, which is resolved the same way it is when the resource pool is created
+		if plan.Region.IsUnknown() || plan.VirtualNetwork.IsUnknown() || plan.VirtualNetworkResourceGroup.IsUnknown() {
+			return "", false
+		}
+		region, _, err := util.GetSingleHypervisorResourceWithNoCacheRetry(ctx, r.client, &diag.Diagnostics{}, hypervisor.GetId(), "", plan.Region.ValueString(), "Region", "", hypervisor)
+		if err != nil {
+			return "", false
+		}
+		regionPath := region.GetRelativePath()
+		vnet, _, err := util.GetSingleHypervisorResourceWithNoCacheRetry(ctx, r.client, &diag.Diagnostics{}, hypervisor.GetId(), fmt.Sprintf("%s/virtualprivatecloud.folder", regionPath), plan.VirtualNetwork.ValueString(), util.VirtualPrivateCloudResourceType, plan.VirtualNetworkResourceGroup.ValueString(), hypervisor)
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("%s/virtualprivatecloud.folder/%s", regionPath, vnet.GetRelativePath()), true
+	}, []resourcePoolResourceList{
+		{Attribute: "subnets", ResourceType: util.NetworkResourceType, Description: "Subnet", Plan: plan.Subnets, State: state.Subnets},
+	})
 }
@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &gcpHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &gcpHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &gcpHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &gcpHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &gcpHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &gcpHypervisorResourcePoolResource{}
 )
@@ -45,6 +46,11 @@ func (r *gcpHypervisorResourcePoolResource) Schema(_ context.Context, _ resource
 	resp.Schema = GcpHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *gcpHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *gcpHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -92,13 +98,8 @@ func (r *gcpHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 		)
 		return
 	}
-	regionPath := fmt.Sprintf("%s.project/%s.region", plan.ProjectName.ValueString(), plan.Region.ValueString())
-	resourcePoolDetails.SetRegion(regionPath)
-	vnetPath := fmt.Sprintf("%s/%s.virtualprivatecloud", regionPath, plan.Vpc.ValueString())
-	if plan.SharedVpc.ValueBool() {
-		// Support shared VPC if specified as true
-		vnetPath = fmt.Sprintf("%s/%s.sharedvirtualprivatecloud", regionPath, plan.Vpc.ValueString())
-	}
+	resourcePoolDetails.SetRegion(plan.getRegionFolderPath())
+	vnetPath := plan.getVpcFolderPath()
 	resourcePoolDetails.SetVirtualPrivateCloud(vnetPath)
 	//Checking the subnet
 	if plan.Subnets.IsNull() {
@@ -136,6 +137,7 @@ func (r *gcpHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -143,6 +145,7 @@ func (r *gcpHypervisorResourcePoolResource) Create(ctx context.Context, req reso
 
 func (r *gcpHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state GcpHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -173,6 +176,7 @@ func (r *gcpHypervisorResourcePoolResource) Read(ctx context.Context, req resour
 
 func (r *gcpHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan GcpHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -194,12 +198,7 @@ func (r *gcpHypervisorResourcePoolResource) Update(ctx context.Context, req reso
 	editHypervisorResourcePool.SetVmTagging(plan.VmTagging.ValueBool())
 
 	planSubnet := util.StringListToStringArray(ctx, &resp.Diagnostics, plan.Subnets)
-	regionPath := fmt.Sprintf("%s.project/%s.region", plan.ProjectName.ValueString(), plan.Region.ValueString())
-	vnetPath := fmt.Sprintf("%s/%s.virtualprivatecloud", regionPath, plan.Vpc.ValueString())
-	if plan.SharedVpc.ValueBool() {
-		// Support shared VPC if specified as true
-		vnetPath = fmt.Sprintf("%s/%s.sharedvirtualprivatecloud", regionPath, plan.Vpc.ValueString())
-	}
+	vnetPath := plan.getVpcFolderPath()
 	subnets, err := getHypervisorResourcePoolSubnets(ctx, r.client, &resp.Diagnostics, plan.Hypervisor.ValueString(), vnetPath, planSubnet, citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM)
 	if err != nil {
 		// Directly return. Error logs have been populated in common function
@@ -233,12 +232,17 @@ func (r *gcpHypervisorResourcePoolResource) Update(ctx context.Context, req reso
 func (r *gcpHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -247,7 +251,7 @@ func (r *gcpHypervisorResourcePoolResource) ImportState(ctx context.Context, req
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -276,17 +280,17 @@ func (r *gcpHypervisorResourcePoolResource) ImportState(ctx context.Context, req
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
@@ -354,4 +358,26 @@ func (r *gcpHypervisorResourcePoolResource) ModifyPlan(ctx context.Context, req
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
 	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state GcpHypervisorResourcePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateResourcePoolHypervisorResources(ctx, r.client, &resp.Diagnostics, plan.Hypervisor, func(*citrixorchestration.HypervisorDetailResponseModel) (string, bool) {
+		if plan.ProjectName.IsUnknown() || plan.Region.IsUnknown() || plan.Vpc.IsUnknown() || plan.SharedVpc.IsUnknown() {
+			return "", false
+		}
+		return plan.getVpcFolderPath(), true
+	}, []resourcePoolResourceList{
+		{Attribute: "subnets", ResourceType: util.NetworkResourceType, Description: "Subnet", Plan: plan.Subnets, State: state.Subnets},
+	})
 }
@@ -4,6 +4,7 @@ package hypervisor_resource_pool
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -163,6 +164,18 @@ func (r GcpHypervisorResourcePoolResourceModel) RefreshPropertyValues(ctx contex
 	return r
 }
 
+func (r GcpHypervisorResourcePoolResourceModel) getRegionFolderPath() string {
+	return fmt.Sprintf("%s.project/%s.region", r.ProjectName.ValueString(), r.Region.ValueString())
+}
+
+func (r GcpHypervisorResourcePoolResourceModel) getVpcFolderPath() string {
+	if r.SharedVpc.ValueBool() {
+		// Support shared VPC if specified as true
+		return fmt.Sprintf("%s/%s.sharedvirtualprivatecloud", r.getRegionFolderPath(), r.Vpc.ValueString())
+	}
+	return fmt.Sprintf("%s/%s.virtualprivatecloud", r.getRegionFolderPath(), r.Vpc.ValueString())
+}
+
 func (r GcpHypervisorResourcePoolResourceModel) shouldSetRegion(region citrixorchestration.HypervisorResourceRefResponseModel) bool {
 	// Always store name in state for the first time, but allow either if already specified in state or plan
 	return r.Region.IsNull() || r.Region.ValueString() == "" ||
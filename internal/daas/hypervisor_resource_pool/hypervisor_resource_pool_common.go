@@ -12,6 +12,7 @@ import (
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -175,6 +176,71 @@ func getHypervisorResourcePoolSubnets(ctx context.Context, client *citrixdaascli
 	return remoteSubnets, nil
 }
 
+// resourcePoolResourceList describes a list attribute of a resource pool that references hypervisor resources by name.
+type resourcePoolResourceList struct {
+	Attribute    string
+	ResourceType string
+	Description  string
+	IsStorage    bool // List[HypervisorStorageModel] when true, List[string] otherwise
+	Plan         types.List
+	State        types.List
+}
+
+// validateResourcePoolHypervisorResources resolves the hypervisor resources referenced by a resource pool at plan time,
+// so that a misspelled storage or network name fails the plan with a list of close matches instead of failing the resource pool job.
+// Only lists that are new or changed compared to the state are resolved to limit the number of hypervisor calls.
+// The folders holding the resources, such as the region and virtual network of a cloud resource pool, are not validated: when the folder
+// cannot be resolved or listed, the lists are left for the resource pool job to report. SCVMM, OpenShift and Amazon WorkSpaces Core resource
+// pools are not validated at plan time.
+func validateResourcePoolHypervisorResources(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId types.String, getFolderPath func(*citrixorchestration.HypervisorDetailResponseModel) (string, bool), resourceLists []resourcePoolResourceList) {
+	if client == nil || hypervisorId.IsUnknown() || hypervisorId.IsNull() {
+		return
+	}
+
+	changedLists := []resourcePoolResourceList{}
+	for _, resourceList := range resourceLists {
+		if !resourceList.Plan.IsUnknown() && !resourceList.Plan.IsNull() && !resourceList.Plan.Equal(resourceList.State) {
+			changedLists = append(changedLists, resourceList)
+		}
+	}
+	if len(changedLists) == 0 {
+		return
+	}
+
+	// Lookup failures are left for the resource pool job to report, plan time validation only reports resources that are confirmed missing
+	hypervisor, err := util.GetHypervisor(ctx, client, &diag.Diagnostics{}, hypervisorId.ValueString())
+	if err != nil {
+		return
+	}
+	folderPath, ok := getFolderPath(hypervisor)
+	if !ok {
+		return
+	}
+
+	for _, resourceList := range changedLists {
+		names := []string{}
+		listDiagnostics := diag.Diagnostics{}
+		if resourceList.IsStorage {
+			for _, storage := range util.ObjectListToTypedArray[HypervisorStorageModel](ctx, &listDiagnostics, resourceList.Plan) {
+				if !storage.StorageName.IsUnknown() {
+					names = append(names, storage.StorageName.ValueString())
+				}
+			}
+		} else {
+			for _, name := range resourceList.Plan.Elements() {
+				if name, ok := name.(types.String); ok && !name.IsUnknown() {
+					names = append(names, name.ValueString())
+				}
+			}
+		}
+		if listDiagnostics.HasError() {
+			continue
+		}
+
+		util.ValidateHypervisorResourcesExist(ctx, client, diagnostics, path.Root(resourceList.Attribute), hypervisor, folderPath, resourceList.ResourceType, names, resourceList.Description)
+	}
+}
+
 func waitForProvImagesPendingDelete(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId, resourcePoolId string, timeoutMinutes int32) error {
 	// Poll interval in seconds
 	pollInterval := 60 * time.Second
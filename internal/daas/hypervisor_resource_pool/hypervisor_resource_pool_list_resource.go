@@ -0,0 +1,141 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package hypervisor_resource_pool
+
+import (
+	"context"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &hypervisorResourcePoolListResource{}
+	_ list.ListResourceWithConfigure = &hypervisorResourcePoolListResource{}
+)
+
+func NewAzureHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_azure_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM, newResource: NewAzureHypervisorResourcePoolResource}
+}
+
+func NewAwsHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_aws_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS, newResource: NewAwsHypervisorResourcePoolResource}
+}
+
+func NewAmazonWorkSpacesCoreHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_amazon_workspaces_core_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE, newResource: NewAmazonWorkSpacesCoreHypervisorResourcePoolResource}
+}
+
+func NewGcpHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_gcp_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM, newResource: NewGcpHypervisorResourcePoolResource}
+}
+
+func NewVsphereHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_vsphere_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER, newResource: NewVsphereHypervisorResourcePoolResource}
+}
+
+func NewXenserverHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_xenserver_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER, newResource: NewXenserverHypervisorResourcePoolResource}
+}
+
+func NewSCVMMHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_scvmm_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM, newResource: NewSCVMMHypervisorResourcePoolResource}
+}
+
+func NewOpenShiftHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_openshift_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT, newResource: NewOpenShiftHypervisorResourcePoolResource}
+}
+
+func NewNutanixHypervisorResourcePoolListResource() list.ListResource {
+	return &hypervisorResourcePoolListResource{typeName: "_nutanix_hypervisor_resource_pool", connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM, pluginId: util.NUTANIX_PLUGIN_ID, newResource: NewNutanixHypervisorResourcePoolResource}
+}
+
+// HypervisorResourcePoolListResourceModel is the config of the hypervisor resource pool list resources.
+type HypervisorResourcePoolListResourceModel struct {
+	Hypervisor types.String `tfsdk:"hypervisor"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+}
+
+func (HypervisorResourcePoolListResourceModel) GetSchema() listschema.Schema {
+	return listschema.Schema{
+		Description: "Lists the hypervisor resource pools of the site.",
+		Attributes: map[string]listschema.Attribute{
+			"hypervisor": listschema.StringAttribute{
+				Description: "Id of the hypervisor to list the resource pools of. When omitted, the resource pools of all hypervisors with a matching connection type are returned.",
+				Optional:    true,
+			},
+			"name_regex": util.GetListResourceNameRegexAttribute("resource pools"),
+		},
+	}
+}
+
+// hypervisorResourcePoolListResource lists the resource pools of the hypervisors of a single connection type.
+type hypervisorResourcePoolListResource struct {
+	client         *citrixdaasclient.CitrixDaasClient
+	typeName       string
+	connectionType citrixorchestration.HypervisorConnectionType
+	pluginId       string
+	newResource    func() resource.Resource
+}
+
+// Metadata returns the list resource type name.
+func (r *hypervisorResourcePoolListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *hypervisorResourcePoolListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = HypervisorResourcePoolListResourceModel{}.GetSchema()
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *hypervisorResourcePoolListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the resource pools of the hypervisors with a matching connection type.
+func (r *hypervisorResourcePoolListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config HypervisorResourcePoolListResourceModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	hypervisors, _ := util.GetHypervisors(ctx, r.client, &diags)
+	items := []util.ListResourceItem{}
+	for _, hypervisor := range hypervisors {
+		if !util.IsHypervisorOfConnectionType(hypervisor, r.connectionType, r.pluginId) {
+			continue
+		}
+		if !config.Hypervisor.IsNull() && !strings.EqualFold(hypervisor.GetId(), config.Hypervisor.ValueString()) {
+			continue
+		}
+
+		resourcePools, err := util.GetHypervisorResourcePools(ctx, r.client, &diags, hypervisor.GetId())
+		if err != nil {
+			break
+		}
+		for _, resourcePool := range resourcePools {
+			items = append(items, util.ListResourceItem{
+				Id:              resourcePool.GetId(),
+				DisplayName:     resourcePool.GetName(),
+				StateAttributes: map[string]string{"hypervisor": hypervisor.GetId()},
+			})
+		}
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, r.newResource, r.client)
+}
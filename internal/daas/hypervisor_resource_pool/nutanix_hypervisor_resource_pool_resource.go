@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                   = &nutanixHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &nutanixHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &nutanixHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &nutanixHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &nutanixHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &nutanixHypervisorResourcePoolResource{}
 )
@@ -45,6 +46,11 @@ func (*nutanixHypervisorResourcePoolResource) Schema(_ context.Context, req reso
 	resp.Schema = NutanixHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (*nutanixHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 func (r *nutanixHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -111,6 +117,7 @@ func (r *nutanixHypervisorResourcePoolResource) Create(ctx context.Context, req
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -119,6 +126,7 @@ func (r *nutanixHypervisorResourcePoolResource) Create(ctx context.Context, req
 // Read implements resource.Resource.
 func (r *nutanixHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state NutanixHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -150,6 +158,7 @@ func (r *nutanixHypervisorResourcePoolResource) Read(ctx context.Context, req re
 // Update implements resource.Resource.
 func (r *nutanixHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan NutanixHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -223,12 +232,17 @@ func (r *nutanixHypervisorResourcePoolResource) Update(ctx context.Context, req
 func (r *nutanixHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -237,7 +251,7 @@ func (r *nutanixHypervisorResourcePoolResource) ImportState(ctx context.Context,
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -266,17 +280,17 @@ func (r *nutanixHypervisorResourcePoolResource) ImportState(ctx context.Context,
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
@@ -370,4 +384,23 @@ func (r *nutanixHypervisorResourcePoolResource) ModifyPlan(ctx context.Context,
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
 	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state NutanixHypervisorResourcePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateResourcePoolHypervisorResources(ctx, r.client, &resp.Diagnostics, plan.Hypervisor, func(*citrixorchestration.HypervisorDetailResponseModel) (string, bool) {
+		return "", true
+	}, []resourcePoolResourceList{
+		{Attribute: "networks", ResourceType: util.NetworkResourceType, Description: "Network", Plan: plan.Networks, State: state.Networks},
+	})
 }
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                   = &openshiftHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &openshiftHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &openshiftHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &openshiftHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &openshiftHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &openshiftHypervisorResourcePoolResource{}
 )
@@ -96,6 +97,11 @@ func (r *openshiftHypervisorResourcePoolResource) Schema(_ context.Context, _ re
 	resp.Schema = OpenShiftHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *openshiftHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *openshiftHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -169,6 +175,7 @@ func (r *openshiftHypervisorResourcePoolResource) Create(ctx context.Context, re
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -176,6 +183,7 @@ func (r *openshiftHypervisorResourcePoolResource) Create(ctx context.Context, re
 
 func (r *openshiftHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state OpenShiftHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -206,6 +214,7 @@ func (r *openshiftHypervisorResourcePoolResource) Read(ctx context.Context, req
 
 func (r *openshiftHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan OpenShiftHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -303,12 +312,17 @@ func (r *openshiftHypervisorResourcePoolResource) Update(ctx context.Context, re
 func (r *openshiftHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -317,7 +331,7 @@ func (r *openshiftHypervisorResourcePoolResource) ImportState(ctx context.Contex
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -346,17 +360,17 @@ func (r *openshiftHypervisorResourcePoolResource) ImportState(ctx context.Contex
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
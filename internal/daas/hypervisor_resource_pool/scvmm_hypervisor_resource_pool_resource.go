@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                   = &scvmmHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &scvmmHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &scvmmHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &scvmmHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &scvmmHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &scvmmHypervisorResourcePoolResource{}
 )
@@ -71,6 +72,11 @@ func (r *scvmmHypervisorResourcePoolResource) Schema(_ context.Context, _ resour
 	resp.Schema = SCVMMHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *scvmmHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *scvmmHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -216,6 +222,7 @@ func (r *scvmmHypervisorResourcePoolResource) Create(ctx context.Context, req re
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -224,6 +231,7 @@ func (r *scvmmHypervisorResourcePoolResource) Create(ctx context.Context, req re
 // Read implements resource.Resource.
 func (r *scvmmHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state SCVMMHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -256,12 +264,17 @@ func (r *scvmmHypervisorResourcePoolResource) Read(ctx context.Context, req reso
 func (r *scvmmHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -270,7 +283,7 @@ func (r *scvmmHypervisorResourcePoolResource) ImportState(ctx context.Context, r
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -299,23 +312,24 @@ func (r *scvmmHypervisorResourcePoolResource) ImportState(ctx context.Context, r
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
 // Update implements resource.Resource.
 func (r *scvmmHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan SCVMMHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
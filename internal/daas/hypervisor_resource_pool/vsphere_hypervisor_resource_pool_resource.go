@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                   = &vsphereHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &vsphereHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &vsphereHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &vsphereHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &vsphereHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &vsphereHypervisorResourcePoolResource{}
 )
@@ -60,6 +61,25 @@ func (r *vsphereHypervisorResourcePoolResource) ModifyPlan(ctx context.Context,
 		return
 	}
 
+	var state VsphereHypervisorResourcePoolResourceModel
+	if !create {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	validateResourcePoolHypervisorResources(ctx, r.client, &resp.Diagnostics, plan.Hypervisor, func(hypervisor *citrixorchestration.HypervisorDetailResponseModel) (string, bool) {
+		if plan.Cluster.IsUnknown() || plan.Cluster.IsNull() {
+			return "", false
+		}
+		return plan.getClusterFolderPath(ctx, &diag.Diagnostics{}, hypervisor), true
+	}, []resourcePoolResourceList{
+		{Attribute: "storage", ResourceType: util.StorageResourceType, Description: "Storage", IsStorage: true, Plan: plan.Storage, State: state.Storage},
+		{Attribute: "temporary_storage", ResourceType: util.StorageResourceType, Description: "Temporary storage", IsStorage: true, Plan: plan.TemporaryStorage, State: state.TemporaryStorage},
+		{Attribute: "networks", ResourceType: util.NetworkResourceType, Description: "Network", Plan: plan.Networks, State: state.Networks},
+	})
+
 	if !create {
 		return
 	}
@@ -96,6 +116,11 @@ func (r *vsphereHypervisorResourcePoolResource) Schema(_ context.Context, _ reso
 	resp.Schema = VsphereHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *vsphereHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *vsphereHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -212,6 +237,7 @@ func (r *vsphereHypervisorResourcePoolResource) Create(ctx context.Context, req
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -219,6 +245,7 @@ func (r *vsphereHypervisorResourcePoolResource) Create(ctx context.Context, req
 
 func (r *vsphereHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state VsphereHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -249,6 +276,7 @@ func (r *vsphereHypervisorResourcePoolResource) Read(ctx context.Context, req re
 
 func (r *vsphereHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan VsphereHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -352,12 +380,17 @@ func (r *vsphereHypervisorResourcePoolResource) Update(ctx context.Context, req
 func (r *vsphereHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -366,7 +399,7 @@ func (r *vsphereHypervisorResourcePoolResource) ImportState(ctx context.Context,
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -395,17 +428,17 @@ func (r *vsphereHypervisorResourcePoolResource) ImportState(ctx context.Context,
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
@@ -444,6 +477,21 @@ func (r *vsphereHypervisorResourcePoolResource) Delete(ctx context.Context, req
 	}
 }
 
+// getClusterFolderPath returns the hypervisor resource path of the datacenter, cluster or host the resource pool is created in.
+func (plan VsphereHypervisorResourcePoolResourceModel) getClusterFolderPath(ctx context.Context, diags *diag.Diagnostics, hypervisor *citrixorchestration.HypervisorDetailResponseModel) string {
+	cluster := util.ObjectValueToTypedObject[VsphereHypervisorClusterModel](ctx, diags, plan.Cluster)
+	folderPath := fmt.Sprintf("%s\\%s.datacenter", hypervisor.GetXDPath(), cluster.Datacenter.ValueString())
+	if !cluster.ClusterName.IsNull() {
+		folderPath = fmt.Sprintf("%s\\%s.cluster", folderPath, cluster.ClusterName.ValueString())
+	}
+
+	if !cluster.Host.IsNull() {
+		folderPath = fmt.Sprintf("%s\\%s.computeresource", folderPath, cluster.Host.ValueString())
+	}
+
+	return folderPath
+}
+
 func (plan VsphereHypervisorResourcePoolResourceModel) GetStorageList(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diags *diag.Diagnostics, hypervisor *citrixorchestration.HypervisorDetailResponseModel, isCreate bool, forSuperseded bool) ([]string, []string) {
 	action := "updating"
 	if isCreate {
@@ -460,15 +508,7 @@ func (plan VsphereHypervisorResourcePoolResourceModel) GetStorageList(ctx contex
 	storageNames := util.ConvertBaseStringArrayToPrimitiveStringArray(storage)
 	hypervisorId := hypervisor.GetId()
 	hypervisorConnectionType := hypervisor.GetConnectionType()
-	cluster := util.ObjectValueToTypedObject[VsphereHypervisorClusterModel](ctx, diags, plan.Cluster)
-	folderPath := fmt.Sprintf("%s\\%s.datacenter", hypervisor.GetXDPath(), cluster.Datacenter.ValueString())
-	if !cluster.ClusterName.IsNull() {
-		folderPath = fmt.Sprintf("%s\\%s.cluster", folderPath, cluster.ClusterName.ValueString())
-	}
-
-	if !cluster.Host.IsNull() {
-		folderPath = fmt.Sprintf("%s\\%s.computeresource", folderPath, cluster.Host.ValueString())
-	}
+	folderPath := plan.getClusterFolderPath(ctx, diags, hypervisor)
 	storages, err := util.GetFilteredResourcePathListWithNoCacheRetry(ctx, client, diags, hypervisorId, folderPath, util.StorageResourceType, storageNames, hypervisorConnectionType, hypervisor.GetPluginId(), false)
 
 	if len(storage) > 0 && len(storages) == 0 {
@@ -515,15 +555,7 @@ func (plan VsphereHypervisorResourcePoolResourceModel) GetNetworksList(ctx conte
 		action = "creating"
 	}
 
-	cluster := util.ObjectValueToTypedObject[VsphereHypervisorClusterModel](ctx, diags, plan.Cluster)
-	folderPath := fmt.Sprintf("%s\\%s.datacenter", hypervisor.GetXDPath(), cluster.Datacenter.ValueString())
-	if !cluster.ClusterName.IsNull() {
-		folderPath = fmt.Sprintf("%s\\%s.cluster", folderPath, cluster.ClusterName.ValueString())
-	}
-
-	if !cluster.Host.IsNull() {
-		folderPath = fmt.Sprintf("%s\\%s.computeresource", folderPath, cluster.Host.ValueString())
-	}
+	folderPath := plan.getClusterFolderPath(ctx, diags, hypervisor)
 
 	networkNames := util.StringListToStringArray(ctx, diags, plan.Networks)
 	networks, err := util.GetFilteredResourcePathListWithNoCacheRetry(ctx, client, diags, hypervisorId, folderPath, util.NetworkResourceType, networkNames, hypervisorConnectionType, hypervisor.GetPluginId(), false)
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                   = &xenserverHypervisorResourcePoolResource{}
 	_ resource.ResourceWithConfigure      = &xenserverHypervisorResourcePoolResource{}
 	_ resource.ResourceWithImportState    = &xenserverHypervisorResourcePoolResource{}
+	_ resource.ResourceWithIdentity       = &xenserverHypervisorResourcePoolResource{}
 	_ resource.ResourceWithValidateConfig = &xenserverHypervisorResourcePoolResource{}
 	_ resource.ResourceWithModifyPlan     = &xenserverHypervisorResourcePoolResource{}
 )
@@ -47,6 +48,11 @@ func (r *xenserverHypervisorResourcePoolResource) Schema(_ context.Context, _ re
 	resp.Schema = XenserverHypervisorResourcePoolResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *xenserverHypervisorResourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *xenserverHypervisorResourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -122,6 +128,7 @@ func (r *xenserverHypervisorResourcePoolResource) Create(ctx context.Context, re
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -129,6 +136,7 @@ func (r *xenserverHypervisorResourcePoolResource) Create(ctx context.Context, re
 
 func (r *xenserverHypervisorResourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var state XenserverHypervisorResourcePoolResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -159,6 +167,7 @@ func (r *xenserverHypervisorResourcePoolResource) Read(ctx context.Context, req
 
 func (r *xenserverHypervisorResourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	var plan XenserverHypervisorResourcePoolResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -262,12 +271,17 @@ func (r *xenserverHypervisorResourcePoolResource) Update(ctx context.Context, re
 func (r *xenserverHypervisorResourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	idParts := strings.Split(req.ID, ",")
+	importId := util.GetImportStateId(ctx, &resp.Diagnostics, req)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(importId, ",")
 
 	if len(idParts) > 2 {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: hypervisorResourcePoolId or hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 		)
 		return
 	}
@@ -276,7 +290,7 @@ func (r *xenserverHypervisorResourcePoolResource) ImportState(ctx context.Contex
 		if idParts[0] == "" || idParts[1] == "" {
 			resp.Diagnostics.AddError(
 				"Unexpected Import Identifier",
-				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", req.ID),
+				fmt.Sprintf("Expected import identifier with format: hypervisorId,hypervisorResourcePoolId. Got: %q", importId),
 			)
 			return
 		}
@@ -305,17 +319,17 @@ func (r *xenserverHypervisorResourcePoolResource) ImportState(ctx context.Contex
 
 		resourcePools := hypervisorAndResourcePool.GetResourcePools()
 		if slices.ContainsFunc(resourcePools, func(resourcePool citrixorchestration.HypervisorBaseResponseModel) bool {
-			return strings.EqualFold(resourcePool.GetId(), req.ID)
+			return strings.EqualFold(resourcePool.GetId(), importId)
 		}) {
 			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hypervisor"), hypervisorAndResourcePool.GetId())...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importId)...)
 			return
 		}
 	}
 
 	resp.Diagnostics.AddError(
 		"Error importing Hypervisor Resource Pool",
-		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", req.ID),
+		fmt.Sprintf("Hypervisor Resource Pool with ID %q not found", importId),
 	)
 }
 
@@ -462,4 +476,25 @@ func (r *xenserverHypervisorResourcePoolResource) ModifyPlan(ctx context.Context
 		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
 		return
 	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state XenserverHypervisorResourcePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateResourcePoolHypervisorResources(ctx, r.client, &resp.Diagnostics, plan.Hypervisor, func(*citrixorchestration.HypervisorDetailResponseModel) (string, bool) {
+		return "", true
+	}, []resourcePoolResourceList{
+		{Attribute: "storage", ResourceType: util.StorageResourceType, Description: "Storage", IsStorage: true, Plan: plan.Storage, State: state.Storage},
+		{Attribute: "temporary_storage", ResourceType: util.StorageResourceType, Description: "Temporary storage", IsStorage: true, Plan: plan.TemporaryStorage, State: state.TemporaryStorage},
+		{Attribute: "networks", ResourceType: util.NetworkResourceType, Description: "Network", Plan: plan.Networks, State: state.Networks},
+	})
 }
@@ -0,0 +1,26 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"testing"
+	"time"
+)
+
+// DeleteMachinesFromMcsPvsCatalog exposes the scale down of MCS and PVS catalogs to the tests run against the fake Orchestration API.
+var DeleteMachinesFromMcsPvsCatalog = deleteMachinesFromMcsPvsCatalog
+
+// RolloutCatalogImage exposes the staged image rollout to the tests run against the fake Orchestration API.
+var RolloutCatalogImage = rolloutCatalogImage
+
+// SetPollIntervals shortens the intervals at which the machines are checked until the end of the test.
+func SetPollIntervals(t *testing.T, interval time.Duration) {
+	previousImageRolloutPollInterval := imageRolloutPollInterval
+	previousMachineRegistrationPollInterval := machineRegistrationPollInterval
+	imageRolloutPollInterval = interval
+	machineRegistrationPollInterval = interval
+	t.Cleanup(func() {
+		imageRolloutPollInterval = previousImageRolloutPollInterval
+		machineRegistrationPollInterval = previousMachineRegistrationPollInterval
+	})
+}
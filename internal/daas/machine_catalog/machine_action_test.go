@@ -0,0 +1,261 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newActionConfig returns the configuration of an action holding the values of the action model.
+func newActionConfig(t *testing.T, a action.Action, model any) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	// The configuration cannot be set from a model, so the value is built with a state of the same schema
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("error building the action configuration: %v", diags)
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}
+}
+
+// getErrorSummaries returns the attribute paths and summaries of the errors, such as `force: Incorrect Attribute Configuration`.
+func getErrorSummaries(diagnostics diag.Diagnostics) []string {
+	summaries := []string{}
+	for _, d := range diagnostics.Errors() {
+		summary := d.Summary()
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			summary = withPath.Path().String() + ": " + summary
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func newImageUpdateRebootOptions(t *testing.T, rebootOptions *ImageUpdateRebootOptionsModel) types.Object {
+	t.Helper()
+	if rebootOptions == nil {
+		attributes, err := util.ResourceAttributeMapFromObject(ImageUpdateRebootOptionsModel{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return types.ObjectNull(attributes)
+	}
+	diagnostics := diag.Diagnostics{}
+	value := util.TypedObjectToObjectValue(context.Background(), &diagnostics, *rebootOptions)
+	if diagnostics.HasError() {
+		t.Fatalf("error building the reboot options: %v", diagnostics)
+	}
+	return value
+}
+
+func TestMachinePowerActionValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config         MachinePowerActionModel
+		expectedErrors []string
+	}{
+		"forced reboot waiting for registration": {
+			config: MachinePowerActionModel{
+				Action:                     types.StringValue(MachinePowerActionReboot),
+				Force:                      types.BoolValue(true),
+				WaitForRegistration:        types.BoolValue(true),
+				RegistrationTimeoutMinutes: types.Int64Value(10),
+			},
+		},
+		"force on start": {
+			config: MachinePowerActionModel{
+				Action: types.StringValue(MachinePowerActionStart),
+				Force:  types.BoolValue(true),
+			},
+			expectedErrors: []string{"force: Incorrect Attribute Configuration"},
+		},
+		"wait for registration on logoff": {
+			config: MachinePowerActionModel{
+				Action:              types.StringValue(MachinePowerActionLogoff),
+				WaitForRegistration: types.BoolValue(true),
+			},
+			expectedErrors: []string{"wait_for_registration: Incorrect Attribute Configuration"},
+		},
+		"registration timeout without waiting": {
+			config: MachinePowerActionModel{
+				Action:                     types.StringValue(MachinePowerActionShutdown),
+				RegistrationTimeoutMinutes: types.Int64Value(10),
+			},
+			expectedErrors: []string{"registration_timeout_minutes: Incorrect Attribute Configuration"},
+		},
+		"unknown action": {
+			config: MachinePowerActionModel{
+				Action: types.StringUnknown(),
+				Force:  types.BoolValue(true),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			test.config.Machine = types.StringValue("DOMAIN\\vm-1")
+			a := &machinePowerAction{}
+			resp := &action.ValidateConfigResponse{}
+			a.ValidateConfig(context.Background(), action.ValidateConfigRequest{Config: newActionConfig(t, a, test.config)}, resp)
+
+			if actual := getErrorSummaries(resp.Diagnostics); strings.Join(actual, "\n") != strings.Join(test.expectedErrors, "\n") {
+				t.Errorf("expected errors %v, got %v", test.expectedErrors, actual)
+			}
+		})
+	}
+}
+
+func TestMachineCatalogUpdateImageActionValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		rebootOptions  *ImageUpdateRebootOptionsModel
+		expectedErrors []string
+	}{
+		"no reboot options": {},
+		"reboot with warning": {
+			rebootOptions: &ImageUpdateRebootOptionsModel{
+				RebootDuration:        types.Int64Value(60),
+				WarningDuration:       types.Int64Value(15),
+				WarningMessage:        types.StringValue("Rebooting in %m% minutes"),
+				WarningRepeatInterval: types.Int64Value(5),
+			},
+		},
+		"warning without reboot": {
+			rebootOptions: &ImageUpdateRebootOptionsModel{
+				RebootDuration:        types.Int64Value(-1),
+				WarningDuration:       types.Int64Value(15),
+				WarningMessage:        types.StringValue("Rebooting"),
+				WarningRepeatInterval: types.Int64Null(),
+			},
+			expectedErrors: []string{"warning_duration: Invalid Reboot Warning Duration"},
+		},
+		"repeat interval longer than the warning": {
+			rebootOptions: &ImageUpdateRebootOptionsModel{
+				RebootDuration:        types.Int64Value(60),
+				WarningDuration:       types.Int64Value(5),
+				WarningMessage:        types.StringValue("Rebooting"),
+				WarningRepeatInterval: types.Int64Value(10),
+			},
+			expectedErrors: []string{"warning_repeat_interval: Invalid Reboot Warning Repeat Interval"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &machineCatalogUpdateImageAction{}
+			config := MachineCatalogUpdateImageActionModel{
+				MachineCatalogId:         types.StringValue("6d0bb6e1-5b6c-4b4e-a3c1-1f4b1f0e6a11"),
+				MasterImagePath:          types.StringNull(),
+				MasterImageNote:          types.StringNull(),
+				ImageUpdateRebootOptions: newImageUpdateRebootOptions(t, test.rebootOptions),
+			}
+			resp := &action.ValidateConfigResponse{}
+			a.ValidateConfig(context.Background(), action.ValidateConfigRequest{Config: newActionConfig(t, a, config)}, resp)
+
+			if actual := getErrorSummaries(resp.Diagnostics); strings.Join(actual, "\n") != strings.Join(test.expectedErrors, "\n") {
+				t.Errorf("expected errors %v, got %v", test.expectedErrors, actual)
+			}
+		})
+	}
+}
+
+func TestGetUpdateImageRequestModel(t *testing.T) {
+	t.Parallel()
+
+	currentImagePath := "XDHyp:\\HostingUnits\\pool\\image.vm\\v1.snapshot"
+	catalog := &citrixorchestration.MachineCatalogDetailResponseModel{}
+	catalog.SetMinimumFunctionalLevel(citrixorchestration.FUNCTIONALLEVEL_L7_20)
+	provScheme := citrixorchestration.ProvisioningSchemeResponseModel{}
+	masterImage := citrixorchestration.HypervisorResourceRefResponseModel{}
+	masterImage.SetXDPath(currentImagePath)
+	provScheme.SetMasterImage(masterImage)
+	catalog.SetProvisioningScheme(provScheme)
+
+	tests := map[string]struct {
+		masterImagePath       types.String
+		rebootOptions         *ImageUpdateRebootOptionsModel
+		expectedImagePath     string
+		expectedRebootOptions string
+	}{
+		"current image updated on next shutdown": {
+			masterImagePath:       types.StringNull(),
+			expectedImagePath:     currentImagePath,
+			expectedRebootOptions: `{"RebootDuration":-1}`,
+		},
+		"new image with a reboot warning": {
+			masterImagePath: types.StringValue("XDHyp:\\HostingUnits\\pool\\image.vm\\v2.snapshot"),
+			rebootOptions: &ImageUpdateRebootOptionsModel{
+				RebootDuration:        types.Int64Value(60),
+				WarningDuration:       types.Int64Value(15),
+				WarningMessage:        types.StringValue("Rebooting in %m% minutes"),
+				WarningRepeatInterval: types.Int64Value(5),
+			},
+			expectedImagePath:     "XDHyp:\\HostingUnits\\pool\\image.vm\\v2.snapshot",
+			expectedRebootOptions: `{"RebootDuration":60,"WarningDuration":15,"WarningMessage":"Rebooting in %m% minutes","WarningRepeatInterval":5}`,
+		},
+		"immediate reboot without warning": {
+			masterImagePath: types.StringNull(),
+			rebootOptions: &ImageUpdateRebootOptionsModel{
+				RebootDuration:        types.Int64Value(0),
+				WarningDuration:       types.Int64Null(),
+				WarningMessage:        types.StringNull(),
+				WarningRepeatInterval: types.Int64Null(),
+			},
+			expectedImagePath:     currentImagePath,
+			expectedRebootOptions: `{"RebootDuration":0,"WarningDuration":0}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := MachineCatalogUpdateImageActionModel{
+				MasterImagePath:          test.masterImagePath,
+				MasterImageNote:          types.StringValue("monthly patch"),
+				ImageUpdateRebootOptions: newImageUpdateRebootOptions(t, test.rebootOptions),
+			}
+			diagnostics := diag.Diagnostics{}
+			request := getUpdateImageRequestModel(context.Background(), &diagnostics, catalog, config)
+			if diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diagnostics)
+			}
+
+			if request.GetMasterImagePath() != test.expectedImagePath {
+				t.Errorf("expected master image path %q, got %q", test.expectedImagePath, request.GetMasterImagePath())
+			}
+			if request.GetMasterImageNote() != "monthly patch" || !request.GetStoreOldImage() || request.GetMinimumFunctionalLevel() != citrixorchestration.FUNCTIONALLEVEL_L7_20 {
+				t.Errorf("unexpected image update request %+v", request)
+			}
+			rebootOptions, err := request.RebootOptions.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(rebootOptions) != test.expectedRebootOptions {
+				t.Errorf("expected reboot options %s, got %s", test.expectedRebootOptions, rebootOptions)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -235,7 +236,7 @@ func checkIfProvSchemeIsCloudOnly(diagnostics *diag.Diagnostics, provisoningSche
 func generateBatchApiHeaders(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, provisioningSchemePlan ProvisioningSchemeModel, generateCredentialHeader bool) ([]citrixorchestration.NameValueStringPairModel, *http.Response, error) {
 	headers := []citrixorchestration.NameValueStringPairModel{}
 
-	cwsAuthToken, httpResp, err := client.SignIn()
+	cwsAuthToken, httpResp, err := middleware.SignIn(ctx, client)
 	var token string
 	if err != nil {
 		return headers, httpResp, err
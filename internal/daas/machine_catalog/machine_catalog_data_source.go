@@ -7,6 +7,7 @@ import (
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/vda"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -84,14 +85,24 @@ func (d *MachineCatalogDataSource) Read(ctx context.Context, req datasource.Read
 	machineCatalogId := machineCatalog.GetId()
 
 	// Get VDAs associated with the machine catalog
-	machineCatalogVdas, err := util.GetMachineCatalogMachines(ctx, d.client, &resp.Diagnostics, machineCatalogId)
+	machineCatalogVdas, err := util.GetMachineCatalogMachinesWithFields(ctx, d.client, &resp.Diagnostics, machineCatalogId, vda.VdaMachineFields)
 	if err != nil {
 		return
 	}
 
 	tags := getMachineCatalogTags(ctx, &resp.Diagnostics, d.client, machineCatalogId)
 
-	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, machineCatalog, machineCatalogVdas, tags)
+	// Get the hypervisor connection type to parse the hypervisor specific machine configuration
+	var hypervisor *citrixorchestration.HypervisorDetailResponseModel
+	hypervisorConnection := machineCatalog.GetHypervisorConnection()
+	if hypervisorId := hypervisorConnection.GetId(); hypervisorId != "" && machineCatalog.HasProvisioningScheme() {
+		hypervisor, err = util.GetHypervisor(ctx, d.client, &resp.Diagnostics, hypervisorId)
+		if err != nil {
+			return
+		}
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, machineCatalog, hypervisor, machineCatalogVdas, tags)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -26,6 +27,300 @@ type MachineCatalogDataSourceModel struct {
 	Vdas                     []vda.VdaModel `tfsdk:"vdas"`    // List[VdaModel]
 	Tenants                  types.Set      `tfsdk:"tenants"` // Set[String]
 	Tags                     types.Set      `tfsdk:"tags"`    // Set[string]
+
+	ProvisioningScheme *ProvisioningSchemeDataSourceModel `tfsdk:"provisioning_scheme"`
+}
+
+// ProvisioningSchemeDataSourceModel maps the provisioning scheme properties of an MCS machine catalog. The hypervisor specific machine
+// configuration reuses the models and parsing of the machine catalog resource.
+type ProvisioningSchemeDataSourceModel struct {
+	Hypervisor                 types.String               `tfsdk:"hypervisor"`
+	HypervisorResourcePool     types.String               `tfsdk:"hypervisor_resource_pool"`
+	IdentityType               types.String               `tfsdk:"identity_type"`
+	NumTotalMachines           types.Int64                `tfsdk:"number_of_total_machines"`
+	MasterImage                types.String               `tfsdk:"master_image"`
+	MasterImageNote            types.String               `tfsdk:"master_image_note"`
+	ImageDefinition            types.String               `tfsdk:"image_definition"`
+	ImageVersion               types.String               `tfsdk:"image_version"`
+	MachineProfile             types.String               `tfsdk:"machine_profile"`
+	ServiceOffering            types.String               `tfsdk:"service_offering"`
+	CpuCount                   types.Int64                `tfsdk:"cpu_count"`
+	MemoryMB                   types.Int64                `tfsdk:"memory_mb"`
+	DiskSizeGB                 types.Int64                `tfsdk:"disk_size_gb"`
+	NetworkMapping             []util.NetworkMappingModel `tfsdk:"network_mapping"`
+	NamingScheme               types.String               `tfsdk:"naming_scheme"`
+	NamingSchemeType           types.String               `tfsdk:"naming_scheme_type"`
+	Domain                     types.String               `tfsdk:"domain"`
+	DomainOu                   types.String               `tfsdk:"domain_ou"`
+	UseWritebackCache          types.Bool                 `tfsdk:"use_writeback_cache"`
+	WritebackCacheDiskSizeGB   types.Int64                `tfsdk:"writeback_cache_disk_size_gb"`
+	WritebackCacheMemorySizeMB types.Int64                `tfsdk:"writeback_cache_memory_size_mb"`
+	WritebackCacheDriveLetter  types.String               `tfsdk:"writeback_cache_drive_letter"`
+	ImageHistory               []ImageHistoryModel        `tfsdk:"image_history"`
+
+	AzureMachineConfig                types.Object `tfsdk:"azure_machine_config"`                  // AzureMachineConfigModel
+	AwsMachineConfig                  types.Object `tfsdk:"aws_machine_config"`                    // AwsMachineConfigModel
+	AmazonWorkspacesCoreMachineConfig types.Object `tfsdk:"amazon_workspaces_core_machine_config"` // AmazonWorkspacesCoreMachineConfigModel
+	GcpMachineConfig                  types.Object `tfsdk:"gcp_machine_config"`                    // GcpMachineConfigModel
+	VsphereMachineConfig              types.Object `tfsdk:"vsphere_machine_config"`                // VsphereMachineConfigModel
+	XenserverMachineConfig            types.Object `tfsdk:"xenserver_machine_config"`              // XenserverMachineConfigModel
+	NutanixMachineConfig              types.Object `tfsdk:"nutanix_machine_config"`                // NutanixMachineConfigModel
+	SCVMMMachineConfig                types.Object `tfsdk:"scvmm_machine_config"`                  // SCVMMMachineConfigModel
+	OpenshiftMachineConfig            types.Object `tfsdk:"openshift_machine_config"`              // OpenshiftMachineConfigModel
+}
+
+func (ProvisioningSchemeDataSourceModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Provisioning scheme of the machine catalog. Only set for MCS machine catalogs.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"hypervisor": schema.StringAttribute{
+				Description: "Id of the hypervisor used for provisioning the machines.",
+				Computed:    true,
+			},
+			"hypervisor_resource_pool": schema.StringAttribute{
+				Description: "Id of the hypervisor resource pool used for provisioning the machines.",
+				Computed:    true,
+			},
+			"identity_type": schema.StringAttribute{
+				Description: "The identity type of the machines.",
+				Computed:    true,
+			},
+			"number_of_total_machines": schema.Int64Attribute{
+				Description: "Number of machines provisioned in the machine catalog.",
+				Computed:    true,
+			},
+			"master_image": schema.StringAttribute{
+				Description: "XDPath of the master image currently used for provisioning new machines. Not set when the catalog is provisioned from a prepared image.",
+				Computed:    true,
+			},
+			"master_image_note": schema.StringAttribute{
+				Description: "Note recorded when the current master image was assigned to the catalog.",
+				Computed:    true,
+			},
+			"image_definition": schema.StringAttribute{
+				Description: "Id of the image definition of the current prepared image. Only set when the catalog is provisioned from a prepared image.",
+				Computed:    true,
+			},
+			"image_version": schema.StringAttribute{
+				Description: "Id of the image version currently used for provisioning new machines. Only set when the catalog is provisioned from a prepared image.",
+				Computed:    true,
+			},
+			"machine_profile": schema.StringAttribute{
+				Description: "XDPath of the machine profile used for provisioning the machines.",
+				Computed:    true,
+			},
+			"service_offering": schema.StringAttribute{
+				Description: "The VM size or instance type used for provisioning the machines.",
+				Computed:    true,
+			},
+			"cpu_count": schema.Int64Attribute{
+				Description: "Number of vCPUs of the provisioned machines.",
+				Computed:    true,
+			},
+			"memory_mb": schema.Int64Attribute{
+				Description: "Memory size in MB of the provisioned machines.",
+				Computed:    true,
+			},
+			"disk_size_gb": schema.Int64Attribute{
+				Description: "Size in GB of the OS disk of the provisioned machines.",
+				Computed:    true,
+			},
+			"network_mapping": schema.ListNestedAttribute{
+				Description:  "Specifies how the attached NICs are mapped to networks.",
+				Computed:     true,
+				NestedObject: util.NetworkMappingModel{}.GetDataSourceSchema(),
+			},
+			"naming_scheme": schema.StringAttribute{
+				Description: "Naming scheme used for the machine accounts of new machines.",
+				Computed:    true,
+			},
+			"naming_scheme_type": schema.StringAttribute{
+				Description: "Type of the naming scheme used for the machine accounts of new machines.",
+				Computed:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Domain in which the machine accounts of new machines are created.",
+				Computed:    true,
+			},
+			"domain_ou": schema.StringAttribute{
+				Description: "Organization Unit in which the machine accounts of new machines are created.",
+				Computed:    true,
+			},
+			"use_writeback_cache": schema.BoolAttribute{
+				Description: "Whether the machines use a writeback cache.",
+				Computed:    true,
+			},
+			"writeback_cache_disk_size_gb": schema.Int64Attribute{
+				Description: "Size in GB of the writeback cache disk.",
+				Computed:    true,
+			},
+			"writeback_cache_memory_size_mb": schema.Int64Attribute{
+				Description: "Size in MB of the writeback cache memory.",
+				Computed:    true,
+			},
+			"writeback_cache_drive_letter": schema.StringAttribute{
+				Description: "Drive letter of the writeback cache disk.",
+				Computed:    true,
+			},
+			"image_history": schema.ListNestedAttribute{
+				Description:  "Images assigned to the machine catalog, most recent first.",
+				Computed:     true,
+				NestedObject: ImageHistoryModel{}.GetDataSourceSchema(),
+			},
+			"azure_machine_config":                  getMachineConfigDataSourceSchema(AzureMachineConfigModel{}.GetSchema()),
+			"aws_machine_config":                    getMachineConfigDataSourceSchema(AwsMachineConfigModel{}.GetSchema()),
+			"amazon_workspaces_core_machine_config": getMachineConfigDataSourceSchema(AmazonWorkspacesCoreMachineConfigModel{}.GetSchema()),
+			"gcp_machine_config":                    getMachineConfigDataSourceSchema(GcpMachineConfigModel{}.GetSchema()),
+			"vsphere_machine_config":                getMachineConfigDataSourceSchema(VsphereMachineConfigModel{}.GetSchema()),
+			"xenserver_machine_config":              getMachineConfigDataSourceSchema(XenserverMachineConfigModel{}.GetSchema()),
+			"nutanix_machine_config":                getMachineConfigDataSourceSchema(NutanixMachineConfigModel{}.GetSchema()),
+			"scvmm_machine_config":                  getMachineConfigDataSourceSchema(SCVMMMachineConfigModel{}.GetSchema()),
+			"openshift_machine_config":              getMachineConfigDataSourceSchema(OpenshiftMachineConfigModel{}.GetSchema()),
+		},
+	}
+}
+
+func (r ProvisioningSchemeDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, connectionType citrixorchestration.HypervisorConnectionType, pluginId string) ProvisioningSchemeDataSourceModel {
+	provScheme := catalog.GetProvisioningScheme()
+	resourcePool := provScheme.GetResourcePool()
+	hypervisor := resourcePool.GetHypervisor()
+	r.Hypervisor = types.StringValue(hypervisor.GetId())
+	r.HypervisorResourcePool = types.StringValue(resourcePool.GetId())
+	r.IdentityType = types.StringValue(string(provScheme.GetIdentityType()))
+	r.NumTotalMachines = types.Int64Value(int64(provScheme.GetMachineCount()))
+
+	r.MasterImage = types.StringNull()
+	r.MasterImageNote = types.StringNull()
+	r.ImageDefinition = types.StringNull()
+	r.ImageVersion = types.StringNull()
+	if currentImageVersion, ok := provScheme.GetCurrentImageVersionOk(); ok {
+		imageDefinition := currentImageVersion.ImageVersion.GetImageDefinition()
+		r.ImageDefinition = types.StringValue(imageDefinition.GetId())
+		r.ImageVersion = types.StringValue(currentImageVersion.ImageVersion.GetId())
+		r.MasterImageNote = types.StringValue(currentImageVersion.GetImageAssignmentNote())
+	} else if currentDiskImage, ok := provScheme.GetCurrentDiskImageOk(); ok {
+		image := currentDiskImage.GetImage()
+		r.MasterImage = types.StringValue(image.GetXDPath())
+		r.MasterImageNote = types.StringValue(currentDiskImage.GetMasterImageNote())
+	} else if masterImage, ok := provScheme.GetMasterImageOk(); ok {
+		r.MasterImage = types.StringValue(masterImage.GetXDPath())
+	}
+
+	r.MachineProfile = types.StringNull()
+	if machineProfile, ok := provScheme.GetMachineProfileOk(); ok {
+		r.MachineProfile = types.StringValue(machineProfile.GetXDPath())
+	}
+	r.ServiceOffering = types.StringValue(provScheme.GetServiceOffering())
+	r.CpuCount = types.Int64Value(int64(provScheme.GetCpuCount()))
+	r.MemoryMB = types.Int64Value(int64(provScheme.GetMemoryMB()))
+	r.DiskSizeGB = types.Int64Value(int64(provScheme.GetDiskSizeGB()))
+
+	networkMappings := []util.NetworkMappingModel{}
+	for _, networkMap := range provScheme.GetNetworkMaps() {
+		networkMapping := util.NetworkMappingModel{}.RefreshListItem(ctx, diagnostics, networkMap).(util.NetworkMappingModel)
+		networkMappings = append(networkMappings, networkMapping)
+	}
+	r.NetworkMapping = networkMappings
+
+	machineAccountCreationRules := provScheme.GetMachineAccountCreationRules()
+	domain := machineAccountCreationRules.GetDomain()
+	r.NamingScheme = types.StringValue(machineAccountCreationRules.GetNamingScheme())
+	r.NamingSchemeType = types.StringValue(string(machineAccountCreationRules.GetNamingSchemeType()))
+	r.Domain = types.StringValue(domain.GetName())
+	r.DomainOu = types.StringValue(machineAccountCreationRules.GetOU())
+
+	r.UseWritebackCache = types.BoolValue(provScheme.GetUseWriteBackCache())
+	r.WritebackCacheDiskSizeGB = types.Int64Null()
+	r.WritebackCacheMemorySizeMB = types.Int64Null()
+	r.WritebackCacheDriveLetter = types.StringNull()
+	if provScheme.GetUseWriteBackCache() {
+		r.WritebackCacheDiskSizeGB = types.Int64Value(int64(provScheme.GetWriteBackCacheDiskSizeGB()))
+		r.WritebackCacheMemorySizeMB = types.Int64Value(int64(provScheme.GetWriteBackCacheMemorySizeMB()))
+		r.WritebackCacheDriveLetter = types.StringValue(provScheme.GetWriteBackCacheDriveLetter())
+	}
+
+	r.ImageHistory = getProvisioningSchemeImageHistory(&provScheme)
+
+	r.refreshMachineConfig(ctx, diagnostics, catalog, connectionType, pluginId)
+
+	return r
+}
+
+// refreshMachineConfig sets the machine configuration of the catalog hypervisor, parsed the same way as when a machine catalog is imported.
+func (r *ProvisioningSchemeDataSourceModel) refreshMachineConfig(ctx context.Context, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, connectionType citrixorchestration.HypervisorConnectionType, pluginId string) {
+	r.AzureMachineConfig = getNullMachineConfig(diagnostics, AzureMachineConfigModel{})
+	r.AwsMachineConfig = getNullMachineConfig(diagnostics, AwsMachineConfigModel{})
+	r.AmazonWorkspacesCoreMachineConfig = getNullMachineConfig(diagnostics, AmazonWorkspacesCoreMachineConfigModel{})
+	r.GcpMachineConfig = getNullMachineConfig(diagnostics, GcpMachineConfigModel{})
+	r.VsphereMachineConfig = getNullMachineConfig(diagnostics, VsphereMachineConfigModel{})
+	r.XenserverMachineConfig = getNullMachineConfig(diagnostics, XenserverMachineConfigModel{})
+	r.NutanixMachineConfig = getNullMachineConfig(diagnostics, NutanixMachineConfigModel{})
+	r.SCVMMMachineConfig = getNullMachineConfig(diagnostics, SCVMMMachineConfigModel{})
+	r.OpenshiftMachineConfig = getNullMachineConfig(diagnostics, OpenshiftMachineConfigModel{})
+
+	switch connectionType {
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM:
+		azureMachineConfig := getNewMachineConfig[AzureMachineConfigModel](ctx, diagnostics)
+		azureMachineConfig.RefreshProperties(ctx, diagnostics, *catalog, catalog.GetProvisioningType().Ptr())
+		r.AzureMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, azureMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS:
+		awsMachineConfig := getNewMachineConfig[AwsMachineConfigModel](ctx, diagnostics)
+		awsMachineConfig.RefreshProperties(ctx, diagnostics, *catalog)
+		r.AwsMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, awsMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE:
+		amazonWorkspacesCoreMachineConfig := getNewMachineConfig[AmazonWorkspacesCoreMachineConfigModel](ctx, diagnostics)
+		amazonWorkspacesCoreMachineConfig.RefreshProperties(ctx, diagnostics, *catalog)
+		r.AmazonWorkspacesCoreMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, amazonWorkspacesCoreMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM:
+		gcpMachineConfig := getNewMachineConfig[GcpMachineConfigModel](ctx, diagnostics)
+		gcpMachineConfig.RefreshProperties(ctx, diagnostics, *catalog)
+		r.GcpMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, gcpMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER:
+		vSphereMachineConfig := getNewMachineConfig[VsphereMachineConfigModel](ctx, diagnostics)
+		vSphereMachineConfig.RefreshProperties(ctx, diagnostics, *catalog)
+		r.VsphereMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, vSphereMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER:
+		xenserverMachineConfig := getNewMachineConfig[XenserverMachineConfigModel](ctx, diagnostics)
+		xenserverMachineConfig.RefreshProperties(ctx, diagnostics, *catalog)
+		r.XenserverMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, xenserverMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT:
+		openshiftMachineConfig := getNewMachineConfig[OpenshiftMachineConfigModel](ctx, diagnostics)
+		openshiftMachineConfig.RefreshProperties(ctx, diagnostics, *catalog)
+		r.OpenshiftMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, openshiftMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM:
+		scvmmMachineConfig := getNewMachineConfig[SCVMMMachineConfigModel](ctx, diagnostics)
+		scvmmMachineConfig.RefreshProperties(ctx, diagnostics, *catalog)
+		r.SCVMMMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, scvmmMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM:
+		if pluginId == util.NUTANIX_PLUGIN_ID {
+			nutanixMachineConfig := getNewMachineConfig[NutanixMachineConfigModel](ctx, diagnostics)
+			nutanixMachineConfig.RefreshProperties(*catalog)
+			r.NutanixMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, nutanixMachineConfig)
+		}
+	}
+}
+
+func getMachineConfigDataSourceSchema(machineConfigSchema resourceSchema.SingleNestedAttribute) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: machineConfigSchema.GetDescription(),
+		Computed:    true,
+		Attributes:  util.ResourceAttributesToDataSourceAttributes(machineConfigSchema.Attributes),
+	}
+}
+
+func getNullMachineConfig(diagnostics *diag.Diagnostics, machineConfig util.ResourceModelWithAttributes) types.Object {
+	attributesMap, err := util.ResourceAttributeMapFromObject(machineConfig)
+	if err != nil {
+		diagnostics.AddWarning("Error when creating null machine config", err.Error())
+	}
+	return types.ObjectNull(attributesMap)
+}
+
+// getNewMachineConfig returns an empty machine config with typed null nested objects, the starting point of the resource parsing on import.
+func getNewMachineConfig[machineConfigType util.ResourceModelWithAttributes](ctx context.Context, diagnostics *diag.Diagnostics) machineConfigType {
+	var machineConfig machineConfigType
+	return util.ObjectValueToTypedObject[machineConfigType](ctx, diagnostics, getNullMachineConfig(diagnostics, machineConfig))
 }
 
 func (MachineCatalogDataSourceModel) GetSchema() schema.Schema {
@@ -71,11 +366,12 @@ func (MachineCatalogDataSourceModel) GetSchema() schema.Schema {
 				Description: "A set of identifiers of tags to associate with the machine catalog.",
 				Computed:    true,
 			},
+			"provisioning_scheme": ProvisioningSchemeDataSourceModel{}.GetSchema(),
 		},
 	}
 }
 
-func (r MachineCatalogDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, vdas []citrixorchestration.MachineResponseModel, tags []string) MachineCatalogDataSourceModel {
+func (r MachineCatalogDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, hypervisor *citrixorchestration.HypervisorDetailResponseModel, vdas []citrixorchestration.MachineResponseModel, tags []string) MachineCatalogDataSourceModel {
 	r.Id = types.StringValue(catalog.GetId())
 	r.Name = types.StringValue(catalog.GetName())
 
@@ -89,21 +385,7 @@ func (r MachineCatalogDataSourceModel) RefreshPropertyValues(ctx context.Context
 
 	res := []vda.VdaModel{}
 	for _, model := range vdas {
-		machineName := model.GetName()
-		hosting := model.GetHosting()
-		hostedMachineId := hosting.GetHostedMachineId()
-		machineCatalog := model.GetMachineCatalog()
-		machineCatalogId := machineCatalog.GetId()
-		deliveryGroup := model.GetDeliveryGroup()
-		deliveryGroupId := deliveryGroup.GetId()
-
-		res = append(res, vda.VdaModel{
-			Id:                       types.StringValue(model.GetId()),
-			MachineName:              types.StringValue(machineName),
-			HostedMachineId:          types.StringValue(hostedMachineId),
-			AssociatedMachineCatalog: types.StringValue(machineCatalogId),
-			AssociatedDeliveryGroup:  types.StringValue(deliveryGroupId),
-		})
+		res = append(res, vda.VdaModel{}.RefreshPropertyValues(ctx, diagnostics, model))
 	}
 
 	r.Vdas = res
@@ -111,5 +393,12 @@ func (r MachineCatalogDataSourceModel) RefreshPropertyValues(ctx context.Context
 	r.Tenants = util.RefreshTenantSet(ctx, diagnostics, catalog.GetTenants())
 	r.Tags = util.StringArrayToStringSet(ctx, diagnostics, tags)
 
+	if _, ok := catalog.GetProvisioningSchemeOk(); ok {
+		provSchemeModel := ProvisioningSchemeDataSourceModel{}.RefreshPropertyValues(ctx, diagnostics, catalog, hypervisor.GetConnectionType(), hypervisor.GetPluginId())
+		r.ProvisioningScheme = &provSchemeModel
+	} else {
+		r.ProvisioningScheme = nil
+	}
+
 	return r
 }
@@ -0,0 +1,155 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMachineCatalogDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeorchestration.NewClient(t)
+
+	hypervisorId := server.AddObject("Hypervisors", map[string]any{"Name": "azure", "ConnectionType": "AzureRM"})
+	diskImage := func(name string, status string, date string) map[string]any {
+		return map[string]any{
+			"Image":           map[string]any{"Name": name, "XDPath": `XDHyp:\HostingUnits\pool\image.folder\rg-images.resourcegroup\` + name + ".manageddisk"},
+			"ImageStatus":     status,
+			"Date":            date,
+			"MasterImageNote": "note of " + name,
+		}
+	}
+	currentImage := diskImage("image-3", "Current", "2026-03-10T08:00:00Z")
+	catalogId := server.AddObject("MachineCatalogs", map[string]any{
+		"Name":                 "catalog",
+		"ProvisioningType":     "MCS",
+		"HypervisorConnection": hypervisorId,
+		"ProvisioningScheme": map[string]any{
+			"ResourcePool":     map[string]any{"Id": "pool-id", "Name": "pool", "Hypervisor": map[string]any{"Id": hypervisorId, "Name": "azure"}},
+			"IdentityType":     "ActiveDirectory",
+			"MachineCount":     2,
+			"ServiceOffering":  "Standard_D2s_v3",
+			"MasterImage":      currentImage["Image"],
+			"CurrentDiskImage": currentImage,
+			"HistoricalDiskImages": []any{
+				diskImage("image-1", "Deleted", "2026-01-10T08:00:00Z"),
+				currentImage,
+				diskImage("image-2", "Prepared", "2026-02-10T08:00:00Z"),
+			},
+			"MachineAccountCreationRules": map[string]any{
+				"NamingScheme":     "web-##",
+				"NamingSchemeType": "Numeric",
+				"Domain":           map[string]any{"Name": "example.com"},
+			},
+		},
+	})
+	server.AddObject("Machines", map[string]any{"Name": `DOMAIN\web-01`, "MachineCatalog": catalogId})
+	server.AddObject("Machines", map[string]any{"Name": `DOMAIN\web-02`, "MachineCatalog": catalogId})
+	manualCatalogId := server.AddObject("MachineCatalogs", map[string]any{"Name": "manual-catalog", "ProvisioningType": "Manual"})
+
+	d := &machine_catalog.MachineCatalogDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &datasource.ConfigureResponse{})
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	read := func(t *testing.T, config machine_catalog.MachineCatalogDataSourceModel) machine_catalog.MachineCatalogDataSourceModel {
+		t.Helper()
+		// The configuration is built through a state, which accepts a model with computed attributes
+		config.Tenants = types.SetNull(types.StringType)
+		config.Tags = types.SetNull(types.StringType)
+		configState := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		if diags := configState.Set(ctx, config); diags.HasError() {
+			t.Fatalf("error setting the configuration: %v", diags)
+		}
+
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		var data machine_catalog.MachineCatalogDataSourceModel
+		if diags := resp.State.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("error reading the state: %v", diags)
+		}
+		return data
+	}
+
+	t.Run("mcs catalog", func(t *testing.T) {
+		data := read(t, machine_catalog.MachineCatalogDataSourceModel{Name: types.StringValue("catalog")})
+
+		machines := []string{}
+		for _, machine := range data.Vdas {
+			machines = append(machines, machine.MachineName.ValueString())
+		}
+		if expected := []string{`DOMAIN\web-01`, `DOMAIN\web-02`}; !reflect.DeepEqual(machines, expected) {
+			t.Errorf("expected machines %v, got %v", expected, machines)
+		}
+
+		provScheme := data.ProvisioningScheme
+		if provScheme == nil {
+			t.Fatalf("expected the provisioning scheme to be set")
+		}
+		if provScheme.Hypervisor.ValueString() != hypervisorId || provScheme.HypervisorResourcePool.ValueString() != "pool-id" {
+			t.Errorf("expected hypervisor %s and resource pool pool-id, got %s and %s", hypervisorId, provScheme.Hypervisor, provScheme.HypervisorResourcePool)
+		}
+		if provScheme.NumTotalMachines.ValueInt64() != 2 || provScheme.NamingScheme.ValueString() != "web-##" || provScheme.Domain.ValueString() != "example.com" {
+			t.Errorf("unexpected machine count, naming scheme or domain: %s, %s, %s", provScheme.NumTotalMachines, provScheme.NamingScheme, provScheme.Domain)
+		}
+		if provScheme.MasterImageNote.ValueString() != "note of image-3" {
+			t.Errorf("expected the note of the current image, got %s", provScheme.MasterImageNote)
+		}
+
+		images := []string{}
+		for _, image := range provScheme.ImageHistory {
+			if image.IsCurrent.ValueBool() != (image.MasterImage.ValueString() == currentImage["Image"].(map[string]any)["XDPath"]) {
+				t.Errorf("unexpected current flag on image %s", image.MasterImage)
+			}
+			images = append(images, image.Date.ValueString())
+		}
+		if expected := []string{"2026-03-10T08:00:00Z", "2026-02-10T08:00:00Z", "2026-01-10T08:00:00Z"}; !reflect.DeepEqual(images, expected) {
+			t.Errorf("expected image history dates %v, got %v", expected, images)
+		}
+
+		// The hypervisor specific configuration is parsed the same way as the machine catalog resource
+		if !provScheme.AwsMachineConfig.IsNull() || !provScheme.VsphereMachineConfig.IsNull() {
+			t.Errorf("expected the machine configuration of other hypervisors to be null")
+		}
+		diagnostics := diag.Diagnostics{}
+		azureMachineConfig := util.ObjectValueToTypedObject[machine_catalog.AzureMachineConfigModel](ctx, &diagnostics, provScheme.AzureMachineConfig)
+		azureMasterImage := util.ObjectValueToTypedObject[machine_catalog.AzureMasterImageModel](ctx, &diagnostics, azureMachineConfig.AzureMasterImage)
+		if diagnostics.HasError() {
+			t.Fatalf("error reading the azure machine config: %v", diagnostics)
+		}
+		if azureMachineConfig.ServiceOffering.ValueString() != "Standard_D2s_v3" {
+			t.Errorf("expected service offering Standard_D2s_v3, got %s", azureMachineConfig.ServiceOffering)
+		}
+		if azureMasterImage.MasterImage.ValueString() != "image-3" || azureMasterImage.ResourceGroup.ValueString() != "rg-images" {
+			t.Errorf("expected master image image-3 in rg-images, got %s in %s", azureMasterImage.MasterImage, azureMasterImage.ResourceGroup)
+		}
+	})
+
+	t.Run("manual catalog", func(t *testing.T) {
+		data := read(t, machine_catalog.MachineCatalogDataSourceModel{Id: types.StringValue(manualCatalogId)})
+		if data.ProvisioningScheme != nil {
+			t.Errorf("expected no provisioning scheme for a manual catalog")
+		}
+		if len(data.Vdas) != 0 {
+			t.Errorf("expected no machines, got %d", len(data.Vdas))
+		}
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateProvisioningSchemeHypervisorResources resolves the hypervisor resources referenced by the provisioning scheme at plan time,
+// so that a misspelled service offering, master image, storage or network fails the plan instead of the catalog job.
+// Only configurations that are new or changed compared to the state are resolved to limit the number of hypervisor calls.
+func validateProvisioningSchemeHypervisorResources(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, provSchemePlan ProvisioningSchemeModel, provSchemeState *ProvisioningSchemeModel) {
+	if client == nil || provSchemePlan.Hypervisor.IsUnknown() || provSchemePlan.Hypervisor.IsNull() ||
+		provSchemePlan.HypervisorResourcePool.IsUnknown() || provSchemePlan.HypervisorResourcePool.IsNull() {
+		return
+	}
+
+	resourcePoolChanged := provSchemeState == nil ||
+		!provSchemePlan.Hypervisor.Equal(provSchemeState.Hypervisor) ||
+		!provSchemePlan.HypervisorResourcePool.Equal(provSchemeState.HypervisorResourcePool)
+	machineConfigChanged := resourcePoolChanged
+	networkMappingChanged := resourcePoolChanged
+	if !resourcePoolChanged {
+		machineConfigChanged = !provSchemePlan.AzureMachineConfig.Equal(provSchemeState.AzureMachineConfig) ||
+			!provSchemePlan.AwsMachineConfig.Equal(provSchemeState.AwsMachineConfig) ||
+			!provSchemePlan.GcpMachineConfig.Equal(provSchemeState.GcpMachineConfig) ||
+			!provSchemePlan.VsphereMachineConfig.Equal(provSchemeState.VsphereMachineConfig) ||
+			!provSchemePlan.XenserverMachineConfig.Equal(provSchemeState.XenserverMachineConfig) ||
+			!provSchemePlan.NutanixMachineConfig.Equal(provSchemeState.NutanixMachineConfig) ||
+			!provSchemePlan.SCVMMMachineConfigModel.Equal(provSchemeState.SCVMMMachineConfigModel)
+		networkMappingChanged = !provSchemePlan.NetworkMapping.Equal(provSchemeState.NetworkMapping)
+	}
+	networkMappingChanged = networkMappingChanged && !provSchemePlan.NetworkMapping.IsUnknown() && !provSchemePlan.NetworkMapping.IsNull()
+	if !machineConfigChanged && !networkMappingChanged {
+		return
+	}
+
+	// Lookup failures are left for the catalog job to report, plan time validation only reports resources that are confirmed missing
+	hypervisor, err := util.GetHypervisor(ctx, client, &diag.Diagnostics{}, provSchemePlan.Hypervisor.ValueString())
+	if err != nil {
+		return
+	}
+	resourcePool, err := util.GetHypervisorResourcePool(ctx, client, &diag.Diagnostics{}, provSchemePlan.Hypervisor.ValueString(), provSchemePlan.HypervisorResourcePool.ValueString())
+	if err != nil {
+		return
+	}
+
+	hypervisorId := hypervisor.GetId()
+	resourcePoolId := resourcePool.GetId()
+	resourcePoolName := resourcePool.GetName()
+	provSchemePath := path.Root("provisioning_scheme")
+	validate := func(attributePath path.Path, folderPath string, resourceName types.String, resourceType string, resourceDescription string) {
+		if resourceName.IsUnknown() || resourceName.ValueString() == "" {
+			return
+		}
+		util.ValidateResourcePoolResourceExists(ctx, client, diagnostics, attributePath, hypervisorId, resourcePoolId, resourcePoolName, folderPath, resourceName.ValueString(), resourceType, resourceDescription)
+	}
+
+	if machineConfigChanged {
+		switch hypervisor.GetConnectionType() {
+		case citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM:
+			if provSchemePlan.AzureMachineConfig.IsUnknown() || provSchemePlan.AzureMachineConfig.IsNull() {
+				break
+			}
+			configPath := provSchemePath.AtName("azure_machine_config")
+			azureMachineConfig := util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, diagnostics, provSchemePlan.AzureMachineConfig)
+			validate(configPath.AtName("service_offering"), "serviceoffering.folder", azureMachineConfig.ServiceOffering, util.ServiceOfferingResourceType, "Service offering")
+
+			if !azureMachineConfig.AzureMasterImage.IsUnknown() && !azureMachineConfig.AzureMasterImage.IsNull() {
+				azureMasterImage := util.ObjectValueToTypedObject[AzureMasterImageModel](ctx, diagnostics, azureMachineConfig.AzureMasterImage)
+				if !azureMasterImage.ResourceGroup.IsUnknown() && !azureMasterImage.SharedSubscription.IsUnknown() &&
+					!azureMasterImage.StorageAccount.IsUnknown() && !azureMasterImage.Container.IsUnknown() {
+					folderPath := util.BuildAzureImageFolderPath(azureMasterImage.SharedSubscription.ValueString(), azureMasterImage.ResourceGroup.ValueString(), azureMasterImage.StorageAccount.ValueString(), azureMasterImage.Container.ValueString())
+					validate(configPath.AtName("azure_master_image").AtName("master_image"), folderPath, azureMasterImage.MasterImage, "", "Master image")
+				}
+			}
+
+			if !azureMachineConfig.MachineProfile.IsUnknown() && !azureMachineConfig.MachineProfile.IsNull() {
+				machineProfile := util.ObjectValueToTypedObject[util.AzureMachineProfileModel](ctx, diagnostics, azureMachineConfig.MachineProfile)
+				util.ValidateAzureMachineProfile(ctx, client, diagnostics, configPath.AtName("machine_profile"), hypervisorId, resourcePoolId, resourcePoolName, machineProfile)
+			}
+		case citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS:
+			if provSchemePlan.AwsMachineConfig.IsUnknown() || provSchemePlan.AwsMachineConfig.IsNull() {
+				break
+			}
+			configPath := provSchemePath.AtName("aws_machine_config")
+			awsMachineConfig := util.ObjectValueToTypedObject[AwsMachineConfigModel](ctx, diagnostics, provSchemePlan.AwsMachineConfig)
+			validate(configPath.AtName("service_offering"), "", awsMachineConfig.ServiceOffering, util.ServiceOfferingResourceType, "Service offering")
+
+			if awsMachineConfig.AwsEc2PreparedImage.IsNull() && !awsMachineConfig.ImageAmi.IsUnknown() && !awsMachineConfig.MasterImage.IsUnknown() {
+				imageId := types.StringValue(fmt.Sprintf("%s (%s)", awsMachineConfig.MasterImage.ValueString(), awsMachineConfig.ImageAmi.ValueString()))
+				validate(configPath.AtName("master_image"), "", imageId, util.TemplateResourceType, "Master image")
+			}
+		case citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM:
+			if provSchemePlan.GcpMachineConfig.IsUnknown() || provSchemePlan.GcpMachineConfig.IsNull() {
+				break
+			}
+			gcpMachineConfig := util.ObjectValueToTypedObject[GcpMachineConfigModel](ctx, diagnostics, provSchemePlan.GcpMachineConfig)
+			validate(provSchemePath.AtName("gcp_machine_config").AtName("master_image"), "", gcpMachineConfig.MasterImage, util.VirtualMachineResourceType, "Master image VM")
+		case citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER:
+			if provSchemePlan.VsphereMachineConfig.IsUnknown() || provSchemePlan.VsphereMachineConfig.IsNull() {
+				break
+			}
+			vSphereMachineConfig := util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, diagnostics, provSchemePlan.VsphereMachineConfig)
+			if vSphereMachineConfig.VspherePreparedImage.IsNull() && !vSphereMachineConfig.ResourcePoolPath.IsUnknown() {
+				folderPath := getOnPremImageFolderPath(vSphereMachineConfig.ResourcePoolPath.ValueString())
+				validate(provSchemePath.AtName("vsphere_machine_config").AtName("master_image_vm"), folderPath, vSphereMachineConfig.MasterImageVm, util.VirtualMachineResourceType, "Master image VM")
+			}
+		case citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER:
+			if provSchemePlan.XenserverMachineConfig.IsUnknown() || provSchemePlan.XenserverMachineConfig.IsNull() {
+				break
+			}
+			xenserverMachineConfig := util.ObjectValueToTypedObject[XenserverMachineConfigModel](ctx, diagnostics, provSchemePlan.XenserverMachineConfig)
+			validate(provSchemePath.AtName("xenserver_machine_config").AtName("master_image_vm"), "", xenserverMachineConfig.MasterImageVm, util.VirtualMachineResourceType, "Master image VM")
+		case citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM:
+			if provSchemePlan.SCVMMMachineConfigModel.IsUnknown() || provSchemePlan.SCVMMMachineConfigModel.IsNull() {
+				break
+			}
+			scvmmMachineConfig := util.ObjectValueToTypedObject[SCVMMMachineConfigModel](ctx, diagnostics, provSchemePlan.SCVMMMachineConfigModel)
+			validate(provSchemePath.AtName("scvmm_machine_config").AtName("master_image"), "", scvmmMachineConfig.MasterImage, util.VirtualMachineResourceType, "Master image VM")
+		case citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM:
+			if hypervisor.GetPluginId() != util.NUTANIX_PLUGIN_ID || provSchemePlan.NutanixMachineConfig.IsUnknown() || provSchemePlan.NutanixMachineConfig.IsNull() {
+				break
+			}
+			configPath := provSchemePath.AtName("nutanix_machine_config")
+			nutanixMachineConfig := util.ObjectValueToTypedObject[NutanixMachineConfigModel](ctx, diagnostics, provSchemePlan.NutanixMachineConfig)
+			validate(configPath.AtName("master_image"), "", nutanixMachineConfig.MasterImage, util.TemplateResourceType, "Master image")
+			validate(configPath.AtName("container"), "", nutanixMachineConfig.Container, util.StorageResourceType, "Container")
+		}
+	}
+
+	if networkMappingChanged && hypervisor.GetConnectionType() != citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM {
+		networkMappings := util.ObjectListToTypedArray[util.NetworkMappingModel](ctx, diagnostics, provSchemePlan.NetworkMapping)
+		util.ValidateNetworkMappingNetworks(diagnostics, provSchemePath.AtName("network_mapping"), networkMappings, resourcePool, hypervisor.GetPluginId())
+	}
+}
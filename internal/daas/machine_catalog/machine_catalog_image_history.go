@@ -0,0 +1,164 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ImageHistoryModel maps a single entry of the provisioning scheme image history.
+type ImageHistoryModel struct {
+	MasterImage     types.String `tfsdk:"master_image"`
+	ImageDefinition types.String `tfsdk:"image_definition"`
+	ImageVersion    types.String `tfsdk:"image_version"`
+	Date            types.String `tfsdk:"date"`
+	Note            types.String `tfsdk:"note"`
+	IsCurrent       types.Bool   `tfsdk:"is_current"`
+	IsAvailable     types.Bool   `tfsdk:"is_available"`
+}
+
+func (ImageHistoryModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"master_image": schema.StringAttribute{
+				Description: "XDPath of the master image. Only set for catalogs provisioned from a master image.",
+				Computed:    true,
+			},
+			"image_definition": schema.StringAttribute{
+				Description: "Id of the image definition. Only set for catalogs provisioned from a prepared image.",
+				Computed:    true,
+			},
+			"image_version": schema.StringAttribute{
+				Description: "Id of the image version. Only set for catalogs provisioned from a prepared image.",
+				Computed:    true,
+			},
+			"date": schema.StringAttribute{
+				Description: "Time the image was assigned to the catalog.",
+				Computed:    true,
+			},
+			"note": schema.StringAttribute{
+				Description: "Note recorded when the image was assigned to the catalog.",
+				Computed:    true,
+			},
+			"is_current": schema.BoolAttribute{
+				Description: "Whether the image is the one currently used to provision new machines.",
+				Computed:    true,
+			},
+			"is_available": schema.BoolAttribute{
+				Description: "Whether the image is still available on the hypervisor or in the image definition.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (ImageHistoryModel) GetAttributes() map[string]schema.Attribute {
+	return ImageHistoryModel{}.GetSchema().Attributes
+}
+
+func (ImageHistoryModel) GetDataSourceSchema() dataSourceSchema.NestedAttributeObject {
+	return dataSourceSchema.NestedAttributeObject{
+		Attributes: map[string]dataSourceSchema.Attribute{
+			"master_image": dataSourceSchema.StringAttribute{
+				Description: "XDPath of the master image. Only set for catalogs provisioned from a master image.",
+				Computed:    true,
+			},
+			"image_definition": dataSourceSchema.StringAttribute{
+				Description: "Id of the image definition. Only set for catalogs provisioned from a prepared image.",
+				Computed:    true,
+			},
+			"image_version": dataSourceSchema.StringAttribute{
+				Description: "Id of the image version. Only set for catalogs provisioned from a prepared image.",
+				Computed:    true,
+			},
+			"date": dataSourceSchema.StringAttribute{
+				Description: "Time the image was assigned to the catalog.",
+				Computed:    true,
+			},
+			"note": dataSourceSchema.StringAttribute{
+				Description: "Note recorded when the image was assigned to the catalog.",
+				Computed:    true,
+			},
+			"is_current": dataSourceSchema.BoolAttribute{
+				Description: "Whether the image is the one currently used to provision new machines.",
+				Computed:    true,
+			},
+			"is_available": dataSourceSchema.BoolAttribute{
+				Description: "Whether the image is still available on the hypervisor or in the image definition.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// getProvisioningSchemeImageHistory returns the images assigned to the provisioning scheme, most recent first.
+// Catalogs provisioned from a prepared image report image versions, other MCS catalogs report master images.
+func getProvisioningSchemeImageHistory(provScheme *citrixorchestration.ProvisioningSchemeResponseModel) []ImageHistoryModel {
+	history := []ImageHistoryModel{}
+	if provScheme == nil {
+		return history
+	}
+
+	if provScheme.CurrentImageVersion != nil || len(provScheme.GetHistoricalImageVersions()) > 0 {
+		currentImageVersion := provScheme.GetCurrentImageVersion()
+		currentImageVersionId := currentImageVersion.ImageVersion.GetId()
+		imageVersions := provScheme.GetHistoricalImageVersions()
+		if provScheme.CurrentImageVersion != nil && !slices.ContainsFunc(imageVersions, func(imageVersion citrixorchestration.ProvisioningSchemeImageVersionHistoryResponseModel) bool {
+			return strings.EqualFold(imageVersion.ImageVersion.GetId(), currentImageVersionId)
+		}) {
+			imageVersions = append([]citrixorchestration.ProvisioningSchemeImageVersionHistoryResponseModel{currentImageVersion}, imageVersions...)
+		}
+
+		for _, imageVersion := range imageVersions {
+			imageDefinition := imageVersion.ImageVersion.GetImageDefinition()
+			isAvailable := true
+			if imageVersion.IsImageAvailable != nil {
+				isAvailable = imageVersion.GetIsImageAvailable()
+			}
+			history = append(history, ImageHistoryModel{
+				MasterImage:     types.StringNull(),
+				ImageDefinition: types.StringValue(imageDefinition.GetId()),
+				ImageVersion:    types.StringValue(imageVersion.ImageVersion.GetId()),
+				Date:            types.StringValue(imageVersion.GetDate()),
+				Note:            types.StringValue(imageVersion.GetImageAssignmentNote()),
+				IsCurrent:       types.BoolValue(provScheme.CurrentImageVersion != nil && strings.EqualFold(imageVersion.ImageVersion.GetId(), currentImageVersionId)),
+				IsAvailable:     types.BoolValue(isAvailable),
+			})
+		}
+	} else {
+		for _, diskImage := range provScheme.GetHistoricalDiskImages() {
+			image := diskImage.GetImage()
+			history = append(history, ImageHistoryModel{
+				MasterImage:     types.StringValue(image.GetXDPath()),
+				ImageDefinition: types.StringNull(),
+				ImageVersion:    types.StringNull(),
+				Date:            types.StringValue(diskImage.GetDate()),
+				Note:            types.StringValue(diskImage.GetMasterImageNote()),
+				IsCurrent:       types.BoolValue(diskImage.GetImageStatus() == citrixorchestration.VMIMAGESTATUS_CURRENT),
+				IsAvailable:     types.BoolValue(diskImage.GetImageStatus() != citrixorchestration.VMIMAGESTATUS_DELETED),
+			})
+		}
+	}
+
+	// Dates are reported in ISO 8601 format, so sorting them as strings orders the history chronologically
+	slices.SortStableFunc(history, func(a, b ImageHistoryModel) int {
+		return strings.Compare(b.Date.ValueString(), a.Date.ValueString())
+	})
+	return history
+}
+
+// getPreviousImage returns the most recent image of the history that is not the current image and is still available.
+func getPreviousImage(history []ImageHistoryModel) (ImageHistoryModel, bool) {
+	for _, image := range history {
+		if !image.IsCurrent.ValueBool() && image.IsAvailable.ValueBool() {
+			return image, true
+		}
+	}
+	return ImageHistoryModel{}, false
+}
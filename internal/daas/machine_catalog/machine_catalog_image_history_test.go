@@ -0,0 +1,111 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+)
+
+func newTestDiskImage(xdPath string, status citrixorchestration.VMImageStatus, date string) citrixorchestration.VMImageResponseModel {
+	image := citrixorchestration.HypervisorResourceRefResponseModel{}
+	image.SetXDPath(xdPath)
+
+	diskImage := citrixorchestration.VMImageResponseModel{}
+	diskImage.SetImage(image)
+	diskImage.SetImageStatus(status)
+	diskImage.SetDate(date)
+	return diskImage
+}
+
+func newTestImageVersionHistory(imageVersionId string, date string, isAvailable bool) citrixorchestration.ProvisioningSchemeImageVersionHistoryResponseModel {
+	imageDefinition := citrixorchestration.RefResponseModel{}
+	imageDefinition.SetId("definition-id")
+
+	imageVersion := citrixorchestration.ImageVersionRefResponseModel{}
+	imageVersion.SetId(imageVersionId)
+	imageVersion.SetImageDefinition(imageDefinition)
+
+	history := citrixorchestration.ProvisioningSchemeImageVersionHistoryResponseModel{}
+	history.SetImageVersion(imageVersion)
+	history.SetDate(date)
+	history.SetIsImageAvailable(isAvailable)
+	return history
+}
+
+func TestGetProvisioningSchemeImageHistory(t *testing.T) {
+	t.Parallel()
+
+	preparedImageScheme := citrixorchestration.ProvisioningSchemeResponseModel{}
+	preparedImageScheme.SetCurrentImageVersion(newTestImageVersionHistory("version-2", "2026-02-10T08:00:00Z", true))
+	preparedImageScheme.SetHistoricalImageVersions([]citrixorchestration.ProvisioningSchemeImageVersionHistoryResponseModel{
+		newTestImageVersionHistory("version-1", "2026-01-10T08:00:00Z", false),
+	})
+
+	type entry struct {
+		image       string
+		isCurrent   bool
+		isAvailable bool
+	}
+
+	tests := map[string]struct {
+		provScheme *citrixorchestration.ProvisioningSchemeResponseModel
+		expected   []entry
+	}{
+		"image versions include the current version": {
+			provScheme: &preparedImageScheme,
+			expected: []entry{
+				{image: "version-2", isCurrent: true, isAvailable: true},
+				{image: "version-1", isCurrent: false, isAvailable: false},
+			},
+		},
+		"no provisioning scheme": {
+			provScheme: nil,
+			expected:   []entry{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := []entry{}
+			for _, image := range getProvisioningSchemeImageHistory(test.provScheme) {
+				imageName := image.MasterImage.ValueString()
+				if !image.ImageVersion.IsNull() {
+					imageName = image.ImageVersion.ValueString()
+				}
+				actual = append(actual, entry{image: imageName, isCurrent: image.IsCurrent.ValueBool(), isAvailable: image.IsAvailable.ValueBool()})
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected image history %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetPreviousImage(t *testing.T) {
+	t.Parallel()
+
+	provScheme := citrixorchestration.ProvisioningSchemeResponseModel{}
+	provScheme.SetHistoricalDiskImages([]citrixorchestration.VMImageResponseModel{
+		newTestDiskImage("image-1.vm", citrixorchestration.VMIMAGESTATUS_PREPARED, "2026-01-10T08:00:00Z"),
+		newTestDiskImage("image-2.vm", citrixorchestration.VMIMAGESTATUS_DELETED, "2026-02-10T08:00:00Z"),
+		newTestDiskImage("image-3.vm", citrixorchestration.VMIMAGESTATUS_CURRENT, "2026-03-10T08:00:00Z"),
+	})
+
+	previousImage, found := getPreviousImage(getProvisioningSchemeImageHistory(&provScheme))
+	if !found || previousImage.MasterImage.ValueString() != "image-1.vm" {
+		t.Errorf("expected previous image image-1.vm, got %q (found: %t)", previousImage.MasterImage.ValueString(), found)
+	}
+
+	currentOnly := citrixorchestration.ProvisioningSchemeResponseModel{}
+	currentOnly.SetHistoricalDiskImages([]citrixorchestration.VMImageResponseModel{
+		newTestDiskImage("image-3.vm", citrixorchestration.VMIMAGESTATUS_CURRENT, "2026-03-10T08:00:00Z"),
+	})
+	if _, found := getPreviousImage(getProvisioningSchemeImageHistory(&currentOnly)); found {
+		t.Errorf("expected no previous image when the history only contains the current image")
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &machineCatalogListResource{}
+	_ list.ListResourceWithConfigure = &machineCatalogListResource{}
+)
+
+// NewMachineCatalogListResource is a helper function to simplify the provider implementation.
+func NewMachineCatalogListResource() list.ListResource {
+	return &machineCatalogListResource{}
+}
+
+// machineCatalogListResource is the list resource implementation.
+type machineCatalogListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *machineCatalogListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_catalog"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *machineCatalogListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("machine catalogs")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *machineCatalogListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the machine catalogs of the site.
+func (r *machineCatalogListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	machineCatalogs, _ := util.GetMachineCatalogs(ctx, r.client, &diags, "Id,Name,FullName")
+	items := []util.ListResourceItem{}
+	for _, machineCatalog := range machineCatalogs {
+		items = append(items, util.ListResourceItem{Id: machineCatalog.GetId(), DisplayName: machineCatalog.GetFullName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewMachineCatalogResource, r.client)
+}
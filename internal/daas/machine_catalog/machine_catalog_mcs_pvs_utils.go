@@ -10,9 +10,9 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
-	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
@@ -870,73 +870,38 @@ func generateAdminCredentialHeader(machineDomainIdentityModel util.MachineDomain
 func deleteMachinesFromMcsPvsCatalog(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, provisioningSchemePlan ProvisioningSchemeModel, machineAccountsInPlan []MachineADAccountModel) error {
 	catalogId := catalog.GetId()
 	catalogName := catalog.GetName()
-
-	getMachinesResponses, err := util.GetMachineCatalogMachines(ctx, client, &resp.Diagnostics, catalogId)
-	if err != nil {
-		return err
-	}
-
 	machineDeleteRequestCount := int(catalog.GetTotalCount()) - int(provisioningSchemePlan.NumTotalMachines.ValueInt64())
-	machinesToDelete := []citrixorchestration.MachineResponseModel{}
 
-	type prioritizedMachine struct {
-		machine  citrixorchestration.MachineResponseModel
-		priority int
-	}
-
-	var prioritizedCandidates []prioritizedMachine
-
-	for _, machine := range getMachinesResponses {
-		if machine.GetAllocationType() == citrixorchestration.ALLOCATIONTYPE_STATIC && len(machine.GetAssignedUsers()) > 0 {
-			continue
+	if !provisioningSchemePlan.ScaleDown.IsNull() {
+		scaleDown := util.ObjectValueToTypedObject[ScaleDownModel](ctx, &resp.Diagnostics, provisioningSchemePlan.ScaleDown)
+		catalogMachines, err := util.GetMachineCatalogMachinesWithFields(ctx, client, &resp.Diagnostics, catalogId, scaleDownMachineFields)
+		if err != nil {
+			return err
 		}
-		// Initialize priority for the current machine.
-		// The priority is used to determine the order in which machines should be deleted.
-		priority := 0
-
-		// Priority 1: Machine has no delivery group associated. This is the highest priority for deletion.
-		if !machine.GetDeliveryGroup().Id.IsSet() {
-			priority = 1
-		} else if machine.GetInMaintenanceMode() {
-			// Priority 2: Machine is currently in maintenance mode.
-			priority = 2
-		} else if machine.GetSessionCount() == 0 {
-			// Priority 3: Machine has zero active sessions.
-			priority = 3
+		machinesToDelete, err := selectMachinesToScaleDown(ctx, &resp.Diagnostics, catalogMachines, scaleDown, machineDeleteRequestCount)
+		if err != nil {
+			return err
 		}
-
-		// If the machine is eligible for deletion (i.e., has been assigned a priority),
-		// add it to our list of candidates.
-		if priority > 0 {
-			prioritizedCandidates = append(prioritizedCandidates, prioritizedMachine{
-				machine:  machine,
-				priority: priority,
-			})
+		if scaleDown.Drain.ValueBool() {
+			err = drainMachines(ctx, &resp.Diagnostics, client, catalog, provisioningSchemePlan, machinesToDelete, time.Duration(scaleDown.DrainTimeoutMinutes.ValueInt64())*time.Minute)
+			if err != nil {
+				return err
+			}
 		}
+		return deleteMachinesFromCatalog(ctx, client, resp, provisioningSchemePlan, machinesToDelete, catalogName, true, machineAccountsInPlan)
 	}
 
-	// Sort the collected candidates by their assigned priority in ascending order.
-	sort.SliceStable(prioritizedCandidates, func(i, j int) bool {
-		return prioritizedCandidates[i].priority < prioritizedCandidates[j].priority
-	})
-
-	for _, candidate := range prioritizedCandidates {
-		if len(machinesToDelete) == machineDeleteRequestCount {
-			break
-		}
-		machinesToDelete = append(machinesToDelete, candidate.machine)
+	getMachinesResponses, err := util.GetMachineCatalogMachinesWithFields(ctx, client, &resp.Diagnostics, catalogId, scaleDownMachineFields)
+	if err != nil {
+		return err
 	}
 
-	machinesToDeleteCount := len(machinesToDelete)
-
-	if machineDeleteRequestCount > machinesToDeleteCount {
-		errorString := fmt.Sprintf("%d machine(s) requested to be deleted. %d machine(s) qualify for deletion.", machineDeleteRequestCount, machinesToDeleteCount)
-
+	machinesToDelete, err := selectMachinesToDeleteByPriority(getMachinesResponses, machineDeleteRequestCount)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting machine(s) from Machine Catalog "+catalogName,
-			errorString+" Ensure machines that need to be deleted have no active sessions. For machines with `Static` allocation type, also ensure there are no assigned users.",
+			err.Error()+" Ensure machines that need to be deleted have no active sessions. For machines with `Static` allocation type, also ensure there are no assigned users.",
 		)
-
 		return err
 	}
 
@@ -1064,10 +1029,10 @@ func addMachinesToMcsPvsCatalog(ctx context.Context, client *citrixdaasclient.Ci
 	return nil
 }
 
-func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, plan MachineCatalogResourceModel, catalog *citrixorchestration.MachineCatalogDetailResponseModel, machineProfilePath string, resourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel, hypervisorPluginId string) error {
+func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, plan MachineCatalogResourceModel, catalog *citrixorchestration.MachineCatalogDetailResponseModel, machineProfilePath string, resourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel, hypervisorPluginId string) error {
 	var body citrixorchestration.UpdateMachineCatalogRequestModel
 	body.SetMachineProfilePath(machineProfilePath)
-	provSchemeModel := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, plan.ProvisioningScheme)
+	provSchemeModel := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, diagnostics, plan.ProvisioningScheme)
 	if provSchemeModel.NetworkMapping.IsNull() {
 		provScheme := catalog.GetProvisioningScheme()
 		networkMapping := provScheme.GetNetworkMaps()
@@ -1085,10 +1050,10 @@ func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.C
 			body.SetNetworkMapping(updateNetworkMapping)
 		}
 	} else {
-		networkMappingModel := util.ObjectListToTypedArray[util.NetworkMappingModel](ctx, &resp.Diagnostics, provSchemeModel.NetworkMapping)
+		networkMappingModel := util.ObjectListToTypedArray[util.NetworkMappingModel](ctx, diagnostics, provSchemeModel.NetworkMapping)
 		networkMapping, err := util.ParseNetworkMappingToClientModel(networkMappingModel, resourcePool, hypervisorPluginId)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diagnostics.AddError(
 				"Error creating Machine Catalog",
 				fmt.Sprintf("Failed to find hypervisor network, error: %s", err.Error()),
 			)
@@ -1101,7 +1066,7 @@ func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.C
 	updateMachineCatalogRequest = updateMachineCatalogRequest.UpdateMachineCatalogRequestModel(body).Async(true)
 	_, httpResp, err := citrixdaasclient.AddRequestData(updateMachineCatalogRequest, client).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diagnostics.AddError(
 			"Error updating Machine Catalog "+catalog.GetName(),
 			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 				"\nError message: "+util.ReadClientError(err),
@@ -1109,7 +1074,7 @@ func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.C
 		return err
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating machine profile for Machine Catalog "+catalog.GetName(), &resp.Diagnostics, 15)
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating machine profile for Machine Catalog "+catalog.GetName(), diagnostics, 15)
 	if errors.Is(err, &util.JobPollError{}) {
 		return err
 	} // if the job failed continue processing
@@ -1117,14 +1082,14 @@ func updateCatalogMachineProfile(ctx context.Context, client *citrixdaasclient.C
 	return nil
 }
 
-func updateMemoryAndCpuCount(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, plan MachineCatalogResourceModel, connectionType citrixorchestration.HypervisorConnectionType) error {
-	provisioningSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, plan.ProvisioningScheme)
+func updateMemoryAndCpuCount(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, plan MachineCatalogResourceModel, connectionType citrixorchestration.HypervisorConnectionType) error {
+	provisioningSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, diagnostics, plan.ProvisioningScheme)
 
 	cpuCount := int32(0)
 	memoryMB := int32(0)
 	switch connectionType {
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT:
-		openshiftMachineConfig := util.ObjectValueToTypedObject[OpenshiftMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.OpenshiftMachineConfig)
+		openshiftMachineConfig := util.ObjectValueToTypedObject[OpenshiftMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.OpenshiftMachineConfig)
 		cpuCount = int32(openshiftMachineConfig.CpuCount.ValueInt64())
 		memoryMB = int32(openshiftMachineConfig.MemoryMB.ValueInt64())
 	}
@@ -1137,7 +1102,7 @@ func updateMemoryAndCpuCount(ctx context.Context, client *citrixdaasclient.Citri
 	updateMachineCatalogRequest = updateMachineCatalogRequest.UpdateMachineCatalogRequestModel(body).Async(true)
 	_, httpResp, err := citrixdaasclient.AddRequestData(updateMachineCatalogRequest, client).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diagnostics.AddError(
 			"Error updating Machine Catalog "+catalog.GetName(),
 			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 				"\nError message: "+util.ReadClientError(err),
@@ -1145,7 +1110,7 @@ func updateMemoryAndCpuCount(ctx context.Context, client *citrixdaasclient.Citri
 		return err
 	}
 
-	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating memory and cpu count for Machine Catalog "+catalog.GetName(), &resp.Diagnostics, 15)
+	err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating memory and cpu count for Machine Catalog "+catalog.GetName(), diagnostics, 15)
 	if errors.Is(err, &util.JobPollError{}) {
 		return err
 	} // if the job failed continue processing
@@ -1153,7 +1118,7 @@ func updateMemoryAndCpuCount(ctx context.Context, client *citrixdaasclient.Citri
 	return nil
 }
 
-func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, resp *resource.UpdateResponse, catalog *citrixorchestration.MachineCatalogDetailResponseModel, plan MachineCatalogResourceModel, provisioningType *citrixorchestration.ProvisioningType, maxTimeoutInMinutes int32) error {
+func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, plan MachineCatalogResourceModel, provisioningType *citrixorchestration.ProvisioningType, maxTimeoutInMinutes int32) error {
 	catalogName := catalog.GetName()
 	catalogId := catalog.GetId()
 
@@ -1164,14 +1129,14 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 	customProps := provScheme.GetCustomProperties()
 	currentImageDetails := provScheme.GetCurrentImageVersion()
 
-	provisioningSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, plan.ProvisioningScheme)
+	provisioningSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, diagnostics, plan.ProvisioningScheme)
 
-	hypervisor, errResp := util.GetHypervisor(ctx, client, &resp.Diagnostics, provisioningSchemePlan.Hypervisor.ValueString())
+	hypervisor, errResp := util.GetHypervisor(ctx, client, diagnostics, provisioningSchemePlan.Hypervisor.ValueString())
 	if errResp != nil {
 		return errResp
 	}
 
-	hypervisorResourcePool, errResp := util.GetHypervisorResourcePool(ctx, client, &resp.Diagnostics, provisioningSchemePlan.Hypervisor.ValueString(), provisioningSchemePlan.HypervisorResourcePool.ValueString())
+	hypervisorResourcePool, errResp := util.GetHypervisorResourcePool(ctx, client, diagnostics, provisioningSchemePlan.Hypervisor.ValueString(), provisioningSchemePlan.HypervisorResourcePool.ValueString())
 	if errResp != nil {
 		return errResp
 	}
@@ -1199,32 +1164,23 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 	switch connectionType {
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM:
-		azureMachineConfigModel := util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.AzureMachineConfig)
+		azureMachineConfigModel := util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.AzureMachineConfig)
 		azureMachineProfile := azureMachineConfigModel.MachineProfile
 		if *provisioningType != citrixorchestration.PROVISIONINGTYPE_PVS_STREAMING {
 			if !azureMachineConfigModel.AzureMasterImage.IsNull() {
-				azureMasterImageModel := util.ObjectValueToTypedObject[AzureMasterImageModel](ctx, &resp.Diagnostics, azureMachineConfigModel.AzureMasterImage)
+				azureMasterImageModel := util.ObjectValueToTypedObject[AzureMasterImageModel](ctx, diagnostics, azureMachineConfigModel.AzureMasterImage)
 				sharedSubscription := azureMasterImageModel.SharedSubscription.ValueString()
 				newImage := azureMasterImageModel.MasterImage.ValueString()
 				resourceGroup := azureMasterImageModel.ResourceGroup.ValueString()
-				imageBasePath := "image.folder"
-				if sharedSubscription != "" {
-					imageBasePath = fmt.Sprintf("image.folder\\%s.sharedsubscription", sharedSubscription)
-				}
 
 				if newImage != "" {
 					storageAccount := azureMasterImageModel.StorageAccount.ValueString()
 					container := azureMasterImageModel.Container.ValueString()
+					queryPath := util.BuildAzureImageFolderPath(sharedSubscription, resourceGroup, storageAccount, container)
 					if storageAccount != "" && container != "" {
-						queryPath := fmt.Sprintf(
-							"%s\\%s.resourcegroup\\%s.storageaccount\\%s.container",
-							imageBasePath,
-							resourceGroup,
-							storageAccount,
-							container)
-						imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, newImage, "", "")
+						imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, newImage, "", "")
 						if err != nil {
-							resp.Diagnostics.AddError(
+							diagnostics.AddError(
 								"Error updating Machine Catalog",
 								"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 									fmt.Sprintf("\nFailed to resolve master image VHD %s in container %s of storage account %s, error: %s", newImage, container, storageAccount, err.Error()),
@@ -1232,13 +1188,9 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 							return err
 						}
 					} else {
-						queryPath := fmt.Sprintf(
-							"%s\\%s.resourcegroup",
-							imageBasePath,
-							resourceGroup)
-						imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, newImage, "", "")
+						imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, newImage, "", "")
 						if err != nil {
-							resp.Diagnostics.AddError(
+							diagnostics.AddError(
 								"Error updating Machine Catalog",
 								"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 									fmt.Sprintf("\nFailed to resolve master image Managed Disk or Snapshot %s, error: %s", newImage, err.Error()),
@@ -1247,20 +1199,15 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 						}
 					}
 				} else if !azureMasterImageModel.GalleryImage.IsNull() {
-					azureGalleryImage := util.ObjectValueToTypedObject[util.GalleryImageModel](ctx, &resp.Diagnostics, azureMasterImageModel.GalleryImage)
+					azureGalleryImage := util.ObjectValueToTypedObject[util.GalleryImageModel](ctx, diagnostics, azureMasterImageModel.GalleryImage)
 					gallery := azureGalleryImage.Gallery.ValueString()
 					definition := azureGalleryImage.Definition.ValueString()
 					version := azureGalleryImage.Version.ValueString()
 					if gallery != "" && definition != "" {
-						queryPath := fmt.Sprintf(
-							"%s\\%s.resourcegroup\\%s.gallery\\%s.imagedefinition",
-							imageBasePath,
-							resourceGroup,
-							gallery,
-							definition)
-						imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, version, "", "")
+						queryPath := util.BuildAzureGalleryImagePath(sharedSubscription, resourceGroup, gallery, definition)
+						imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, version, "", "")
 						if err != nil {
-							resp.Diagnostics.AddError(
+							diagnostics.AddError(
 								"Error updating Machine Catalog",
 								"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 									fmt.Sprintf("\nFailed to locate Azure Image Gallery image %s of version %s in gallery %s, error: %s", newImage, version, gallery, err.Error()),
@@ -1274,23 +1221,23 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			} else if !azureMachineConfigModel.AzurePreparedImage.IsNull() {
 				// Handle prepared image
 				usePreparedImage = true
-				preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, &resp.Diagnostics, azureMachineConfigModel.AzurePreparedImage)
+				preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, diagnostics, azureMachineConfigModel.AzurePreparedImage)
 				currentImageVersionDetails := currentImageDetails.GetImageVersion()
 				currentImageDefinitionDetails := currentImageVersionDetails.GetImageDefinition()
 				imageDefinition = preparedImageModel.ImageDefinition.ValueString()
 				imageVersion = preparedImageModel.ImageVersion.ValueString()
 				currentImageVersion = currentImageVersionDetails.GetId()
 				currentImageDefinition = currentImageDefinitionDetails.GetId()
-				imageDefinitionResp, err := image_definition.GetImageDefinition(ctx, client, &resp.Diagnostics, imageDefinition)
+				imageDefinitionResp, err := image_definition.GetImageDefinition(ctx, client, diagnostics, imageDefinition)
 				if err != nil {
 					return err
 				}
-				preparedImageUseSharedGallery = IsAzureImageDefinitionUsingSharedImageGallery(&resp.Diagnostics, imageDefinitionResp)
+				preparedImageUseSharedGallery = IsAzureImageDefinitionUsingSharedImageGallery(diagnostics, imageDefinitionResp)
 			}
 
 			// Set reboot options if configured
 			if !azureMachineConfigModel.ImageUpdateRebootOptions.IsNull() {
-				rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, azureMachineConfigModel.ImageUpdateRebootOptions)
+				rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, azureMachineConfigModel.ImageUpdateRebootOptions)
 				rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 				warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 				rebootOption.SetWarningDuration(warningDuration)
@@ -1303,21 +1250,21 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 				}
 			}
 
-			updateCustomProperties = appendUseAzureComputeGalleryCustomProperties(ctx, &resp.Diagnostics, updateCustomProperties, azureMachineConfigModel, preparedImageUseSharedGallery)
+			updateCustomProperties = appendUseAzureComputeGalleryCustomProperties(ctx, diagnostics, updateCustomProperties, azureMachineConfigModel, preparedImageUseSharedGallery)
 		}
 
 		// For both MCS and PVS
 		if !azureMachineProfile.IsNull() {
-			machineProfilePath, err = util.HandleMachineProfileForAzureMcsPvsCatalog(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), util.ObjectValueToTypedObject[util.AzureMachineProfileModel](ctx, &resp.Diagnostics, azureMachineProfile), "Error updating Machine Catalog")
+			machineProfilePath, err = util.HandleMachineProfileForAzureMcsPvsCatalog(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), util.ObjectValueToTypedObject[util.AzureMachineProfileModel](ctx, diagnostics, azureMachineProfile), "Error updating Machine Catalog")
 			if err != nil {
 				return err
 			}
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS:
-		awsMachineConfig := util.ObjectValueToTypedObject[AwsMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.AwsMachineConfig)
+		awsMachineConfig := util.ObjectValueToTypedObject[AwsMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.AwsMachineConfig)
 		if !awsMachineConfig.AwsEc2PreparedImage.IsNull() {
 			usePreparedImage = true
-			preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, &resp.Diagnostics, awsMachineConfig.AwsEc2PreparedImage)
+			preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, diagnostics, awsMachineConfig.AwsEc2PreparedImage)
 			currentImageVersionDetails := currentImageDetails.GetImageVersion()
 			currentImageDefinitionDetails := currentImageVersionDetails.GetImageDefinition()
 			imageDefinition = preparedImageModel.ImageDefinition.ValueString()
@@ -1326,9 +1273,9 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			currentImageDefinition = currentImageDefinitionDetails.GetId()
 		} else {
 			imageId := fmt.Sprintf("%s (%s)", awsMachineConfig.MasterImage.ValueString(), awsMachineConfig.ImageAmi.ValueString())
-			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", imageId, util.TemplateResourceType, "")
+			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", imageId, util.TemplateResourceType, "")
 			if err != nil {
-				resp.Diagnostics.AddError(
+				diagnostics.AddError(
 					"Error updating Machine Catalog",
 					"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 						fmt.Sprintf("\nFailed to locate AWS image %s with AMI %s, error: %s", awsMachineConfig.MasterImage.ValueString(), awsMachineConfig.ImageAmi.ValueString(), err.Error()),
@@ -1339,8 +1286,8 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		}
 
 		if !awsMachineConfig.MachineProfile.IsNull() {
-			machineProfile := util.ObjectValueToTypedObject[util.AwsMachineProfileModel](ctx, &resp.Diagnostics, awsMachineConfig.MachineProfile)
-			machineProfilePath, err = util.HandleMachineProfileForAwsMcsPvsCatalog(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), machineProfile, "Error updating Machine Catalog")
+			machineProfile := util.ObjectValueToTypedObject[util.AwsMachineProfileModel](ctx, diagnostics, awsMachineConfig.MachineProfile)
+			machineProfilePath, err = util.HandleMachineProfileForAwsMcsPvsCatalog(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), machineProfile, "Error updating Machine Catalog")
 			if err != nil {
 				return err
 			}
@@ -1348,7 +1295,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 		// Set reboot options if configured
 		if !awsMachineConfig.ImageUpdateRebootOptions.IsNull() {
-			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, awsMachineConfig.ImageUpdateRebootOptions)
+			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, awsMachineConfig.ImageUpdateRebootOptions)
 			rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 			warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 			rebootOption.SetWarningDuration(warningDuration)
@@ -1361,10 +1308,10 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			}
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE:
-		amazonWorkspacesCoreMachineConfig := util.ObjectValueToTypedObject[AmazonWorkspacesCoreMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.AmazonWorkspacesCoreMachineConfig)
+		amazonWorkspacesCoreMachineConfig := util.ObjectValueToTypedObject[AmazonWorkspacesCoreMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.AmazonWorkspacesCoreMachineConfig)
 
 		usePreparedImage = true
-		preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, &resp.Diagnostics, amazonWorkspacesCoreMachineConfig.AmazonWorkspacesCorePreparedImage)
+		preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, diagnostics, amazonWorkspacesCoreMachineConfig.AmazonWorkspacesCorePreparedImage)
 		currentImageVersionDetails := currentImageDetails.GetImageVersion()
 		currentImageDefinitionDetails := currentImageVersionDetails.GetImageDefinition()
 		imageDefinition = preparedImageModel.ImageDefinition.ValueString()
@@ -1375,8 +1322,8 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		masterImageNote = amazonWorkspacesCoreMachineConfig.MasterImageNote.ValueString()
 
 		if !amazonWorkspacesCoreMachineConfig.MachineProfile.IsNull() {
-			machineProfile := util.ObjectValueToTypedObject[util.AmazonWorkspacesCoreMachineProfileModel](ctx, &resp.Diagnostics, amazonWorkspacesCoreMachineConfig.MachineProfile)
-			machineProfilePath, err = util.HandleMachineProfileForAmazonWorkspacesCoreMcsCatalog(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), machineProfile, "Error updating Machine Catalog")
+			machineProfile := util.ObjectValueToTypedObject[util.AmazonWorkspacesCoreMachineProfileModel](ctx, diagnostics, amazonWorkspacesCoreMachineConfig.MachineProfile)
+			machineProfilePath, err = util.HandleMachineProfileForAmazonWorkspacesCoreMcsCatalog(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), machineProfile, "Error updating Machine Catalog")
 			if err != nil {
 				return err
 			}
@@ -1384,7 +1331,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 		// Set reboot options if configured
 		if !amazonWorkspacesCoreMachineConfig.ImageUpdateRebootOptions.IsNull() {
-			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, amazonWorkspacesCoreMachineConfig.ImageUpdateRebootOptions)
+			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, amazonWorkspacesCoreMachineConfig.ImageUpdateRebootOptions)
 			rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 			warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 			rebootOption.SetWarningDuration(warningDuration)
@@ -1397,16 +1344,16 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			}
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM:
-		gcpMachineConfig := util.ObjectValueToTypedObject[GcpMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.GcpMachineConfig)
+		gcpMachineConfig := util.ObjectValueToTypedObject[GcpMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.GcpMachineConfig)
 		newImage := gcpMachineConfig.MasterImage.ValueString()
 		snapshot := gcpMachineConfig.MachineSnapshot.ValueString()
 		gcpMachineProfile := gcpMachineConfig.MachineProfile.ValueString()
 
 		if snapshot != "" {
 			queryPath := fmt.Sprintf("%s.vm", newImage)
-			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, snapshot, util.SnapshotResourceType, "")
+			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), queryPath, snapshot, util.SnapshotResourceType, "")
 			if err != nil {
-				resp.Diagnostics.AddError(
+				diagnostics.AddError(
 					"Error updating Machine Catalog",
 					"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 						fmt.Sprintf("\nFailed to locate snapshot %s of master image %s on GCP, error: %s", snapshot, newImage, err.Error()),
@@ -1414,9 +1361,9 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 				return err
 			}
 		} else {
-			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", newImage, util.VirtualMachineResourceType, "")
+			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", newImage, util.VirtualMachineResourceType, "")
 			if err != nil {
-				resp.Diagnostics.AddError(
+				diagnostics.AddError(
 					"Error updating Machine Catalog",
 					"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 						fmt.Sprintf("\nFailed to locate master image machine %s on GCP, error: %s", newImage, err.Error()),
@@ -1429,7 +1376,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 		// Set reboot options if configured
 		if !gcpMachineConfig.ImageUpdateRebootOptions.IsNull() {
-			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, gcpMachineConfig.ImageUpdateRebootOptions)
+			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, gcpMachineConfig.ImageUpdateRebootOptions)
 			rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 			warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 			rebootOption.SetWarningDuration(warningDuration)
@@ -1443,9 +1390,9 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		}
 
 		if gcpMachineProfile != "" {
-			machineProfilePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", gcpMachineConfig.MachineProfile.ValueString(), util.VirtualMachineResourceType, "")
+			machineProfilePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", gcpMachineConfig.MachineProfile.ValueString(), util.VirtualMachineResourceType, "")
 			if err != nil {
-				resp.Diagnostics.AddError(
+				diagnostics.AddError(
 					"Error updating Machine Catalog",
 					"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 						fmt.Sprintf("\nFailed to locate machine profile %s on GCP, error: %s", gcpMachineConfig.MachineProfile.ValueString(), err.Error()),
@@ -1454,10 +1401,10 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			}
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER:
-		vSphereMachineConfig := util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.VsphereMachineConfig)
+		vSphereMachineConfig := util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.VsphereMachineConfig)
 		if !vSphereMachineConfig.VspherePreparedImage.IsNull() {
 			usePreparedImage = true
-			preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, &resp.Diagnostics, vSphereMachineConfig.VspherePreparedImage)
+			preparedImageModel := util.ObjectValueToTypedObject[PreparedImageConfigModel](ctx, diagnostics, vSphereMachineConfig.VspherePreparedImage)
 			currentImageVersionDetails := currentImageDetails.GetImageVersion()
 			currentImageDefinitionDetails := currentImageVersionDetails.GetImageDefinition()
 			imageDefinition = preparedImageModel.ImageDefinition.ValueString()
@@ -1468,7 +1415,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			newImage := vSphereMachineConfig.MasterImageVm.ValueString()
 			snapshot := vSphereMachineConfig.ImageSnapshot.ValueString()
 			resourcePoolPath := vSphereMachineConfig.ResourcePoolPath.ValueString()
-			imagePath, err = getOnPremImagePath(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, resourcePoolPath, "updating")
+			imagePath, err = getOnPremImagePath(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, resourcePoolPath, "updating")
 			if err != nil {
 				return err
 			}
@@ -1477,7 +1424,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		}
 		// Set reboot options if configured
 		if !vSphereMachineConfig.ImageUpdateRebootOptions.IsNull() {
-			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, vSphereMachineConfig.ImageUpdateRebootOptions)
+			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, vSphereMachineConfig.ImageUpdateRebootOptions)
 			rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 			warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 			rebootOption.SetWarningDuration(warningDuration)
@@ -1490,10 +1437,10 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			}
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER:
-		xenserverMachineConfig := util.ObjectValueToTypedObject[XenserverMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.XenserverMachineConfig)
+		xenserverMachineConfig := util.ObjectValueToTypedObject[XenserverMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.XenserverMachineConfig)
 		newImage := xenserverMachineConfig.MasterImageVm.ValueString()
 		snapshot := xenserverMachineConfig.ImageSnapshot.ValueString()
-		imagePath, err = getOnPremImagePath(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, "", "updating")
+		imagePath, err = getOnPremImagePath(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, "", "updating")
 		if err != nil {
 			return err
 		}
@@ -1502,7 +1449,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 		// Set reboot options if configured
 		if !xenserverMachineConfig.ImageUpdateRebootOptions.IsNull() {
-			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, xenserverMachineConfig.ImageUpdateRebootOptions)
+			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, xenserverMachineConfig.ImageUpdateRebootOptions)
 			rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 			warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 			rebootOption.SetWarningDuration(warningDuration)
@@ -1515,10 +1462,10 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			}
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT:
-		openshiftMachineConfig := util.ObjectValueToTypedObject[OpenshiftMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.OpenshiftMachineConfig)
+		openshiftMachineConfig := util.ObjectValueToTypedObject[OpenshiftMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.OpenshiftMachineConfig)
 		openshiftMachineProfile := openshiftMachineConfig.MachineProfile.ValueString()
 		newImage := openshiftMachineConfig.MasterImageVm.ValueString()
-		imagePath, err = getOnPremImagePath(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, "", "", "updating")
+		imagePath, err = getOnPremImagePath(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, "", "", "updating")
 		if err != nil {
 			return err
 		}
@@ -1527,7 +1474,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 		// Set reboot options if configured
 		if !openshiftMachineConfig.ImageUpdateRebootOptions.IsNull() {
-			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, openshiftMachineConfig.ImageUpdateRebootOptions)
+			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, openshiftMachineConfig.ImageUpdateRebootOptions)
 			rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 			warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 			rebootOption.SetWarningDuration(warningDuration)
@@ -1541,9 +1488,9 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		}
 
 		if openshiftMachineProfile != "" {
-			machineProfilePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", openshiftMachineConfig.MachineProfile.ValueString(), util.VirtualMachineResourceType, "")
+			machineProfilePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", openshiftMachineConfig.MachineProfile.ValueString(), util.VirtualMachineResourceType, "")
 			if err != nil {
-				resp.Diagnostics.AddError(
+				diagnostics.AddError(
 					"Error updating Machine Catalog",
 					"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 						fmt.Sprintf("\nFailed to locate machine profile %s on GCP, error: %s", openshiftMachineConfig.MachineProfile.ValueString(), err.Error()),
@@ -1553,10 +1500,10 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 		}
 
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM:
-		scvmmMachineConfig := util.ObjectValueToTypedObject[SCVMMMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.SCVMMMachineConfigModel)
+		scvmmMachineConfig := util.ObjectValueToTypedObject[SCVMMMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.SCVMMMachineConfigModel)
 		newImage := scvmmMachineConfig.MasterImage.ValueString()
 		snapshot := scvmmMachineConfig.ImageSnapshot.ValueString()
-		imagePath, err = getOnPremImagePath(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, "", "updating")
+		imagePath, err = getOnPremImagePath(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), newImage, snapshot, "", "updating")
 		if err != nil {
 			return err
 		}
@@ -1565,7 +1512,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 		// Set reboot options if configured
 		if !scvmmMachineConfig.ImageUpdateRebootOptions.IsNull() {
-			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, scvmmMachineConfig.ImageUpdateRebootOptions)
+			rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, scvmmMachineConfig.ImageUpdateRebootOptions)
 			rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 			warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 			rebootOption.SetWarningDuration(warningDuration)
@@ -1578,12 +1525,12 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 			}
 		}
 	case citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM:
-		nutanixMachineConfig := util.ObjectValueToTypedObject[NutanixMachineConfigModel](ctx, &resp.Diagnostics, provisioningSchemePlan.NutanixMachineConfig)
+		nutanixMachineConfig := util.ObjectValueToTypedObject[NutanixMachineConfigModel](ctx, diagnostics, provisioningSchemePlan.NutanixMachineConfig)
 		if hypervisor.GetPluginId() == util.NUTANIX_PLUGIN_ID {
-			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &resp.Diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", nutanixMachineConfig.MasterImage.ValueString(), util.TemplateResourceType, "")
+			imagePath, httpResp, err = util.GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor.GetName(), hypervisorResourcePool.GetName(), "", nutanixMachineConfig.MasterImage.ValueString(), util.TemplateResourceType, "")
 
 			if err != nil {
-				resp.Diagnostics.AddError(
+				diagnostics.AddError(
 					"Error updating Machine Catalog",
 					"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 						fmt.Sprintf("\nFailed to locate master image %s on NUTANIX, error: %s", nutanixMachineConfig.MasterImage.ValueString(), err.Error()),
@@ -1595,7 +1542,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 			// Set reboot options if configured
 			if !nutanixMachineConfig.ImageUpdateRebootOptions.IsNull() {
-				rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, nutanixMachineConfig.ImageUpdateRebootOptions)
+				rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, nutanixMachineConfig.ImageUpdateRebootOptions)
 				rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
 				warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
 				rebootOption.SetWarningDuration(warningDuration)
@@ -1622,7 +1569,7 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 
 			functionalLevel, err := citrixorchestration.NewFunctionalLevelFromValue(plan.MinimumFunctionalLevel.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError(
+				diagnostics.AddError(
 					"Error updating Machine Catalog "+catalogName,
 					fmt.Sprintf("Unsupported minimum functional level %s.", plan.MinimumFunctionalLevel.ValueString()),
 				)
@@ -1650,32 +1597,30 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 				updateProvisioningSchemeModel.SetCustomProperties(updateCustomProperties)
 			}
 
-			updateMasterImageRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsUpdateMachineCatalogProvisioningScheme(ctx, catalogId)
-			updateMasterImageRequest = updateMasterImageRequest.UpdateMachineCatalogProvisioningSchemeRequestModel(updateProvisioningSchemeModel)
-			_, httpResp, err := citrixdaasclient.AddRequestData(updateMasterImageRequest, client).Async(true).Execute()
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error updating Image for Machine Catalog "+catalogName,
-					"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-						"\nError message: "+util.ReadClientError(err),
-				)
-			}
-
-			err = util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Image for Machine Catalog "+catalogName, &resp.Diagnostics, maxTimeoutInMinutes)
+			err = updateCatalogProvisioningSchemeImage(ctx, client, diagnostics, catalogId, catalogName, updateProvisioningSchemeModel, maxTimeoutInMinutes)
 			if errors.Is(err, &util.JobPollError{}) {
 				return err
 			} // if the job failed continue processing
+
+			if err == nil && !provisioningSchemePlan.Rollout.IsNull() {
+				// The image is assigned without reboot, the rollout reboots the machines in batches
+				rollout := util.ObjectValueToTypedObject[ImageRolloutModel](ctx, diagnostics, provisioningSchemePlan.Rollout)
+				err = rolloutCatalogImage(ctx, client, diagnostics, catalog, rollout, maxTimeoutInMinutes)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
 	if machineProfile.GetXDPath() != machineProfilePath {
-		err = updateCatalogMachineProfile(ctx, client, resp, plan, catalog, machineProfilePath, hypervisorResourcePool, hypervisor.GetPluginId())
+		err = updateCatalogMachineProfile(ctx, client, diagnostics, plan, catalog, machineProfilePath, hypervisorResourcePool, hypervisor.GetPluginId())
 		if err != nil {
 			return err
 		}
 
 		if connectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT {
-			err = updateMemoryAndCpuCount(ctx, client, resp, catalog, plan, connectionType)
+			err = updateMemoryAndCpuCount(ctx, client, diagnostics, catalog, plan, connectionType)
 			if err != nil {
 				return err
 			}
@@ -1685,6 +1630,23 @@ func updateCatalogImageAndMachineProfile(ctx context.Context, client *citrixdaas
 	return nil
 }
 
+// updateCatalogProvisioningSchemeImage submits the image update of the provisioning scheme and waits for the job to complete.
+func updateCatalogProvisioningSchemeImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalogId string, catalogName string, updateProvisioningSchemeModel citrixorchestration.UpdateMachineCatalogProvisioningSchemeRequestModel, maxTimeoutInMinutes int32) error {
+	updateMasterImageRequest := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsUpdateMachineCatalogProvisioningScheme(ctx, catalogId)
+	updateMasterImageRequest = updateMasterImageRequest.UpdateMachineCatalogProvisioningSchemeRequestModel(updateProvisioningSchemeModel)
+	_, httpResp, err := citrixdaasclient.AddRequestData(updateMasterImageRequest, client).Async(true).Execute()
+	if err != nil {
+		diagnostics.AddError(
+			"Error updating Image for Machine Catalog "+catalogName,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Image for Machine Catalog "+catalogName, diagnostics, maxTimeoutInMinutes)
+}
+
 func setComputeGalleryValues(customProperties []citrixorchestration.NameValueStringPairModel) (string, string, string) {
 	replicaRatio := ""
 	replicaMaximum := ""
@@ -2056,7 +2018,8 @@ func parseCustomPropertiesToClientModel(ctx context.Context, diagnostics *diag.D
 	return *res, nil
 }
 
-func getOnPremImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diags *diag.Diagnostics, hypervisorName, resourcePoolName, image, snapshot, resourcePoolPath, action string) (*citrixorchestration.HypervisorResourceResponseModel, error) {
+// getOnPremImageFolderPath returns the hypervisor resource path of the folder containing an on-premises master image VM.
+func getOnPremImageFolderPath(resourcePoolPath string) string {
 	queryPath := ""
 	if resourcePoolPath != "" {
 		resourcePoolSegments := strings.Split(resourcePoolPath, "/")
@@ -2064,6 +2027,11 @@ func getOnPremImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClie
 			queryPath = queryPath + resourcePool + ".resourcepool" + "\\"
 		}
 	}
+	return queryPath
+}
+
+func getOnPremImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diags *diag.Diagnostics, hypervisorName, resourcePoolName, image, snapshot, resourcePoolPath, action string) (*citrixorchestration.HypervisorResourceResponseModel, error) {
+	queryPath := getOnPremImageFolderPath(resourcePoolPath)
 	resourceType := util.VirtualMachineResourceType
 	resourceName := image
 	errTemplate := fmt.Sprintf("Failed to locate master image machine %s", image)
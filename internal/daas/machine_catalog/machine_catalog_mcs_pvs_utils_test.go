@@ -0,0 +1,110 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeleteMachinesFromMcsPvsCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		scaleDown           *machine_catalog.ScaleDownModel
+		machineCount        int64
+		expectedDeleted     []string
+		expectedMaintenance []string
+		expectError         bool
+	}{
+		"machines without delivery group and in maintenance mode first": {
+			machineCount:    2,
+			expectedDeleted: []string{`DOMAIN\vm-3`, `DOMAIN\vm-4`},
+		},
+		"oldest first skips machines with sessions": {
+			scaleDown:           &machine_catalog.ScaleDownModel{Strategy: types.StringValue(machine_catalog.ScaleDownStrategyOldestFirst), Drain: types.BoolValue(false)},
+			machineCount:        3,
+			expectedDeleted:     []string{`DOMAIN\vm-2`},
+			expectedMaintenance: []string{`DOMAIN\vm-2`},
+		},
+		"drain leaves machines with sessions in maintenance mode when the timeout expires": {
+			scaleDown:           &machine_catalog.ScaleDownModel{Strategy: types.StringValue(machine_catalog.ScaleDownStrategyOldestFirst), Drain: types.BoolValue(true), DrainTimeoutMinutes: types.Int64Value(0)},
+			machineCount:        3,
+			expectedMaintenance: []string{`DOMAIN\vm-1`},
+			expectError:         true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, client := fakeorchestration.NewClient(t)
+
+			catalogId := server.AddObject("MachineCatalogs", map[string]any{"Name": "catalog", "ProvisioningType": "MCS", "TotalCount": 4})
+			deliveryGroupId := server.AddObject("DeliveryGroups", map[string]any{"Name": "group"})
+			machineIds := map[string]string{
+				`DOMAIN\vm-1`: server.AddObject("Machines", map[string]any{"Name": `DOMAIN\vm-1`, "Uid": 1, "MachineCatalog": catalogId, "DeliveryGroup": deliveryGroupId, "SessionCount": 2}),
+				`DOMAIN\vm-2`: server.AddObject("Machines", map[string]any{"Name": `DOMAIN\vm-2`, "Uid": 2, "MachineCatalog": catalogId, "DeliveryGroup": deliveryGroupId, "SessionCount": 0}),
+				`DOMAIN\vm-3`: server.AddObject("Machines", map[string]any{"Name": `DOMAIN\vm-3`, "Uid": 3, "MachineCatalog": catalogId, "DeliveryGroup": deliveryGroupId, "SessionCount": 1, "InMaintenanceMode": true}),
+				`DOMAIN\vm-4`: server.AddObject("Machines", map[string]any{"Name": `DOMAIN\vm-4`, "Uid": 4, "MachineCatalog": catalogId, "SessionCount": 0}),
+			}
+
+			diagnostics := diag.Diagnostics{}
+			provSchemePlan := machine_catalog.ProvisioningSchemeModel{NumTotalMachines: types.Int64Value(test.machineCount)}
+			if test.scaleDown == nil {
+				attributes, _ := util.ResourceAttributeMapFromObject(machine_catalog.ScaleDownModel{})
+				provSchemePlan.ScaleDown = types.ObjectNull(attributes)
+			} else {
+				test.scaleDown.MachinesToRemove = types.ListNull(types.StringType)
+				provSchemePlan.ScaleDown = util.TypedObjectToObjectValue(ctx, &diagnostics, *test.scaleDown)
+			}
+			if diagnostics.HasError() {
+				t.Fatalf("error building the provisioning scheme: %v", diagnostics)
+			}
+
+			catalog := citrixorchestration.MachineCatalogDetailResponseModel{}
+			catalog.SetId(catalogId)
+			catalog.SetName("catalog")
+			catalog.SetTotalCount(4)
+			resp := &resource.UpdateResponse{}
+			err := machine_catalog.DeleteMachinesFromMcsPvsCatalog(ctx, client, resp, &catalog, provSchemePlan, nil)
+			if test.expectError != (err != nil) || test.expectError != resp.Diagnostics.HasError() {
+				t.Fatalf("expected error %t, got %v with diagnostics %v", test.expectError, err, resp.Diagnostics)
+			}
+
+			deleted := []string{}
+			for name, machineId := range machineIds {
+				if !slices.ContainsFunc(server.Objects("Machines"), func(machine map[string]any) bool { return machine["Id"] == machineId }) {
+					deleted = append(deleted, name)
+				}
+			}
+			// Machines are put in maintenance mode before they are drained or deleted, unless they have no delivery group
+			// or already are in maintenance mode
+			inMaintenance := []string{}
+			for _, request := range server.Requests() {
+				for name, machineId := range machineIds {
+					if strings.HasPrefix(request, "PATCH ") && strings.HasSuffix(request, "/Machines/"+machineId) {
+						inMaintenance = append(inMaintenance, name)
+					}
+				}
+			}
+			slices.Sort(deleted)
+			slices.Sort(inMaintenance)
+			if !slices.Equal(deleted, test.expectedDeleted) {
+				t.Errorf("expected machines %v to be deleted, got %v", test.expectedDeleted, deleted)
+			}
+			if !slices.Equal(inMaintenance, test.expectedMaintenance) {
+				t.Errorf("expected machines %v to be put in maintenance mode, got %v", test.expectedMaintenance, inMaintenance)
+			}
+		})
+	}
+}
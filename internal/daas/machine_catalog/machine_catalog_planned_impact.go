@@ -0,0 +1,149 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"slices"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const plannedImpactMachineFields = scaleDownMachineFields + ",PowerState"
+
+// getMachineCatalogPlannedImpact computes the machines and sessions affected by updating the machine catalog from state to plan.
+// Machines are deleted when number_of_total_machines is reduced, and rebooted when the image update reboots them right away.
+func getMachineCatalogPlannedImpact(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, plan MachineCatalogResourceModel, state MachineCatalogResourceModel) (util.PlannedImpact, error) {
+	impact := util.PlannedImpact{}
+	if plan.ProvisioningScheme.IsUnknown() || plan.ProvisioningScheme.IsNull() || state.ProvisioningScheme.IsNull() {
+		return impact, nil
+	}
+
+	provSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, diagnostics, plan.ProvisioningScheme)
+	provSchemeState := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, diagnostics, state.ProvisioningScheme)
+	if provSchemePlan.NumTotalMachines.IsUnknown() {
+		return impact, nil
+	}
+
+	scaleDown := provSchemePlan.NumTotalMachines.ValueInt64() < provSchemeState.NumTotalMachines.ValueInt64()
+	imageUpdateReboot := isImageUpdateRebootPlanned(ctx, diagnostics, provSchemePlan, provSchemeState)
+	if !scaleDown && !imageUpdateReboot {
+		return impact, nil
+	}
+
+	machines, err := util.GetMachineCatalogMachinesWithFields(ctx, client, diagnostics, state.Id.ValueString(), plannedImpactMachineFields)
+	if err != nil {
+		return impact, err
+	}
+
+	machinesToDelete := []citrixorchestration.MachineResponseModel{}
+	machineDeleteRequestCount := len(machines) - int(provSchemePlan.NumTotalMachines.ValueInt64())
+	if scaleDown && machineDeleteRequestCount > 0 {
+		if !provSchemePlan.ScaleDown.IsNull() {
+			scaleDownModel := util.ObjectValueToTypedObject[ScaleDownModel](ctx, diagnostics, provSchemePlan.ScaleDown)
+			if scaleDownModel.MachinesToRemove.IsUnknown() {
+				return impact, nil
+			}
+			// The selection errors are reported as a warning, the machines can still change before apply
+			selectDiagnostics := diag.Diagnostics{}
+			machinesToDelete, err = selectMachinesToScaleDown(ctx, &selectDiagnostics, machines, scaleDownModel, machineDeleteRequestCount)
+		} else {
+			machinesToDelete, err = selectMachinesToDeleteByPriority(machines, machineDeleteRequestCount)
+		}
+		if err != nil {
+			diagnostics.AddAttributeWarning(
+				path.Root("provisioning_scheme").AtName("number_of_total_machines"),
+				"Machine Catalog scale down cannot be applied with the current machines",
+				err.Error(),
+			)
+		}
+		for _, machine := range machinesToDelete {
+			impact.DeleteMachine(machine)
+		}
+	}
+
+	if imageUpdateReboot {
+		for _, machine := range machines {
+			if machine.GetPowerState() != citrixorchestration.POWERSTATE_ON || slices.ContainsFunc(machinesToDelete, func(machineToDelete citrixorchestration.MachineResponseModel) bool {
+				return machineToDelete.GetId() == machine.GetId()
+			}) {
+				continue
+			}
+			impact.RebootMachine(machine)
+		}
+	}
+
+	return impact, nil
+}
+
+// isImageUpdateRebootPlanned checks whether the image of the catalog changes and the machines are rebooted as part of the
+// update, either by the image rollout or by image update reboot options that do not wait for the next shutdown.
+func isImageUpdateRebootPlanned(ctx context.Context, diagnostics *diag.Diagnostics, provSchemePlan ProvisioningSchemeModel, provSchemeState ProvisioningSchemeModel) bool {
+	imagePlan, rebootOptionsPlan := getImageUpdateSettings(ctx, diagnostics, provSchemePlan)
+	imageState, _ := getImageUpdateSettings(ctx, diagnostics, provSchemeState)
+	if len(imagePlan) != len(imageState) {
+		// The hypervisor changes, the catalog is replaced instead
+		return false
+	}
+
+	imageChanged := false
+	for index := range imagePlan {
+		if imagePlan[index].IsUnknown() || !imagePlan[index].Equal(imageState[index]) {
+			imageChanged = true
+			break
+		}
+	}
+	if !imageChanged {
+		return false
+	}
+
+	if !provSchemePlan.Rollout.IsNull() {
+		return true
+	}
+	if rebootOptionsPlan.IsNull() || rebootOptionsPlan.IsUnknown() {
+		return false
+	}
+	rebootOptions := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, rebootOptionsPlan)
+	return rebootOptions.RebootDuration.ValueInt64() >= 0
+}
+
+// getImageUpdateSettings returns the attributes of the machine config that select the image of the catalog, along with
+// the image update reboot options.
+func getImageUpdateSettings(ctx context.Context, diagnostics *diag.Diagnostics, provSchemeModel ProvisioningSchemeModel) ([]attr.Value, types.Object) {
+	switch {
+	case !provSchemeModel.AzureMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, diagnostics, provSchemeModel.AzureMachineConfig)
+		return []attr.Value{machineConfig.AzureMasterImage, machineConfig.AzurePreparedImage, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.AwsMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[AwsMachineConfigModel](ctx, diagnostics, provSchemeModel.AwsMachineConfig)
+		return []attr.Value{machineConfig.MasterImage, machineConfig.ImageAmi, machineConfig.AwsEc2PreparedImage, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.AmazonWorkspacesCoreMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[AmazonWorkspacesCoreMachineConfigModel](ctx, diagnostics, provSchemeModel.AmazonWorkspacesCoreMachineConfig)
+		return []attr.Value{machineConfig.AmazonWorkspacesCorePreparedImage, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.GcpMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[GcpMachineConfigModel](ctx, diagnostics, provSchemeModel.GcpMachineConfig)
+		return []attr.Value{machineConfig.MasterImage, machineConfig.MachineSnapshot, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.VsphereMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, diagnostics, provSchemeModel.VsphereMachineConfig)
+		return []attr.Value{machineConfig.MasterImageVm, machineConfig.VspherePreparedImage, machineConfig.ImageSnapshot, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.XenserverMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[XenserverMachineConfigModel](ctx, diagnostics, provSchemeModel.XenserverMachineConfig)
+		return []attr.Value{machineConfig.MasterImageVm, machineConfig.ImageSnapshot, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.NutanixMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[NutanixMachineConfigModel](ctx, diagnostics, provSchemeModel.NutanixMachineConfig)
+		return []attr.Value{machineConfig.MasterImage, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.SCVMMMachineConfigModel.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[SCVMMMachineConfigModel](ctx, diagnostics, provSchemeModel.SCVMMMachineConfigModel)
+		return []attr.Value{machineConfig.MasterImage, machineConfig.ImageSnapshot, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	case !provSchemeModel.OpenshiftMachineConfig.IsNull():
+		machineConfig := util.ObjectValueToTypedObject[OpenshiftMachineConfigModel](ctx, diagnostics, provSchemeModel.OpenshiftMachineConfig)
+		return []attr.Value{machineConfig.MasterImageVm, machineConfig.MasterImageNote}, machineConfig.ImageUpdateRebootOptions
+	}
+	return []attr.Value{}, types.ObjectNull(map[string]attr.Type{})
+}
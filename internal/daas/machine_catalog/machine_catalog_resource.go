@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                   = &machineCatalogResource{}
 	_ resource.ResourceWithConfigure      = &machineCatalogResource{}
 	_ resource.ResourceWithImportState    = &machineCatalogResource{}
+	_ resource.ResourceWithIdentity       = &machineCatalogResource{}
 	_ resource.ResourceWithValidateConfig = &machineCatalogResource{}
 	_ resource.ResourceWithModifyPlan     = &machineCatalogResource{}
 )
@@ -51,6 +52,11 @@ func (r *machineCatalogResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = MachineCatalogResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *machineCatalogResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *machineCatalogResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -186,11 +192,15 @@ func (r *machineCatalogResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Map response body to schema and populate Computed attribute values
+	if plan.PlannedImpact.IsUnknown() {
+		plan.PlannedImpact = util.GetPlannedImpactNull()
+	}
 	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, r.client, catalog, &connectionType, machines, pluginId, tags, machineAdAccounts)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,6 +209,7 @@ func (r *machineCatalogResource) Create(ctx context.Context, req resource.Create
 // Read refreshes the Terraform state with the latest data.
 func (r *machineCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state MachineCatalogResourceModel
@@ -265,6 +276,7 @@ func (r *machineCatalogResource) Read(ctx context.Context, req resource.ReadRequ
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *machineCatalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan MachineCatalogResourceModel
@@ -380,7 +392,7 @@ func (r *machineCatalogResource) Update(ctx context.Context, req resource.Update
 		}
 
 		machineAccountsInPlan := util.ObjectListToTypedArray[MachineADAccountModel](ctx, &resp.Diagnostics, planProvSchemeModel.MachineADAccounts)
-		err = updateCatalogImageAndMachineProfile(ctx, r.client, resp, catalog, plan, provisioningType, updateTimeout)
+		err = updateCatalogImageAndMachineProfile(ctx, r.client, &resp.Diagnostics, catalog, plan, provisioningType, updateTimeout)
 
 		if err != nil {
 			return
@@ -724,6 +736,9 @@ func (r *machineCatalogResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update resource state with updated items and timestamp
+	if plan.PlannedImpact.IsUnknown() {
+		plan.PlannedImpact = util.GetPlannedImpactNull()
+	}
 	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, r.client, catalog, &connectionType, machines, pluginId, tags, machineAdAccounts)
 
 	diags = resp.State.Set(ctx, plan)
@@ -842,7 +857,7 @@ func (r *machineCatalogResource) Delete(ctx context.Context, req resource.Delete
 
 func (r *machineCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *machineCatalogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -1150,6 +1165,9 @@ func (r *machineCatalogResource) ValidateConfig(ctx context.Context, req resourc
 				}
 			}
 
+			validateImageRollout(ctx, &resp.Diagnostics, provSchemeModel)
+			validateScaleDown(ctx, &resp.Diagnostics, provSchemeModel)
+
 			if !provSchemeModel.MachineDomainIdentity.IsNull() && provSchemeModel.IdentityType.ValueString() == string(citrixorchestration.IDENTITYTYPE_ACTIVE_DIRECTORY) {
 				machineDomainIdentityModel := util.ObjectValueToTypedObject[util.MachineDomainIdentityModel](ctx, &resp.Diagnostics, provSchemeModel.MachineDomainIdentity)
 				if !machineDomainIdentityModel.Domain.IsUnknown() && machineDomainIdentityModel.Domain.IsNull() {
@@ -1270,6 +1288,16 @@ func (r *machineCatalogResource) ValidateConfig(ctx context.Context, req resourc
 				)
 			}
 
+			if !provSchemeModel.Rollout.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("provisioning_scheme").AtName("rollout"),
+					"Incorrect Attribute Configuration",
+					fmt.Sprintf("rollout cannot be configured when value of provisioning_type is %s.", provisioningTypePvsStreaming),
+				)
+			}
+
+			validateScaleDown(ctx, &resp.Diagnostics, provSchemeModel)
+
 			if azureMachineConfigModel.MasterImageNote.ValueString() != "" {
 				resp.Diagnostics.AddAttributeError(
 					path.Root("master_image_note"),
@@ -1560,6 +1588,20 @@ func (r *machineCatalogResource) ModifyPlan(ctx context.Context, req resource.Mo
 			util.CheckFunctionalLevelValues(r.client, &resp.Diagnostics, plan.MinimumFunctionalLevel.String(), "Unsupported Machine Catalog Configuration", "Identity type Workgroup")
 		}
 
+		var provSchemeState *ProvisioningSchemeModel
+		if !req.State.Raw.IsNull() {
+			var state MachineCatalogResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			if !state.ProvisioningScheme.IsNull() {
+				provSchemeStateModel := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, state.ProvisioningScheme)
+				provSchemeState = &provSchemeStateModel
+			}
+		}
+		validateProvisioningSchemeHypervisorResources(ctx, r.client, &resp.Diagnostics, provSchemePlan, provSchemeState)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		if !provSchemePlan.MachineADAccounts.IsUnknown() && !provSchemePlan.MachineADAccounts.IsNull() {
 			machineAccountsInPlan := util.ObjectListToTypedArray[MachineADAccountModel](ctx, &resp.Diagnostics, provSchemePlan.MachineADAccounts)
 
@@ -1631,6 +1673,7 @@ func (r *machineCatalogResource) ModifyPlan(ctx context.Context, req resource.Mo
 	catalogNameExists := checkIfCatalogNameExists(ctx, r.client, plan.Name.ValueString())
 
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("planned_impact"), util.GetPlannedImpactNull())...)
 		return
 	}
 
@@ -1651,6 +1694,21 @@ func (r *machineCatalogResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 
+	if plan.ProvisioningScheme.Equal(state.ProvisioningScheme) {
+		// The image history only changes with the provisioning scheme, keep it known in the plan otherwise
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("image_history"), state.ImageHistory)...)
+	}
+
+	if plan.PlannedImpact.IsUnknown() {
+		// The planned impact is computed once at plan time, Update carries the planned value into the state
+		plannedImpact, err := getMachineCatalogPlannedImpact(ctx, r.client, &resp.Diagnostics, plan, state)
+		if err != nil {
+			return
+		}
+		plannedImpact.AddWarning(&resp.Diagnostics, "Machine Catalog "+state.Name.ValueString())
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("planned_impact"), plannedImpact.ToModel(ctx, &resp.Diagnostics))...)
+	}
+
 	if !plan.ProvisioningScheme.IsNull() {
 		provSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, plan.ProvisioningScheme)
 		provSchemeState := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, &resp.Diagnostics, state.ProvisioningScheme)
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -54,6 +55,8 @@ type MachineCatalogResourceModel struct {
 	VdaUpgradeType                    types.String `tfsdk:"vda_upgrade_type"`
 	ProvisioningType                  types.String `tfsdk:"provisioning_type"`
 	ProvisioningScheme                types.Object `tfsdk:"provisioning_scheme"` // ProvisioningSchemeModel
+	ImageHistory                      types.List   `tfsdk:"image_history"`       // List[ImageHistoryModel]
+	PlannedImpact                     types.Object `tfsdk:"planned_impact"`      // util.PlannedImpactModel
 	MachineAccounts                   types.List   `tfsdk:"machine_accounts"`    // List[MachineAccountsModel]
 	RemotePcOus                       types.List   `tfsdk:"remote_pc_ous"`       // List[RemotePcOuModel]
 	MinimumFunctionalLevel            types.String `tfsdk:"minimum_functional_level"`
@@ -192,6 +195,8 @@ type ProvisioningSchemeModel struct {
 	CustomProperties                  types.List   `tfsdk:"custom_properties"`              // List[CustomPropertyModel]
 	Metadata                          types.List   `tfsdk:"metadata"`                       // List[NameValueStringPairModel]
 	ApplyUpdatesToExistingMachines    types.Bool   `tfsdk:"apply_updates_to_existing_machines"`
+	Rollout                           types.Object `tfsdk:"rollout"`    // ImageRolloutModel
+	ScaleDown                         types.Object `tfsdk:"scale_down"` // ScaleDownModel
 }
 
 func (ProvisioningSchemeModel) GetSchema() schema.SingleNestedAttribute {
@@ -228,7 +233,8 @@ func (ProvisioningSchemeModel) GetSchema() schema.SingleNestedAttribute {
 			"number_of_total_machines": schema.Int64Attribute{
 				Description: "Number of VDA machines allocated in the catalog." +
 					"\n\n~> **Please Note** When deleting machines, ensure machines that need to be deleted have no active sessions. For machines with `Static` allocation type, also ensure there are no assigned users." +
-					"<br /><br />If machines that qualify for deletion are more than the requested number of machines to delete, machines are chosen in the following sequence of priority.<br />1. Machines with no associated Delivery Groups.<br />2. Machines in Maintenance Mode.<br />3. Machines with no active sessions.",
+					"<br /><br />If machines that qualify for deletion are more than the requested number of machines to delete, machines are chosen in the following sequence of priority.<br />1. Machines with no associated Delivery Groups.<br />2. Machines in Maintenance Mode.<br />3. Machines with no active sessions." +
+					"<br /><br />Configure `scale_down` to choose the machines to delete with another strategy.",
 				Required: true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(0),
@@ -312,6 +318,8 @@ func (ProvisioningSchemeModel) GetSchema() schema.SingleNestedAttribute {
 					"\n\n~> **Please Note** As long as this property is set to true, any update to the machine catalog (even outside of the provisioning scheme) will trigger an immediate reboot of all existing machines that are powered on and can disrupt any active sessions. It is safest to turn this property to `true`, run `apply` to update the existing machines, then turn it to `false`. Since the property is read from the resource plan, subsequent `apply` operations will not trigger an update.",
 				Optional: true,
 			},
+			"rollout":    ImageRolloutModel{}.GetSchema(),
+			"scale_down": ScaleDownModel{}.GetSchema(),
 		},
 	}
 }
@@ -320,6 +328,145 @@ func (ProvisioningSchemeModel) GetAttributes() map[string]schema.Attribute {
 	return ProvisioningSchemeModel{}.GetSchema().Attributes
 }
 
+// ImageRolloutModel maps the staged image rollout settings of the provisioning scheme.
+type ImageRolloutModel struct {
+	CanaryMachineCount        types.Int64 `tfsdk:"canary_machine_count"`
+	CanaryMachinePercentage   types.Int64 `tfsdk:"canary_machine_percentage"`
+	BatchSize                 types.Int64 `tfsdk:"batch_size"`
+	BatchPauseMinutes         types.Int64 `tfsdk:"batch_pause_minutes"`
+	HealthCheckTimeoutMinutes types.Int64 `tfsdk:"health_check_timeout_minutes"`
+}
+
+func (ImageRolloutModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Staged rollout of the master image updates. The new image is first applied to the canary machines, then to the remaining machines in batches. " +
+			"After each batch, the updated machines must register again within `health_check_timeout_minutes`. Otherwise the catalog is rolled back to the previous image and the update fails." +
+			"\n\n-> **Note** Only the machines that are powered on are rebooted by the rollout. The machines that are powered off get the image assigned to the catalog when they are next started." +
+			"\n\n~> **Please Note** `image_update_reboot_options` cannot be configured with `rollout`, the rollout reboots the machines itself.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"canary_machine_count": schema.Int64Attribute{
+				Description: "Number of machines to update first.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("canary_machine_percentage")),
+				},
+			},
+			"canary_machine_percentage": schema.Int64Attribute{
+				Description: "Percentage of the machines to update first. The number of canary machines is rounded up.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "Number of machines to update in each batch after the canary machines. When omitted, the remaining machines are updated in a single batch.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"batch_pause_minutes": schema.Int64Attribute{
+				Description: "Time in minutes to wait between two batches. Defaults to `0`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"health_check_timeout_minutes": schema.Int64Attribute{
+				Description: "Time in minutes for the machines of a batch to register again after their reboot. Defaults to `30`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (ImageRolloutModel) GetAttributes() map[string]schema.Attribute {
+	return ImageRolloutModel{}.GetSchema().Attributes
+}
+
+const (
+	ScaleDownStrategyOldestFirst      = "oldest_first"
+	ScaleDownStrategyNewestFirst      = "newest_first"
+	ScaleDownStrategyHighestNameIndex = "highest_name_index"
+	ScaleDownStrategyExplicit         = "explicit"
+)
+
+// ScaleDownModel maps the settings used to choose and remove machines when number_of_total_machines is reduced.
+type ScaleDownModel struct {
+	Strategy            types.String `tfsdk:"strategy"`
+	MachinesToRemove    types.List   `tfsdk:"machines_to_remove"` // List[String]
+	Drain               types.Bool   `tfsdk:"drain"`
+	DrainTimeoutMinutes types.Int64  `tfsdk:"drain_timeout_minutes"`
+}
+
+func (ScaleDownModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Selection of the machines to delete when `number_of_total_machines` is reduced. When omitted, machines are chosen by the priority described in `number_of_total_machines`." +
+			"\n\n-> **Note** Machines with `Static` allocation type and assigned users are never deleted.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"strategy": schema.StringAttribute{
+				Description: "Strategy used to choose the machines to delete. Available values are `oldest_first`, `newest_first`, `highest_name_index` and `explicit`." +
+					"<br />`oldest_first` and `newest_first` order the machines by the time they were added to the site, using the machine Uid that the site assigns in increasing order." +
+					"<br />`highest_name_index` deletes the machines with the highest index in their name first." +
+					"<br />`explicit` deletes the machines of `machines_to_remove`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						ScaleDownStrategyOldestFirst,
+						ScaleDownStrategyNewestFirst,
+						ScaleDownStrategyHighestNameIndex,
+						ScaleDownStrategyExplicit,
+					),
+					validators.AlsoRequiresOnStringValues(
+						[]string{
+							ScaleDownStrategyExplicit,
+						},
+						path.MatchRelative().AtParent().AtName("machines_to_remove"),
+					),
+				},
+			},
+			"machines_to_remove": schema.ListAttribute{
+				Description: "Names of the machines to delete, in order of priority, when `strategy` is `explicit`. Use the format `<domain>\\<machine>` or the machine name alone. Machines of the list that are no longer in the catalog are ignored, so the list can be kept after the machines are deleted. A machine listed more than once is deleted once.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"drain": schema.BoolAttribute{
+				Description: "Drain the machines before deleting them. The machines are put in maintenance mode so that they do not accept new sessions, then their sessions are waited for to end. When `false`, machines with active sessions are not deleted. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"drain_timeout_minutes": schema.Int64Attribute{
+				Description: "Time in minutes to wait for the sessions of the drained machines to end. The update fails if sessions are still active after this time, and the drained machines stay in maintenance mode. Defaults to `60`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (ScaleDownModel) GetAttributes() map[string]schema.Attribute {
+	return ScaleDownModel{}.GetSchema().Attributes
+}
+
 type CustomPropertyModel struct {
 	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
@@ -646,6 +793,13 @@ func (MachineCatalogResourceModel) GetSchema() schema.Schema {
 				Computed:    true,
 			},
 			"provisioning_scheme": ProvisioningSchemeModel{}.GetSchema(),
+			"image_history": schema.ListNestedAttribute{
+				Description: "Images assigned to the MCS machine catalog, most recent first. " +
+					"Use the `citrix_catalog_rollback_to_previous_image` action to roll the machine catalog back to the previous image.",
+				Computed:     true,
+				NestedObject: ImageHistoryModel{}.GetSchema(),
+			},
+			"planned_impact": util.PlannedImpactModel{}.GetSchema(),
 			"machine_catalog_folder_path": schema.StringAttribute{
 				Description: "The path to the folder in which the machine catalog is located.",
 				Optional:    true,
@@ -812,6 +966,12 @@ func (r MachineCatalogResourceModel) RefreshPropertyValues(ctx context.Context,
 
 	r.Tags = util.RefreshTagSet(ctx, diagnostics, tags)
 
+	if catalog.ProvisioningScheme != nil {
+		r.ImageHistory = util.TypedArrayToObjectList[ImageHistoryModel](ctx, diagnostics, getProvisioningSchemeImageHistory(catalog.ProvisioningScheme))
+	} else {
+		r.ImageHistory = util.TypedArrayToObjectList[ImageHistoryModel](ctx, diagnostics, nil)
+	}
+
 	if catalog.ProvisioningScheme == nil {
 		if attributesMap, err := util.ResourceAttributeMapFromObject(ProvisioningSchemeModel{}); err == nil {
 			r.ProvisioningScheme = types.ObjectNull(attributesMap)
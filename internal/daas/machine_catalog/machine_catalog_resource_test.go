@@ -0,0 +1,139 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMachineCatalogModifyPlanPlannedImpact(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeorchestration.NewClient(t)
+
+	catalogId := server.AddObject("MachineCatalogs", map[string]any{"Name": "catalog", "ProvisioningType": "MCS"})
+	deliveryGroupId := server.AddObject("DeliveryGroups", map[string]any{"Name": "group"})
+	server.AddObject("Machines", map[string]any{"Name": `DOMAIN\vm-1`, "MachineCatalog": catalogId, "PowerState": "On", "SessionCount": 0})
+	server.AddObject("Machines", map[string]any{"Name": `DOMAIN\vm-2`, "MachineCatalog": catalogId, "DeliveryGroup": deliveryGroupId, "PowerState": "On", "SessionCount": 1})
+	server.AddObject("Machines", map[string]any{"Name": `DOMAIN\vm-3`, "MachineCatalog": catalogId, "DeliveryGroup": deliveryGroupId, "PowerState": "Off", "SessionCount": 2})
+
+	r := machine_catalog.NewMachineCatalogResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	// newValue builds an Azure machine catalog with the machine count and master image, rebooting the machines on image
+	// update when the reboot duration is set. The planned impact is left unknown for planned changes.
+	newValue := func(t *testing.T, planned bool, machineCount int64, masterImage string, rebootDuration *int64) tftypes.Value {
+		t.Helper()
+		diagnostics := diag.Diagnostics{}
+		provSchemePath := path.Root("provisioning_scheme")
+		azureMachineConfigPath := provSchemePath.AtName("azure_machine_config")
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), catalogId)...)
+		diagnostics.Append(state.SetAttribute(ctx, path.Root("name"), "catalog")...)
+		diagnostics.Append(state.SetAttribute(ctx, provSchemePath.AtName("number_of_total_machines"), machineCount)...)
+		diagnostics.Append(state.SetAttribute(ctx, provSchemePath.AtName("machine_account_creation_rules").AtName("naming_scheme"), "vm-#")...)
+		diagnostics.Append(state.SetAttribute(ctx, azureMachineConfigPath.AtName("azure_master_image").AtName("master_image"), masterImage)...)
+		if rebootDuration != nil {
+			diagnostics.Append(state.SetAttribute(ctx, azureMachineConfigPath.AtName("image_update_reboot_options").AtName("reboot_duration"), *rebootDuration)...)
+		}
+		if planned {
+			// Terraform marks the computed planned impact as unknown when the machine catalog changes
+			plannedImpactType := schemaResp.Schema.Attributes["planned_impact"].GetType().(types.ObjectType)
+			diagnostics.Append(state.SetAttribute(ctx, path.Root("planned_impact"), types.ObjectUnknown(plannedImpactType.AttrTypes))...)
+		}
+		if diagnostics.HasError() {
+			t.Fatalf("error building the machine catalog: %v", diagnostics)
+		}
+		return state.Raw
+	}
+	rebootNow := int64(0)
+	current := newValue(t, false, 3, "image-1", nil)
+
+	tests := map[string]struct {
+		plan                         tftypes.Value
+		expectedWarnings             []string
+		expectedAffectedSessionCount types.Int64
+	}{
+		"unchanged": {
+			plan:                         current,
+			expectedAffectedSessionCount: types.Int64Null(),
+		},
+		"image update without reboot": {
+			plan:                         newValue(t, true, 3, "image-2", nil),
+			expectedAffectedSessionCount: types.Int64Value(0),
+		},
+		"scale down": {
+			plan: newValue(t, true, 2, "image-1", nil),
+			expectedWarnings: []string{
+				`1 machine(s) will be deleted: DOMAIN\vm-1.`,
+				"0 session(s) will be affected.",
+			},
+			expectedAffectedSessionCount: types.Int64Value(0),
+		},
+		"image update with reboot": {
+			plan: newValue(t, true, 3, "image-2", &rebootNow),
+			expectedWarnings: []string{
+				`2 machine(s) will be rebooted: DOMAIN\vm-1, DOMAIN\vm-2.`,
+				"1 session(s) will be affected.",
+			},
+			expectedAffectedSessionCount: types.Int64Value(1),
+		},
+		"scale down and image update with reboot": {
+			plan: newValue(t, true, 2, "image-2", &rebootNow),
+			expectedWarnings: []string{
+				`1 machine(s) will be deleted: DOMAIN\vm-1.`,
+				`1 machine(s) will be rebooted: DOMAIN\vm-2.`,
+				"1 session(s) will be affected.",
+			},
+			expectedAffectedSessionCount: types.Int64Value(1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: test.plan}}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: current},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: test.plan},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			// The planned impact is known in the plan, so that Update carries it into the state unchanged
+			var affectedSessionCount types.Int64
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("planned_impact").AtName("affected_session_count"), &affectedSessionCount)...)
+			if !affectedSessionCount.Equal(test.expectedAffectedSessionCount) {
+				t.Errorf("expected %s affected sessions in the planned impact, got %s", test.expectedAffectedSessionCount, affectedSessionCount)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(test.expectedWarnings) == 0 {
+				if len(warnings) != 0 {
+					t.Errorf("expected no planned impact, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != "Planned impact on Machine Catalog catalog" {
+				t.Fatalf("expected a single planned impact warning, got %v", warnings)
+			}
+			for _, expected := range test.expectedWarnings {
+				if !strings.Contains(warnings[0].Detail(), expected) {
+					t.Errorf("expected the planned impact to contain %q, got %q", expected, warnings[0].Detail())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &machineCatalogRollbackImageAction{}
+	_ action.ActionWithConfigure      = &machineCatalogRollbackImageAction{}
+	_ action.ActionWithValidateConfig = &machineCatalogRollbackImageAction{}
+)
+
+// NewMachineCatalogRollbackImageAction is a helper function to simplify the provider implementation.
+func NewMachineCatalogRollbackImageAction() action.Action {
+	return &machineCatalogRollbackImageAction{}
+}
+
+// machineCatalogRollbackImageAction is the action implementation.
+type machineCatalogRollbackImageAction struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// MachineCatalogRollbackImageActionModel maps the action schema data.
+type MachineCatalogRollbackImageActionModel struct {
+	MachineCatalogId         types.String `tfsdk:"machine_catalog_id"`
+	ImageUpdateRebootOptions types.Object `tfsdk:"image_update_reboot_options"` // ImageUpdateRebootOptionsModel
+}
+
+// Metadata returns the action type name.
+func (a *machineCatalogRollbackImageAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_rollback_to_previous_image"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *machineCatalogRollbackImageAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// Schema defines the schema for the action.
+func (a *machineCatalogRollbackImageAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "CVAD --- Rolls an MCS machine catalog back to the image it used before the current one, as recorded in the `image_history` of the machine catalog. " +
+			"The most recent image that is not the current one and is still available is assigned, either a master image or a prepared image version." +
+			"\n\n~> **Please Note** The image is changed outside of the `citrix_machine_catalog` resource. Update the image in the resource configuration accordingly to avoid the change being reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"machine_catalog_id": schema.StringAttribute{
+				Description: "Id of the machine catalog.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"image_update_reboot_options": getImageUpdateRebootOptionsActionSchema(),
+		},
+	}
+}
+
+func (a *machineCatalogRollbackImageAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var data MachineCatalogRollbackImageActionModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ImageUpdateRebootOptions.IsNull() && !data.ImageUpdateRebootOptions.IsUnknown() {
+		rebootOptions := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, data.ImageUpdateRebootOptions)
+		rebootOptions.ValidateConfig(&resp.Diagnostics)
+	}
+}
+
+// Invoke assigns the previous image of the machine catalog and waits for the job to complete.
+func (a *machineCatalogRollbackImageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if a.client == nil || a.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var config MachineCatalogRollbackImageActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Rolling back Image for Machine Catalog " + config.MachineCatalogId.ValueString()})
+
+	previousImageName, err := rollbackCatalogImage(ctx, a.client, &resp.Diagnostics, config.MachineCatalogId.ValueString(), config.ImageUpdateRebootOptions, getMachineCatalogTimeoutConfigs().UpdateDefault)
+	if err != nil {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Image of Machine Catalog " + config.MachineCatalogId.ValueString() + " rolled back to " + previousImageName})
+}
+
+// rollbackCatalogImage assigns the most recent image of the image history of the catalog that is not the current one and
+// is still available, through the same image update as a change of the machine catalog resource. It is used by the
+// rollback action and by the image rollout when a batch fails its health check, and returns the image assigned.
+func rollbackCatalogImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalogId string, imageUpdateRebootOptions types.Object, maxTimeoutInMinutes int32) (string, error) {
+	catalog, err := util.GetMachineCatalog(ctx, client, diagnostics, catalogId, true)
+	if err != nil {
+		return "", err
+	}
+	catalogName := catalog.GetName()
+
+	if catalog.GetProvisioningType() != citrixorchestration.PROVISIONINGTYPE_MCS {
+		err = errors.New("image rollback is only supported for machine catalogs with provisioning type MCS")
+		diagnostics.AddError(
+			"Error rolling back Image for Machine Catalog "+catalogName,
+			"Image rollback is only supported for machine catalogs with provisioning type MCS.",
+		)
+		return "", err
+	}
+
+	provScheme := catalog.GetProvisioningScheme()
+	previousImage, found := getPreviousImage(getProvisioningSchemeImageHistory(&provScheme))
+	if !found {
+		err = errors.New("the image history of the machine catalog does not contain a previous image that is still available")
+		diagnostics.AddError(
+			"Error rolling back Image for Machine Catalog "+catalogName,
+			"The image history of the machine catalog does not contain a previous image that is still available.",
+		)
+		return "", err
+	}
+
+	resourcePool := provScheme.GetResourcePool()
+	hypervisorRef := resourcePool.GetHypervisor()
+	hypervisor, err := util.GetHypervisor(ctx, client, diagnostics, hypervisorRef.GetId())
+	if err != nil {
+		return "", err
+	}
+
+	errorsCount := diagnostics.ErrorsCount()
+	plan := getRollbackImagePlan(ctx, client, diagnostics, catalog, hypervisor, previousImage, imageUpdateRebootOptions)
+	if diagnostics.ErrorsCount() > errorsCount {
+		return "", errors.New("error building the image update of machine catalog " + catalogName)
+	}
+
+	err = updateCatalogImageAndMachineProfile(ctx, client, diagnostics, catalog, plan, catalog.GetProvisioningType().Ptr(), maxTimeoutInMinutes)
+	if err != nil {
+		return "", err
+	}
+	if diagnostics.ErrorsCount() > errorsCount {
+		return "", errors.New("error updating the image of machine catalog " + catalogName)
+	}
+
+	previousImageName := previousImage.MasterImage.ValueString()
+	if !previousImage.ImageVersion.IsNull() {
+		previousImageName = previousImage.ImageVersion.ValueString()
+	}
+	return previousImageName, nil
+}
+
+// getRollbackImagePlan returns the machine catalog configuration with the previous image assigned, parsed from the catalog the
+// same way as on import, so that the rollback goes through the same image update as a change of the machine catalog resource.
+func getRollbackImagePlan(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, hypervisor *citrixorchestration.HypervisorDetailResponseModel, previousImage ImageHistoryModel, imageUpdateRebootOptions types.Object) MachineCatalogResourceModel {
+	rollbackProvScheme := catalog.GetProvisioningScheme()
+	if !previousImage.ImageVersion.IsNull() {
+		for _, imageVersion := range rollbackProvScheme.GetHistoricalImageVersions() {
+			if strings.EqualFold(imageVersion.ImageVersion.GetId(), previousImage.ImageVersion.ValueString()) {
+				rollbackProvScheme.SetCurrentImageVersion(imageVersion)
+			}
+		}
+	} else {
+		for _, diskImage := range rollbackProvScheme.GetHistoricalDiskImages() {
+			image := diskImage.GetImage()
+			if strings.EqualFold(image.GetXDPath(), previousImage.MasterImage.ValueString()) {
+				rollbackProvScheme.SetMasterImage(image)
+				rollbackProvScheme.SetCurrentDiskImage(diskImage)
+			}
+		}
+		rollbackProvScheme.CurrentImageVersion = nil
+	}
+	rollbackCatalog := *catalog
+	rollbackCatalog.SetProvisioningScheme(rollbackProvScheme)
+
+	provSchemeAttributes, err := util.ResourceAttributeMapFromObject(ProvisioningSchemeModel{})
+	if err != nil {
+		diagnostics.AddWarning("Error when creating null ProvisioningSchemeModel", err.Error())
+	}
+	plan := MachineCatalogResourceModel{
+		ProvisioningType:       types.StringValue(string(catalog.GetProvisioningType())),
+		MinimumFunctionalLevel: types.StringValue(string(catalog.GetMinimumFunctionalLevel())),
+		ProvisioningScheme:     types.ObjectNull(provSchemeAttributes),
+	}
+	plan = plan.updateCatalogWithProvScheme(ctx, diagnostics, client, &rollbackCatalog, hypervisor.GetConnectionType().Ptr(), hypervisor.GetPluginId(), rollbackProvScheme, nil)
+
+	provSchemePlan := util.ObjectValueToTypedObject[ProvisioningSchemeModel](ctx, diagnostics, plan.ProvisioningScheme)
+	setMachineConfigImageUpdateRebootOptions(ctx, diagnostics, &provSchemePlan, hypervisor, imageUpdateRebootOptions)
+	plan.ProvisioningScheme = util.TypedObjectToObjectValue(ctx, diagnostics, provSchemePlan)
+	return plan
+}
+
+// setMachineConfigImageUpdateRebootOptions sets the reboot options of the image update in the machine configuration of the hypervisor.
+func setMachineConfigImageUpdateRebootOptions(ctx context.Context, diagnostics *diag.Diagnostics, provSchemePlan *ProvisioningSchemeModel, hypervisor *citrixorchestration.HypervisorDetailResponseModel, imageUpdateRebootOptions types.Object) {
+	rebootOptionsAttributes, err := util.ResourceAttributeMapFromObject(ImageUpdateRebootOptionsModel{})
+	if err != nil {
+		diagnostics.AddWarning("Error when creating null ImageUpdateRebootOptionsModel", err.Error())
+	}
+	rebootOptions := types.ObjectNull(rebootOptionsAttributes)
+	if !imageUpdateRebootOptions.IsNull() {
+		rebootOptions = util.TypedObjectToObjectValue(ctx, diagnostics, util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, imageUpdateRebootOptions))
+	}
+
+	switch hypervisor.GetConnectionType() {
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM:
+		azureMachineConfig := util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, diagnostics, provSchemePlan.AzureMachineConfig)
+		azureMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.AzureMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, azureMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS:
+		awsMachineConfig := util.ObjectValueToTypedObject[AwsMachineConfigModel](ctx, diagnostics, provSchemePlan.AwsMachineConfig)
+		awsMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.AwsMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, awsMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE:
+		amazonWorkspacesCoreMachineConfig := util.ObjectValueToTypedObject[AmazonWorkspacesCoreMachineConfigModel](ctx, diagnostics, provSchemePlan.AmazonWorkspacesCoreMachineConfig)
+		amazonWorkspacesCoreMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.AmazonWorkspacesCoreMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, amazonWorkspacesCoreMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM:
+		gcpMachineConfig := util.ObjectValueToTypedObject[GcpMachineConfigModel](ctx, diagnostics, provSchemePlan.GcpMachineConfig)
+		gcpMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.GcpMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, gcpMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER:
+		vSphereMachineConfig := util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, diagnostics, provSchemePlan.VsphereMachineConfig)
+		vSphereMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.VsphereMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, vSphereMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER:
+		xenserverMachineConfig := util.ObjectValueToTypedObject[XenserverMachineConfigModel](ctx, diagnostics, provSchemePlan.XenserverMachineConfig)
+		xenserverMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.XenserverMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, xenserverMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT:
+		openshiftMachineConfig := util.ObjectValueToTypedObject[OpenshiftMachineConfigModel](ctx, diagnostics, provSchemePlan.OpenshiftMachineConfig)
+		openshiftMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.OpenshiftMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, openshiftMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM:
+		scvmmMachineConfig := util.ObjectValueToTypedObject[SCVMMMachineConfigModel](ctx, diagnostics, provSchemePlan.SCVMMMachineConfigModel)
+		scvmmMachineConfig.ImageUpdateRebootOptions = rebootOptions
+		provSchemePlan.SCVMMMachineConfigModel = util.TypedObjectToObjectValue(ctx, diagnostics, scvmmMachineConfig)
+	case citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM:
+		if hypervisor.GetPluginId() == util.NUTANIX_PLUGIN_ID {
+			nutanixMachineConfig := util.ObjectValueToTypedObject[NutanixMachineConfigModel](ctx, diagnostics, provSchemePlan.NutanixMachineConfig)
+			nutanixMachineConfig.ImageUpdateRebootOptions = rebootOptions
+			provSchemePlan.NutanixMachineConfig = util.TypedObjectToObjectValue(ctx, diagnostics, nutanixMachineConfig)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMachineCatalogRollbackImageActionInvoke(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeorchestration.NewClient(t)
+
+	hypervisorId := server.AddObject("Hypervisors", map[string]any{"Name": "azure", "ConnectionType": "AzureRM"})
+	resourcePoolId := server.AddObject("Hypervisors/"+hypervisorId+"/ResourcePools", map[string]any{"Name": "pool"})
+	imagePath := func(name string) string {
+		return `XDHyp:\HostingUnits\pool\image.folder\rg-images.resourcegroup\` + name + ".manageddisk"
+	}
+	diskImage := func(name string, status string, date string) map[string]any {
+		return map[string]any{
+			"Image":           map[string]any{"Name": name, "XDPath": imagePath(name)},
+			"ImageStatus":     status,
+			"Date":            date,
+			"MasterImageNote": "note of " + name,
+		}
+	}
+	for _, name := range []string{"image-2", "image-3"} {
+		server.AddObject("Hypervisors/"+hypervisorId+"/ResourcePools/"+resourcePoolId+"/Resources", map[string]any{"Name": name, "XDPath": imagePath(name)})
+	}
+	resourcePool := map[string]any{"Id": resourcePoolId, "Name": "pool", "Hypervisor": map[string]any{"Id": hypervisorId, "Name": "azure"}}
+
+	currentImage := diskImage("image-3", "Current", "2026-03-10T08:00:00Z")
+	masterImageCatalogId := server.AddObject("MachineCatalogs", map[string]any{
+		"Name":                   "master-image-catalog",
+		"ProvisioningType":       "MCS",
+		"MinimumFunctionalLevel": "L7_20",
+		"ProvisioningScheme": map[string]any{
+			"ResourcePool":     resourcePool,
+			"ServiceOffering":  "Standard_D2s_v3",
+			"MasterImage":      currentImage["Image"],
+			"CurrentDiskImage": currentImage,
+			"HistoricalDiskImages": []any{
+				diskImage("image-1", "Deleted", "2026-01-10T08:00:00Z"),
+				currentImage,
+				diskImage("image-2", "Prepared", "2026-02-10T08:00:00Z"),
+			},
+		},
+	})
+
+	imageDefinitionId := server.AddObject("ImageDefinitions", map[string]any{"Name": "definition"})
+	imageVersion := func(imageVersionId string, date string) map[string]any {
+		return map[string]any{
+			"ImageVersion":     map[string]any{"Id": imageVersionId, "ImageDefinition": map[string]any{"Id": imageDefinitionId}},
+			"Date":             date,
+			"IsImageAvailable": true,
+		}
+	}
+	preparedImageCatalogId := server.AddObject("MachineCatalogs", map[string]any{
+		"Name":                   "prepared-image-catalog",
+		"ProvisioningType":       "MCS",
+		"MinimumFunctionalLevel": "L7_20",
+		"ProvisioningScheme": map[string]any{
+			"ResourcePool":            resourcePool,
+			"ServiceOffering":         "Standard_D2s_v3",
+			"CurrentImageVersion":     imageVersion("version-2", "2026-02-10T08:00:00Z"),
+			"HistoricalImageVersions": []any{imageVersion("version-1", "2026-01-10T08:00:00Z")},
+		},
+	})
+
+	a := machine_catalog.NewMachineCatalogRollbackImageAction()
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: client}, &action.ConfigureResponse{})
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	invoke := func(t *testing.T, catalogId string) map[string]any {
+		t.Helper()
+		diagnostics := diag.Diagnostics{}
+		config := machine_catalog.MachineCatalogRollbackImageActionModel{
+			MachineCatalogId: types.StringValue(catalogId),
+			ImageUpdateRebootOptions: util.TypedObjectToObjectValue(ctx, &diagnostics, machine_catalog.ImageUpdateRebootOptionsModel{
+				RebootDuration:        types.Int64Value(60),
+				WarningDuration:       types.Int64Value(15),
+				WarningMessage:        types.StringValue("Rebooting to roll back the image"),
+				WarningRepeatInterval: types.Int64Null(),
+			}),
+		}
+		configState := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		diagnostics.Append(configState.Set(ctx, config)...)
+		if diagnostics.HasError() {
+			t.Fatalf("error setting the configuration: %v", diagnostics)
+		}
+
+		resp := &action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+		a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		request := server.LastRequestBody("MachineCatalogs/" + catalogId + "/$UpdateProvisioningScheme")
+		if request == nil {
+			t.Fatalf("expected the provisioning scheme of the catalog to be updated")
+		}
+		if request["StoreOldImage"] != true || request["MinimumFunctionalLevel"] != "L7_20" {
+			t.Errorf("expected the old image to be stored at functional level L7_20, got %v and %v", request["StoreOldImage"], request["MinimumFunctionalLevel"])
+		}
+		rebootOptions, _ := request["RebootOptions"].(map[string]any)
+		if rebootOptions["RebootDuration"] != float64(60) || rebootOptions["WarningDuration"] != float64(15) || rebootOptions["WarningMessage"] != "Rebooting to roll back the image" {
+			t.Errorf("expected the configured reboot options, got %v", rebootOptions)
+		}
+		return request
+	}
+
+	t.Run("master image", func(t *testing.T) {
+		request := invoke(t, masterImageCatalogId)
+		if request["MasterImagePath"] != imagePath("image-2") || request["MasterImageNote"] != "note of image-2" {
+			t.Errorf("expected master image %s with its note, got %v and %v", imagePath("image-2"), request["MasterImagePath"], request["MasterImageNote"])
+		}
+		if _, ok := request["AssignImageVersionToProvisioningScheme"]; ok {
+			t.Errorf("expected no image version to be assigned to a master image catalog")
+		}
+	})
+
+	t.Run("prepared image", func(t *testing.T) {
+		request := invoke(t, preparedImageCatalogId)
+		assignImageVersion, _ := request["AssignImageVersionToProvisioningScheme"].(map[string]any)
+		if assignImageVersion["ImageDefinition"] != imageDefinitionId || assignImageVersion["ImageVersion"] != "version-1" {
+			t.Errorf("expected image version version-1 of %s to be assigned, got %v", imageDefinitionId, assignImageVersion)
+		}
+		if _, ok := request["MasterImagePath"]; ok {
+			t.Errorf("expected no master image for a prepared image catalog")
+		}
+	})
+}
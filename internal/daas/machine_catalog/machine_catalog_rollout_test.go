@@ -0,0 +1,147 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRolloutCatalogImage(t *testing.T) {
+	tests := map[string]struct {
+		batchPauseMinutes  int64
+		unhealthyMachines  []string
+		cancelAfter        time.Duration
+		expectedReboots    []string
+		expectedRollback   bool
+		expectedError      string
+		expectedDiagnostic string
+	}{
+		"every batch registers with the new image": {
+			expectedReboots: []string{"vm-1", "vm-2", "vm-3", "vm-4"},
+		},
+		"batch failing the health check rolls back the image": {
+			unhealthyMachines: []string{"vm-3"},
+			// The machines of the canary and failed batches are rebooted again with the previous image
+			expectedReboots:    []string{"vm-1", "vm-2", "vm-3", "vm-1", "vm-2", "vm-3"},
+			expectedRollback:   true,
+			expectedError:      "did not register with the new image",
+			expectedDiagnostic: "The image rollout failed on batch 2 of 3",
+		},
+		"cancelled during the pause between batches": {
+			batchPauseMinutes: 60,
+			cancelAfter:       time.Second,
+			expectedReboots:   []string{"vm-1"},
+			expectedError:     "the image rollout was cancelled",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			server, client := fakeorchestration.NewClient(t)
+			machine_catalog.SetPollIntervals(t, time.Millisecond)
+
+			hypervisorId := server.AddObject("Hypervisors", map[string]any{"Name": "azure", "ConnectionType": "AzureRM"})
+			resourcePoolId := server.AddObject("Hypervisors/"+hypervisorId+"/ResourcePools", map[string]any{"Name": "pool"})
+			imagePath := func(name string) string {
+				return `XDHyp:\HostingUnits\pool\image.folder\rg-images.resourcegroup\` + name + ".manageddisk"
+			}
+			diskImage := func(name string, status string, date string) map[string]any {
+				return map[string]any{"Image": map[string]any{"Name": name, "XDPath": imagePath(name)}, "ImageStatus": status, "Date": date}
+			}
+			for _, name := range []string{"image-1", "image-2"} {
+				server.AddObject("Hypervisors/"+hypervisorId+"/ResourcePools/"+resourcePoolId+"/Resources", map[string]any{"Name": name, "XDPath": imagePath(name)})
+			}
+			// The new image is already assigned to the catalog when the machines are rolled out
+			currentImage := diskImage("image-2", "Current", "2026-02-10T08:00:00Z")
+			catalogId := server.AddObject("MachineCatalogs", map[string]any{
+				"Name":                   "catalog",
+				"ProvisioningType":       "MCS",
+				"MinimumFunctionalLevel": "L7_20",
+				"ProvisioningScheme": map[string]any{
+					"ResourcePool":         map[string]any{"Id": resourcePoolId, "Name": "pool", "Hypervisor": map[string]any{"Id": hypervisorId, "Name": "azure"}},
+					"ServiceOffering":      "Standard_D2s_v3",
+					"MasterImage":          currentImage["Image"],
+					"CurrentDiskImage":     currentImage,
+					"HistoricalDiskImages": []any{currentImage, diskImage("image-1", "Prepared", "2026-01-10T08:00:00Z")},
+				},
+			})
+			for _, name := range []string{"vm-4", "vm-2", "vm-1", "vm-3"} {
+				server.AddObject("Machines", map[string]any{"Name": name, "MachineCatalog": catalogId, "PowerState": "On", "RegistrationState": "Registered", "Hosting": map[string]any{"ImageOutOfDate": true}})
+			}
+			server.AddObject("Machines", map[string]any{"Name": "vm-5", "MachineCatalog": catalogId, "PowerState": "Off", "RegistrationState": "Unregistered", "Hosting": map[string]any{"ImageOutOfDate": true}})
+
+			// Rebooted machines get the image of the catalog and register again, unless they are unhealthy
+			reboots := []string{}
+			server.OnObjectRequest("POST", "Machines", func(machine map[string]any, rest []string) {
+				if len(rest) != 1 || rest[0] != "$reboot" {
+					return
+				}
+				name, _ := machine["Name"].(string)
+				reboots = append(reboots, name)
+				machine["Hosting"] = map[string]any{"ImageOutOfDate": false}
+				machine["RegistrationState"] = "Registered"
+				if slices.Contains(test.unhealthyMachines, name) {
+					machine["RegistrationState"] = "Unregistered"
+				}
+			})
+
+			if test.cancelAfter > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.cancelAfter)
+				defer cancel()
+			}
+			catalog := citrixorchestration.MachineCatalogDetailResponseModel{}
+			catalog.SetId(catalogId)
+			catalog.SetName("catalog")
+			rollout := machine_catalog.ImageRolloutModel{
+				CanaryMachineCount:        types.Int64Value(1),
+				CanaryMachinePercentage:   types.Int64Null(),
+				BatchSize:                 types.Int64Value(2),
+				BatchPauseMinutes:         types.Int64Value(test.batchPauseMinutes),
+				HealthCheckTimeoutMinutes: types.Int64Value(0),
+			}
+			diagnostics := diag.Diagnostics{}
+			err := machine_catalog.RolloutCatalogImage(ctx, client, &diagnostics, &catalog, rollout, 5)
+
+			if test.expectedError == "" {
+				if err != nil || diagnostics.HasError() {
+					t.Fatalf("unexpected error %v with diagnostics %v", err, diagnostics)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+				t.Fatalf("expected error containing %q, got %v", test.expectedError, err)
+			}
+			if test.expectedDiagnostic != "" && (!diagnostics.HasError() || !strings.Contains(diagnostics.Errors()[0].Detail(), test.expectedDiagnostic)) {
+				t.Errorf("expected a diagnostic containing %q, got %v", test.expectedDiagnostic, diagnostics)
+			}
+			if !slices.Equal(reboots, test.expectedReboots) {
+				t.Errorf("expected machines %v to be rebooted, got %v", test.expectedReboots, reboots)
+			}
+
+			update := server.LastRequestBody("MachineCatalogs/" + catalogId + "/$UpdateProvisioningScheme")
+			if !test.expectedRollback {
+				if update != nil {
+					t.Errorf("expected the image of the catalog not to be rolled back, got %v", update)
+				}
+				return
+			}
+			if update == nil || update["MasterImagePath"] != imagePath("image-1") {
+				t.Fatalf("expected the catalog to be rolled back to %s, got %v", imagePath("image-1"), update)
+			}
+			rebootOptions, _ := update["RebootOptions"].(map[string]any)
+			if rebootOptions["RebootDuration"] != float64(-1) {
+				t.Errorf("expected the image to be rolled back without reboot, got %v", rebootOptions)
+			}
+		})
+	}
+}
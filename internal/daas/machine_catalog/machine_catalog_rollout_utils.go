@@ -0,0 +1,237 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const imageRolloutMachineFields = "Id,Name,Hosting,PowerState,RegistrationState"
+
+// imageRolloutPollInterval is the interval at which the machines of a rollout batch are checked. It is shortened in tests.
+var imageRolloutPollInterval = 30 * time.Second
+
+// validateImageRollout checks that the image rollout is not combined with the image update reboot options, since the
+// rollout reboots the machines itself.
+func validateImageRollout(ctx context.Context, diagnostics *diag.Diagnostics, provSchemeModel ProvisioningSchemeModel) {
+	if provSchemeModel.Rollout.IsNull() {
+		return
+	}
+
+	rebootOptionsConfigured := false
+	if !provSchemeModel.AzureMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[AzureMachineConfigModel](ctx, diagnostics, provSchemeModel.AzureMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.AwsMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[AwsMachineConfigModel](ctx, diagnostics, provSchemeModel.AwsMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.AmazonWorkspacesCoreMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[AmazonWorkspacesCoreMachineConfigModel](ctx, diagnostics, provSchemeModel.AmazonWorkspacesCoreMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.GcpMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[GcpMachineConfigModel](ctx, diagnostics, provSchemeModel.GcpMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.VsphereMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[VsphereMachineConfigModel](ctx, diagnostics, provSchemeModel.VsphereMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.XenserverMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[XenserverMachineConfigModel](ctx, diagnostics, provSchemeModel.XenserverMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.NutanixMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[NutanixMachineConfigModel](ctx, diagnostics, provSchemeModel.NutanixMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.SCVMMMachineConfigModel.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[SCVMMMachineConfigModel](ctx, diagnostics, provSchemeModel.SCVMMMachineConfigModel).ImageUpdateRebootOptions.IsNull()
+	}
+	if !provSchemeModel.OpenshiftMachineConfig.IsNull() {
+		rebootOptionsConfigured = rebootOptionsConfigured || !util.ObjectValueToTypedObject[OpenshiftMachineConfigModel](ctx, diagnostics, provSchemeModel.OpenshiftMachineConfig).ImageUpdateRebootOptions.IsNull()
+	}
+
+	if rebootOptionsConfigured {
+		diagnostics.AddAttributeError(
+			path.Root("provisioning_scheme").AtName("rollout"),
+			"Incorrect Attribute Configuration",
+			"image_update_reboot_options cannot be configured when rollout is set. The rollout reboots the machines in batches.",
+		)
+	}
+}
+
+// getImageRolloutBatches splits the machines into the canary batch followed by batches of batch_size machines.
+func getImageRolloutBatches(machineIds []string, rollout ImageRolloutModel) [][]string {
+	if len(machineIds) == 0 {
+		return [][]string{}
+	}
+
+	canaryCount := int(rollout.CanaryMachineCount.ValueInt64())
+	if !rollout.CanaryMachinePercentage.IsNull() {
+		// Round up, so that at least one machine is a canary
+		canaryCount = (len(machineIds)*int(rollout.CanaryMachinePercentage.ValueInt64()) + 99) / 100
+	}
+	canaryCount = max(1, min(canaryCount, len(machineIds)))
+
+	batches := [][]string{machineIds[:canaryCount]}
+	remaining := machineIds[canaryCount:]
+	if len(remaining) == 0 {
+		return batches
+	}
+	if rollout.BatchSize.IsNull() {
+		return append(batches, remaining)
+	}
+	return append(batches, slices.Collect(slices.Chunk(remaining, int(rollout.BatchSize.ValueInt64())))...)
+}
+
+// rolloutCatalogImage reboots the powered on machines of the catalog in batches so that they get the image newly
+// assigned to the catalog. The catalog is rolled back to its previous image when the machines of a batch do not
+// register again in time.
+func rolloutCatalogImage(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, rollout ImageRolloutModel, maxTimeoutInMinutes int32) error {
+	catalogName := catalog.GetName()
+
+	machines, err := util.GetMachineCatalogMachinesWithFields(ctx, client, diagnostics, catalog.GetId(), imageRolloutMachineFields)
+	if err != nil {
+		return err
+	}
+	machineIds := []string{}
+	machineNames := map[string]string{}
+	for _, machine := range machines {
+		hosting := machine.GetHosting()
+		if machine.GetPowerState() == citrixorchestration.POWERSTATE_ON && hosting.GetImageOutOfDate() {
+			machineIds = append(machineIds, machine.GetId())
+			machineNames[machine.GetId()] = machine.GetName()
+		}
+	}
+	slices.SortFunc(machineIds, func(a, b string) int {
+		return strings.Compare(machineNames[a], machineNames[b])
+	})
+
+	batches := getImageRolloutBatches(machineIds, rollout)
+	updatedMachineIds := []string{}
+	for index, batch := range batches {
+		if index > 0 && rollout.BatchPauseMinutes.ValueInt64() > 0 {
+			if err := sleepWithContext(ctx, time.Duration(rollout.BatchPauseMinutes.ValueInt64())*time.Minute); err != nil {
+				return err
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Rolling out the image of Machine Catalog %s to batch %d of %d", catalogName, index+1, len(batches)), map[string]interface{}{
+			"machines": len(batch),
+		})
+		updatedMachineIds = append(updatedMachineIds, batch...)
+
+		err := rebootImageRolloutMachines(ctx, client, batch)
+		if err == nil {
+			err = waitForImageRolloutMachines(ctx, client, diagnostics, catalog.GetId(), batch, machineNames, time.Duration(rollout.HealthCheckTimeoutMinutes.ValueInt64())*time.Minute)
+		}
+		if err != nil {
+			rollbackErr := rollbackImageRollout(ctx, client, diagnostics, catalog, updatedMachineIds, maxTimeoutInMinutes)
+			if rollbackErr != nil {
+				return rollbackErr
+			}
+			diagnostics.AddError(
+				"Error updating Image for Machine Catalog "+catalogName,
+				fmt.Sprintf("The image rollout failed on batch %d of %d and the machine catalog was rolled back to its previous image.\nError message: %s", index+1, len(batches), err.Error()),
+			)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rebootImageRolloutMachines(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, machineIds []string) error {
+	for _, machineId := range machineIds {
+		rebootRequest := client.ApiClient.MachinesAPIsDAAS.MachinesRebootMachine(ctx, machineId)
+		_, httpResp, err := citrixdaasclient.AddRequestData(rebootRequest, client).Async(true).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to reboot machine %s, TransactionId: %s, error: %s", machineId, citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp), util.ReadClientError(err))
+		}
+
+		// The job errors are reported with the rollout error instead
+		jobDiagnostics := diag.Diagnostics{}
+		err = util.ProcessAsyncJobResponseWithAddToDiagsOption(ctx, client, httpResp, "Error rebooting Machine "+machineId, &jobDiagnostics, 10, false)
+		if err != nil {
+			return fmt.Errorf("failed to reboot machine %s, TransactionId: %s", machineId, citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp))
+		}
+	}
+	return nil
+}
+
+// waitForImageRolloutMachines waits until the machines are registered with the image of the catalog.
+func waitForImageRolloutMachines(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalogId string, machineIds []string, machineNames map[string]string, timeout time.Duration) error {
+	startTime := time.Now()
+	for {
+		if err := sleepWithContext(ctx, imageRolloutPollInterval); err != nil {
+			return err
+		}
+
+		machines, err := util.GetMachineCatalogMachinesWithFields(ctx, client, diagnostics, catalogId, imageRolloutMachineFields)
+		if err != nil {
+			return err
+		}
+		pendingMachines := []string{}
+		for _, machine := range machines {
+			if !slices.Contains(machineIds, machine.GetId()) {
+				continue
+			}
+			hosting := machine.GetHosting()
+			if machine.GetRegistrationState() != citrixorchestration.REGISTRATIONSTATE_REGISTERED || hosting.GetImageOutOfDate() {
+				pendingMachines = append(pendingMachines, machineNames[machine.GetId()])
+			}
+		}
+		if len(pendingMachines) == 0 {
+			return nil
+		}
+
+		if time.Since(startTime) >= timeout {
+			return fmt.Errorf("machines %s did not register with the new image within %s", strings.Join(pendingMachines, ", "), timeout)
+		}
+	}
+}
+
+// rollbackImageRollout assigns the previous image to the catalog again and reboots the machines already updated.
+func rollbackImageRollout(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, updatedMachineIds []string, maxTimeoutInMinutes int32) error {
+	catalogName := catalog.GetName()
+	tflog.Warn(ctx, "Rolling back the image of Machine Catalog "+catalogName)
+
+	// The image is assigned without reboot, the updated machines are rebooted below
+	rebootOptionsAttributes, err := util.ResourceAttributeMapFromObject(ImageUpdateRebootOptionsModel{})
+	if err != nil {
+		diagnostics.AddWarning("Error when creating null ImageUpdateRebootOptionsModel", err.Error())
+	}
+	_, err = rollbackCatalogImage(ctx, client, diagnostics, catalog.GetId(), types.ObjectNull(rebootOptionsAttributes), maxTimeoutInMinutes)
+	if err != nil {
+		return err
+	}
+
+	if err := rebootImageRolloutMachines(ctx, client, updatedMachineIds); err != nil {
+		diagnostics.AddWarning(
+			"Error rebooting Machines of Machine Catalog "+catalogName,
+			"The machine catalog was rolled back to its previous image, but some machines could not be rebooted and keep the new image until their next restart."+
+				"\nError message: "+err.Error(),
+		)
+	}
+	return nil
+}
+
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return errors.New("the image rollout was cancelled: " + ctx.Err().Error())
+	}
+}
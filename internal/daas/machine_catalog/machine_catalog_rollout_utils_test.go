@@ -0,0 +1,57 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGetImageRolloutBatches(t *testing.T) {
+	t.Parallel()
+
+	machines := []string{"m1", "m2", "m3", "m4", "m5", "m6", "m7"}
+
+	tests := map[string]struct {
+		machines []string
+		rollout  ImageRolloutModel
+		expected [][]string
+	}{
+		"canary count then remaining machines at once": {
+			machines: machines,
+			rollout:  ImageRolloutModel{CanaryMachineCount: types.Int64Value(2), CanaryMachinePercentage: types.Int64Null(), BatchSize: types.Int64Null()},
+			expected: [][]string{{"m1", "m2"}, {"m3", "m4", "m5", "m6", "m7"}},
+		},
+		"canary count then batches": {
+			machines: machines,
+			rollout:  ImageRolloutModel{CanaryMachineCount: types.Int64Value(1), CanaryMachinePercentage: types.Int64Null(), BatchSize: types.Int64Value(4)},
+			expected: [][]string{{"m1"}, {"m2", "m3", "m4", "m5"}, {"m6", "m7"}},
+		},
+		"canary percentage is rounded up": {
+			machines: machines,
+			rollout:  ImageRolloutModel{CanaryMachineCount: types.Int64Null(), CanaryMachinePercentage: types.Int64Value(20), BatchSize: types.Int64Value(3)},
+			expected: [][]string{{"m1", "m2"}, {"m3", "m4", "m5"}, {"m6", "m7"}},
+		},
+		"canary count larger than the catalog": {
+			machines: machines[:2],
+			rollout:  ImageRolloutModel{CanaryMachineCount: types.Int64Value(5), CanaryMachinePercentage: types.Int64Null(), BatchSize: types.Int64Null()},
+			expected: [][]string{{"m1", "m2"}},
+		},
+		"no machine to update": {
+			machines: []string{},
+			rollout:  ImageRolloutModel{CanaryMachineCount: types.Int64Value(1), CanaryMachinePercentage: types.Int64Null(), BatchSize: types.Int64Null()},
+			expected: [][]string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if batches := getImageRolloutBatches(test.machines, test.rollout); !reflect.DeepEqual(batches, test.expected) {
+				t.Errorf("expected batches %v, got %v", test.expected, batches)
+			}
+		})
+	}
+}
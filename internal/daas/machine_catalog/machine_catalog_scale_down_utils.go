@@ -0,0 +1,243 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	scaleDownMachineFields      = "Id,Uid,Name,DeliveryGroup,InMaintenanceMode,AssignedUsers,AllocationType,SessionCount"
+	scaleDownDrainPollInterval  = 30 * time.Second
+	scaleDownNameIndexSeparator = "\\"
+)
+
+var machineNameIndexRegex = regexp.MustCompile(`(\d+)$`)
+
+// validateScaleDown checks that machines_to_remove is only configured for the explicit scale down strategy.
+func validateScaleDown(ctx context.Context, diagnostics *diag.Diagnostics, provSchemeModel ProvisioningSchemeModel) {
+	if provSchemeModel.ScaleDown.IsNull() {
+		return
+	}
+
+	scaleDown := util.ObjectValueToTypedObject[ScaleDownModel](ctx, diagnostics, provSchemeModel.ScaleDown)
+	if !scaleDown.Strategy.IsUnknown() && scaleDown.Strategy.ValueString() != ScaleDownStrategyExplicit && !scaleDown.MachinesToRemove.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("provisioning_scheme").AtName("scale_down").AtName("machines_to_remove"),
+			"Incorrect Attribute Configuration",
+			fmt.Sprintf("machines_to_remove can only be configured when strategy is `%s`.", ScaleDownStrategyExplicit),
+		)
+	}
+}
+
+// selectMachinesToScaleDown returns the machines to delete from the catalog in the order of the scale down strategy.
+// Machines with active sessions are only selected when they are drained first.
+func selectMachinesToScaleDown(ctx context.Context, diagnostics *diag.Diagnostics, machines []citrixorchestration.MachineResponseModel, scaleDown ScaleDownModel, machineDeleteRequestCount int) ([]citrixorchestration.MachineResponseModel, error) {
+	candidates := []citrixorchestration.MachineResponseModel{}
+	for _, machine := range machines {
+		if machine.GetAllocationType() == citrixorchestration.ALLOCATIONTYPE_STATIC && len(machine.GetAssignedUsers()) > 0 {
+			continue
+		}
+		if !scaleDown.Drain.ValueBool() && machine.GetSessionCount() > 0 {
+			continue
+		}
+		candidates = append(candidates, machine)
+	}
+
+	// The API does not return the time a machine was added to the site. The site assigns the machine Uid in
+	// increasing order as machines are added, so the Uid orders the machines by the time they were added.
+	switch scaleDown.Strategy.ValueString() {
+	case ScaleDownStrategyOldestFirst:
+		slices.SortStableFunc(candidates, func(a, b citrixorchestration.MachineResponseModel) int {
+			return cmp.Compare(a.GetUid(), b.GetUid())
+		})
+	case ScaleDownStrategyNewestFirst:
+		slices.SortStableFunc(candidates, func(a, b citrixorchestration.MachineResponseModel) int {
+			return cmp.Compare(b.GetUid(), a.GetUid())
+		})
+	case ScaleDownStrategyHighestNameIndex:
+		slices.SortStableFunc(candidates, func(a, b citrixorchestration.MachineResponseModel) int {
+			return compareMachineNameIndex(b.GetName(), a.GetName())
+		})
+	case ScaleDownStrategyExplicit:
+		machinesToRemove := util.StringListToStringArray(ctx, diagnostics, scaleDown.MachinesToRemove)
+		explicitCandidates := []citrixorchestration.MachineResponseModel{}
+		for _, machineToRemove := range machinesToRemove {
+			index := slices.IndexFunc(candidates, func(machine citrixorchestration.MachineResponseModel) bool {
+				return machineNameMatches(machine.GetName(), machineToRemove)
+			})
+			// A machine listed more than once, with or without its domain, is only counted once
+			if index >= 0 && !slices.ContainsFunc(explicitCandidates, func(machine citrixorchestration.MachineResponseModel) bool {
+				return machine.GetId() == candidates[index].GetId()
+			}) {
+				explicitCandidates = append(explicitCandidates, candidates[index])
+			}
+		}
+		candidates = explicitCandidates
+	}
+
+	if len(candidates) < machineDeleteRequestCount {
+		errorString := fmt.Sprintf("%d machine(s) requested to be deleted. %d machine(s) qualify for deletion with the %s scale down strategy.", machineDeleteRequestCount, len(candidates), scaleDown.Strategy.ValueString())
+		if scaleDown.Strategy.ValueString() == ScaleDownStrategyExplicit {
+			errorString += " Ensure machines_to_remove lists enough machines of the catalog."
+		}
+		if !scaleDown.Drain.ValueBool() {
+			errorString += " Ensure machines that need to be deleted have no active sessions, or set drain to true."
+		}
+		err := fmt.Errorf("%s", errorString)
+		diagnostics.AddError(
+			"Error deleting machine(s) from Machine Catalog",
+			errorString+" For machines with `Static` allocation type, also ensure there are no assigned users.",
+		)
+		return nil, err
+	}
+
+	return candidates[:machineDeleteRequestCount], nil
+}
+
+// selectMachinesToDeleteByPriority returns the machines to delete from the catalog when no scale down strategy is configured.
+// Machines without a delivery group go first, then machines in maintenance mode, then machines without sessions.
+func selectMachinesToDeleteByPriority(machines []citrixorchestration.MachineResponseModel, machineDeleteRequestCount int) ([]citrixorchestration.MachineResponseModel, error) {
+	type prioritizedMachine struct {
+		machine  citrixorchestration.MachineResponseModel
+		priority int
+	}
+
+	var prioritizedCandidates []prioritizedMachine
+
+	for _, machine := range machines {
+		if machine.GetAllocationType() == citrixorchestration.ALLOCATIONTYPE_STATIC && len(machine.GetAssignedUsers()) > 0 {
+			continue
+		}
+		// Initialize priority for the current machine.
+		// The priority is used to determine the order in which machines should be deleted.
+		priority := 0
+
+		// Priority 1: Machine has no delivery group associated. This is the highest priority for deletion.
+		if !machine.GetDeliveryGroup().Id.IsSet() {
+			priority = 1
+		} else if machine.GetInMaintenanceMode() {
+			// Priority 2: Machine is currently in maintenance mode.
+			priority = 2
+		} else if machine.GetSessionCount() == 0 {
+			// Priority 3: Machine has zero active sessions.
+			priority = 3
+		}
+
+		// If the machine is eligible for deletion (i.e., has been assigned a priority),
+		// add it to our list of candidates.
+		if priority > 0 {
+			prioritizedCandidates = append(prioritizedCandidates, prioritizedMachine{
+				machine:  machine,
+				priority: priority,
+			})
+		}
+	}
+
+	// Sort the collected candidates by their assigned priority in ascending order.
+	slices.SortStableFunc(prioritizedCandidates, func(a, b prioritizedMachine) int {
+		return cmp.Compare(a.priority, b.priority)
+	})
+
+	machinesToDelete := []citrixorchestration.MachineResponseModel{}
+	for _, candidate := range prioritizedCandidates {
+		if len(machinesToDelete) == machineDeleteRequestCount {
+			break
+		}
+		machinesToDelete = append(machinesToDelete, candidate.machine)
+	}
+
+	if machineDeleteRequestCount > len(machinesToDelete) {
+		return nil, fmt.Errorf("%d machine(s) requested to be deleted. %d machine(s) qualify for deletion.", machineDeleteRequestCount, len(machinesToDelete))
+	}
+
+	return machinesToDelete, nil
+}
+
+// compareMachineNameIndex compares the machine names by the number at their end, and by name otherwise.
+func compareMachineNameIndex(a, b string) int {
+	aIndex := machineNameIndexRegex.FindString(a)
+	bIndex := machineNameIndexRegex.FindString(b)
+	if aIndex != "" && bIndex != "" {
+		aValue, aErr := strconv.ParseUint(aIndex, 10, 64)
+		bValue, bErr := strconv.ParseUint(bIndex, 10, 64)
+		if aErr == nil && bErr == nil && aValue != bValue {
+			return cmp.Compare(aValue, bValue)
+		}
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// machineNameMatches checks the machine name in the format <domain>\<machine> against a name with or without domain.
+func machineNameMatches(machineName string, name string) bool {
+	if strings.EqualFold(machineName, name) {
+		return true
+	}
+	_, nameWithoutDomain, found := strings.Cut(machineName, scaleDownNameIndexSeparator)
+	return found && strings.EqualFold(nameWithoutDomain, name)
+}
+
+// drainMachines puts the machines in maintenance mode and waits until their sessions end.
+func drainMachines(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, catalog *citrixorchestration.MachineCatalogDetailResponseModel, provSchemeModel ProvisioningSchemeModel, machines []citrixorchestration.MachineResponseModel, timeout time.Duration) error {
+	catalogName := catalog.GetName()
+	err := setMachinesToMaintenanceMode(ctx, diagnostics, client, catalog.GetId(), provSchemeModel, machines)
+	if err != nil {
+		return err
+	}
+
+	machineIds := []string{}
+	for _, machine := range machines {
+		machineIds = append(machineIds, machine.GetId())
+	}
+
+	startTime := time.Now()
+	for {
+		catalogMachines, err := util.GetMachineCatalogMachinesWithFields(ctx, client, diagnostics, catalog.GetId(), scaleDownMachineFields)
+		if err != nil {
+			return err
+		}
+		machinesWithSessions := []string{}
+		for _, machine := range catalogMachines {
+			if slices.Contains(machineIds, machine.GetId()) && machine.GetSessionCount() > 0 {
+				machinesWithSessions = append(machinesWithSessions, machine.GetName())
+			}
+		}
+		if len(machinesWithSessions) == 0 {
+			return nil
+		}
+
+		if time.Since(startTime) >= timeout {
+			err := fmt.Errorf("machine(s) %s still have active sessions after %s", strings.Join(machinesWithSessions, ", "), timeout)
+			diagnostics.AddError(
+				"Error deleting machine(s) from Machine Catalog "+catalogName,
+				err.Error()+". The machines stay in maintenance mode, run apply again once their sessions have ended.",
+			)
+			return err
+		}
+
+		tflog.Info(ctx, "Waiting for the sessions of the machines to delete to end", map[string]interface{}{
+			"machines": strings.Join(machinesWithSessions, ", "),
+		})
+		if err := sleepWithContext(ctx, scaleDownDrainPollInterval); err != nil {
+			diagnostics.AddError(
+				"Error deleting machine(s) from Machine Catalog "+catalogName,
+				err.Error(),
+			)
+			return err
+		}
+	}
+}
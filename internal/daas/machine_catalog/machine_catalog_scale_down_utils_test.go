@@ -0,0 +1,135 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newScaleDownTestMachine(name string, uid int32, sessionCount int32, assigned bool) citrixorchestration.MachineResponseModel {
+	machine := citrixorchestration.MachineResponseModel{}
+	machine.SetId(name)
+	machine.SetName(name)
+	machine.SetUid(uid)
+	machine.SetSessionCount(sessionCount)
+	if assigned {
+		machine.SetAllocationType(citrixorchestration.ALLOCATIONTYPE_STATIC)
+		machine.SetAssignedUsers([]citrixorchestration.IdentityUserResponseModel{{}})
+	}
+	return machine
+}
+
+func TestSelectMachinesToScaleDown(t *testing.T) {
+	t.Parallel()
+
+	machines := []citrixorchestration.MachineResponseModel{
+		newScaleDownTestMachine("DOMAIN\\vm-9", 12, 0, false),
+		newScaleDownTestMachine("DOMAIN\\vm-10", 15, 0, false),
+		newScaleDownTestMachine("DOMAIN\\vm-2", 10, 0, false),
+		newScaleDownTestMachine("DOMAIN\\vm-11", 20, 0, true),
+		newScaleDownTestMachine("DOMAIN\\vm-3", 8, 2, false),
+	}
+
+	tests := map[string]struct {
+		strategy         string
+		machinesToRemove []string
+		drain            bool
+		count            int
+		expected         []string
+		expectError      bool
+	}{
+		"oldest first with drain includes machines with sessions": {
+			strategy: ScaleDownStrategyOldestFirst,
+			drain:    true,
+			count:    2,
+			expected: []string{"DOMAIN\\vm-3", "DOMAIN\\vm-2"},
+		},
+		"newest first skips machines with assigned users": {
+			strategy: ScaleDownStrategyNewestFirst,
+			count:    2,
+			expected: []string{"DOMAIN\\vm-10", "DOMAIN\\vm-9"},
+		},
+		"highest name index compares the index as a number": {
+			strategy: ScaleDownStrategyHighestNameIndex,
+			count:    2,
+			expected: []string{"DOMAIN\\vm-10", "DOMAIN\\vm-9"},
+		},
+		"explicit keeps the configured order and ignores unknown machines": {
+			strategy:         ScaleDownStrategyExplicit,
+			machinesToRemove: []string{"vm-removed", "vm-9", "domain\\VM-2"},
+			count:            2,
+			expected:         []string{"DOMAIN\\vm-9", "DOMAIN\\vm-2"},
+		},
+		"explicit counts a machine listed twice once": {
+			strategy:         ScaleDownStrategyExplicit,
+			machinesToRemove: []string{"vm-9", "DOMAIN\\vm-9", "vm-10"},
+			count:            2,
+			expected:         []string{"DOMAIN\\vm-9", "DOMAIN\\vm-10"},
+		},
+		"explicit without enough machines after removing duplicates": {
+			strategy:         ScaleDownStrategyExplicit,
+			machinesToRemove: []string{"vm-9", "vm-9"},
+			count:            2,
+			expectError:      true,
+		},
+		"explicit without enough machines": {
+			strategy:         ScaleDownStrategyExplicit,
+			machinesToRemove: []string{"vm-9", "vm-3"},
+			count:            2,
+			expectError:      true,
+		},
+		"not enough machines without sessions": {
+			strategy:    ScaleDownStrategyNewestFirst,
+			count:       4,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			machinesToRemove := types.ListNull(types.StringType)
+			if test.machinesToRemove != nil {
+				elements := []attr.Value{}
+				for _, machine := range test.machinesToRemove {
+					elements = append(elements, types.StringValue(machine))
+				}
+				machinesToRemove = types.ListValueMust(types.StringType, elements)
+			}
+			scaleDown := ScaleDownModel{
+				Strategy:            types.StringValue(test.strategy),
+				MachinesToRemove:    machinesToRemove,
+				Drain:               types.BoolValue(test.drain),
+				DrainTimeoutMinutes: types.Int64Value(60),
+			}
+
+			diagnostics := diag.Diagnostics{}
+			selected, err := selectMachinesToScaleDown(context.Background(), &diagnostics, machines, scaleDown, test.count)
+			if test.expectError {
+				if err == nil || !diagnostics.HasError() {
+					t.Errorf("expected an error, got machines %v", selected)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			names := []string{}
+			for _, machine := range selected {
+				names = append(names, machine.GetName())
+			}
+			if !reflect.DeepEqual(names, test.expected) {
+				t.Errorf("expected machines %v, got %v", test.expected, names)
+			}
+		})
+	}
+}
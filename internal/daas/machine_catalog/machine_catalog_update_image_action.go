@@ -0,0 +1,244 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &machineCatalogUpdateImageAction{}
+	_ action.ActionWithConfigure      = &machineCatalogUpdateImageAction{}
+	_ action.ActionWithValidateConfig = &machineCatalogUpdateImageAction{}
+)
+
+// NewMachineCatalogUpdateImageAction is a helper function to simplify the provider implementation.
+func NewMachineCatalogUpdateImageAction() action.Action {
+	return &machineCatalogUpdateImageAction{}
+}
+
+// machineCatalogUpdateImageAction is the action implementation.
+type machineCatalogUpdateImageAction struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// MachineCatalogUpdateImageActionModel maps the action schema data.
+type MachineCatalogUpdateImageActionModel struct {
+	MachineCatalogId         types.String `tfsdk:"machine_catalog_id"`
+	MasterImagePath          types.String `tfsdk:"master_image_path"`
+	MasterImageNote          types.String `tfsdk:"master_image_note"`
+	ImageUpdateRebootOptions types.Object `tfsdk:"image_update_reboot_options"` // ImageUpdateRebootOptionsModel
+}
+
+// Metadata returns the action type name.
+func (a *machineCatalogUpdateImageAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_update_image"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *machineCatalogUpdateImageAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// Schema defines the schema for the action.
+func (a *machineCatalogUpdateImageAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "CVAD --- Updates the master image of an MCS machine catalog. " +
+			"\n\n~> **Please Note** The image is changed outside of the `citrix_machine_catalog` resource. Update the master image in the resource configuration accordingly to avoid the change being reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"machine_catalog_id": schema.StringAttribute{
+				Description: "Id of the machine catalog.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"master_image_path": schema.StringAttribute{
+				Description: "The XDPath of the master image, for example `XDHyp:\\HostingUnits\\{resource pool}\\{image}.vm\\{snapshot}.snapshot`. " +
+					"When omitted, the current master image of the catalog is used, which picks up the latest snapshot for hypervisors that resolve the image at update time.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"master_image_note": schema.StringAttribute{
+				Description: "The note for the master image.",
+				Optional:    true,
+			},
+			"image_update_reboot_options": getImageUpdateRebootOptionsActionSchema(),
+		},
+	}
+}
+
+func (a *machineCatalogUpdateImageAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var data MachineCatalogUpdateImageActionModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ImageUpdateRebootOptions.IsNull() && !data.ImageUpdateRebootOptions.IsUnknown() {
+		rebootOptions := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, &resp.Diagnostics, data.ImageUpdateRebootOptions)
+		rebootOptions.ValidateConfig(&resp.Diagnostics)
+	}
+}
+
+// Invoke updates the master image of the machine catalog and waits for the job to complete.
+func (a *machineCatalogUpdateImageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if a.client == nil || a.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var config MachineCatalogUpdateImageActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := util.GetMachineCatalog(ctx, a.client, &resp.Diagnostics, config.MachineCatalogId.ValueString(), true)
+	if err != nil {
+		return
+	}
+	catalogName := catalog.GetName()
+
+	if catalog.GetProvisioningType() != citrixorchestration.PROVISIONINGTYPE_MCS {
+		resp.Diagnostics.AddError(
+			"Error updating Image for Machine Catalog "+catalogName,
+			"Image update is only supported for machine catalogs with provisioning type MCS.",
+		)
+		return
+	}
+
+	provScheme := catalog.GetProvisioningScheme()
+	if provScheme.CurrentImageVersion != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Image for Machine Catalog "+catalogName,
+			"Image update is not supported for machine catalogs using a prepared image. Assign a new image version in the machine catalog resource instead.",
+		)
+		return
+	}
+
+	updateProvisioningSchemeModel := getUpdateImageRequestModel(ctx, &resp.Diagnostics, catalog, config)
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Updating Image for Machine Catalog " + catalogName + " to " + updateProvisioningSchemeModel.GetMasterImagePath()})
+
+	err = updateCatalogProvisioningSchemeImage(ctx, a.client, &resp.Diagnostics, catalog.GetId(), catalogName, updateProvisioningSchemeModel, getMachineCatalogTimeoutConfigs().UpdateDefault)
+	if err != nil {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Image updated for Machine Catalog " + catalogName})
+}
+
+// getUpdateImageRequestModel builds the provisioning scheme update of the action configuration. The current master image
+// of the catalog is used when no master image path is configured.
+func getUpdateImageRequestModel(ctx context.Context, diagnostics *diag.Diagnostics, catalog *citrixorchestration.MachineCatalogDetailResponseModel, config MachineCatalogUpdateImageActionModel) citrixorchestration.UpdateMachineCatalogProvisioningSchemeRequestModel {
+	masterImagePath := config.MasterImagePath.ValueString()
+	if config.MasterImagePath.IsNull() {
+		provScheme := catalog.GetProvisioningScheme()
+		masterImage := provScheme.GetMasterImage()
+		masterImagePath = masterImage.GetXDPath()
+	}
+
+	var updateProvisioningSchemeModel citrixorchestration.UpdateMachineCatalogProvisioningSchemeRequestModel
+	updateProvisioningSchemeModel.SetMinimumFunctionalLevel(catalog.GetMinimumFunctionalLevel())
+	updateProvisioningSchemeModel.SetStoreOldImage(true)
+	updateProvisioningSchemeModel.SetMasterImagePath(masterImagePath)
+	updateProvisioningSchemeModel.SetMasterImageNote(config.MasterImageNote.ValueString())
+	updateProvisioningSchemeModel.SetRebootOptions(getImageUpdateRebootOptionsRequestModel(ctx, diagnostics, config.ImageUpdateRebootOptions))
+	return updateProvisioningSchemeModel
+}
+
+// getImageUpdateRebootOptionsActionSchema returns the reboot options schema shared by the machine catalog image actions.
+func getImageUpdateRebootOptionsActionSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "The options for how rebooting is performed for image update. When omitted, image update on the VDAs will be performed on next shutdown.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"reboot_duration": schema.Int64Attribute{
+				Description: "Approximate maximum duration over which the reboot cycle runs, in minutes. " +
+					"-> **Note** Set to `-1` to skip reboot, and perform image update on the VDAs on next shutdown. " +
+					"Set to `0` to reboot all machines immediately.",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+			},
+			"warning_duration": schema.Int64Attribute{
+				Description: "Time in minutes prior to a machine reboot at which a warning message is displayed in all user sessions on that machine. When omitted, no warning about reboot will be displayed in user session.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+					int64validator.NoneOf(0),
+					int64validator.AlsoRequires(path.Expressions{
+						path.MatchRelative().AtParent().AtName("warning_message"),
+					}...),
+				},
+			},
+			"warning_message": schema.StringAttribute{
+				Description: "Warning message displayed in user sessions on a machine scheduled for a reboot. The optional pattern '%m%' is replaced by the number of minutes until the reboot.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"warning_repeat_interval": schema.Int64Attribute{
+				Description: "Number of minutes to wait before showing the reboot warning message again.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.Expressions{
+						path.MatchRelative().AtParent().AtName("warning_duration"),
+					}...),
+				},
+			},
+		},
+	}
+}
+
+// getImageUpdateRebootOptionsRequestModel builds the reboot options of an image update. When no options are configured, image update on the VDAs will be performed on next shutdown.
+func getImageUpdateRebootOptionsRequestModel(ctx context.Context, diagnostics *diag.Diagnostics, imageUpdateRebootOptions types.Object) citrixorchestration.RebootMachinesRequestModel {
+	var rebootOption citrixorchestration.RebootMachinesRequestModel
+	rebootOption.SetRebootDuration(-1)
+	if imageUpdateRebootOptions.IsNull() {
+		return rebootOption
+	}
+
+	rebootOptionsPlan := util.ObjectValueToTypedObject[ImageUpdateRebootOptionsModel](ctx, diagnostics, imageUpdateRebootOptions)
+	rebootOption.SetRebootDuration(int32(rebootOptionsPlan.RebootDuration.ValueInt64()))
+	warningDuration := int32(rebootOptionsPlan.WarningDuration.ValueInt64())
+	rebootOption.SetWarningDuration(warningDuration)
+	if warningDuration > 0 || warningDuration == -1 {
+		// if warning duration is not 0, it's set in plan and requires warning message body
+		rebootOption.SetWarningMessage(rebootOptionsPlan.WarningMessage.ValueString())
+		if !rebootOptionsPlan.WarningRepeatInterval.IsNull() {
+			rebootOption.SetWarningRepeatInterval(int32(rebootOptionsPlan.WarningRepeatInterval.ValueInt64()))
+		}
+	}
+	return rebootOption
+}
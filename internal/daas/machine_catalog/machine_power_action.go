@@ -0,0 +1,291 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	MachinePowerActionStart      = "Start"
+	MachinePowerActionShutdown   = "Shutdown"
+	MachinePowerActionReboot     = "Reboot"
+	MachinePowerActionSuspend    = "Suspend"
+	MachinePowerActionResume     = "Resume"
+	MachinePowerActionLogoff     = "Logoff"
+	MachinePowerActionDisconnect = "Disconnect"
+)
+
+// machineRegistrationPollInterval is the interval at which the registration of the machine is checked. It is shortened in tests.
+var machineRegistrationPollInterval = 30 * time.Second
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &machinePowerAction{}
+	_ action.ActionWithConfigure      = &machinePowerAction{}
+	_ action.ActionWithValidateConfig = &machinePowerAction{}
+)
+
+// NewMachinePowerAction is a helper function to simplify the provider implementation.
+func NewMachinePowerAction() action.Action {
+	return &machinePowerAction{}
+}
+
+// machinePowerAction is the action implementation.
+type machinePowerAction struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// MachinePowerActionModel maps the action schema data.
+type MachinePowerActionModel struct {
+	Machine                    types.String `tfsdk:"machine"`
+	Action                     types.String `tfsdk:"action"`
+	Force                      types.Bool   `tfsdk:"force"`
+	WaitForRegistration        types.Bool   `tfsdk:"wait_for_registration"`
+	RegistrationTimeoutMinutes types.Int64  `tfsdk:"registration_timeout_minutes"`
+}
+
+// Metadata returns the action type name.
+func (a *machinePowerAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_power_action"
+}
+
+// Configure adds the provider configured client to the action.
+func (a *machinePowerAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// Schema defines the schema for the action.
+func (a *machinePowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "CVAD --- Runs a power or session action against a single machine." +
+			"\n\n-> **Note** The Citrix Orchestration API has no operation that forces a VDA to register again. Use the `Reboot` action with `wait_for_registration` set to `true` to restart the VDA and wait for it to register.",
+		Attributes: map[string]schema.Attribute{
+			"machine": schema.StringAttribute{
+				Description: "Id or name of the machine. The machine name should be in the format `{domain}\\{machine name}`.",
+				Required:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "The action to run against the machine. Choose between `Start`, `Shutdown`, `Reboot`, `Suspend`, `Resume`, `Logoff` and `Disconnect`. " +
+					"`Logoff` and `Disconnect` apply to all sessions on the machine.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						MachinePowerActionStart,
+						MachinePowerActionShutdown,
+						MachinePowerActionReboot,
+						MachinePowerActionSuspend,
+						MachinePowerActionResume,
+						MachinePowerActionLogoff,
+						MachinePowerActionDisconnect,
+					),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Description: "Whether to force the shutdown or reboot of the machine without waiting for a graceful shutdown of the operating system. Only applies to `Shutdown` and `Reboot` actions. When omitted, a graceful shutdown is attempted.",
+				Optional:    true,
+			},
+			"wait_for_registration": schema.BoolAttribute{
+				Description: "Whether to wait for the VDA on the machine to register with the delivery controller after the action completes. Only applies to `Start`, `Reboot` and `Resume` actions. After a `Reboot`, the VDA must first deregister, since it may still report as registered when the reboot job completes.",
+				Optional:    true,
+			},
+			"registration_timeout_minutes": schema.Int64Attribute{
+				Description: "Maximum time in minutes to wait for the VDA to register. Defaults to `30`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *machinePowerAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var data MachinePowerActionModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Action.IsUnknown() {
+		return
+	}
+	powerAction := data.Action.ValueString()
+
+	if !data.Force.IsNull() && powerAction != MachinePowerActionShutdown && powerAction != MachinePowerActionReboot {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("force"),
+			"Incorrect Attribute Configuration",
+			fmt.Sprintf("force can only be specified when action is %s or %s.", MachinePowerActionShutdown, MachinePowerActionReboot),
+		)
+	}
+
+	if data.WaitForRegistration.ValueBool() && !machinePowerActionRegistersVda(powerAction) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_registration"),
+			"Incorrect Attribute Configuration",
+			fmt.Sprintf("wait_for_registration can only be set to true when action is %s, %s or %s.", MachinePowerActionStart, MachinePowerActionReboot, MachinePowerActionResume),
+		)
+	}
+
+	if !data.RegistrationTimeoutMinutes.IsNull() && !data.WaitForRegistration.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("registration_timeout_minutes"),
+			"Incorrect Attribute Configuration",
+			"registration_timeout_minutes can only be specified when wait_for_registration is set to true.",
+		)
+	}
+}
+
+// Invoke runs the power action and optionally waits for the VDA to register.
+func (a *machinePowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if a.client == nil || a.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var config MachinePowerActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineNameOrId := strings.ReplaceAll(config.Machine.ValueString(), "\\", "|")
+	machine, err := getMachineProperties(ctx, a.client, &resp.Diagnostics, machineNameOrId)
+	if err != nil {
+		return
+	}
+	machineName := machine.GetName()
+	powerAction := config.Action.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Running %s on Machine %s", powerAction, machineName)})
+	actionStartTime := time.Now()
+
+	switch powerAction {
+	case MachinePowerActionLogoff:
+		err = applyMachineSessionAction(ctx, a.client, &resp.Diagnostics, machine, MachineSessionActionLogoff)
+	case MachinePowerActionDisconnect:
+		err = applyMachineSessionAction(ctx, a.client, &resp.Diagnostics, machine, MachineSessionActionDisconnect)
+	default:
+		err = runMachinePowerAction(ctx, a.client, &resp.Diagnostics, machine.GetId(), machineName, powerAction, config.Force.ValueBool())
+	}
+	if err != nil {
+		return
+	}
+
+	if !config.WaitForRegistration.ValueBool() {
+		return
+	}
+
+	timeoutMinutes := int64(30)
+	if !config.RegistrationTimeoutMinutes.IsNull() {
+		timeoutMinutes = config.RegistrationTimeoutMinutes.ValueInt64()
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for Machine %s to register", machineName)})
+	_ = waitForMachineRegistration(ctx, a.client, &resp.Diagnostics, machine.GetId(), machineName, powerAction == MachinePowerActionReboot, actionStartTime, time.Duration(timeoutMinutes)*time.Minute)
+}
+
+func machinePowerActionRegistersVda(powerAction string) bool {
+	return powerAction == MachinePowerActionStart || powerAction == MachinePowerActionReboot || powerAction == MachinePowerActionResume
+}
+
+func runMachinePowerAction(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineId string, machineName string, powerAction string, force bool) error {
+	var httpResp *http.Response
+	var err error
+	switch powerAction {
+	case MachinePowerActionStart:
+		startRequest := client.ApiClient.MachinesAPIsDAAS.MachinesStartMachine(ctx, machineId)
+		_, httpResp, err = citrixdaasclient.AddRequestData(startRequest, client).Async(true).Execute()
+	case MachinePowerActionShutdown:
+		shutdownRequest := client.ApiClient.MachinesAPIsDAAS.MachinesShutdownMachine(ctx, machineId).Force(force)
+		_, httpResp, err = citrixdaasclient.AddRequestData(shutdownRequest, client).Async(true).Execute()
+	case MachinePowerActionReboot:
+		rebootRequest := client.ApiClient.MachinesAPIsDAAS.MachinesRebootMachine(ctx, machineId).Force(force)
+		_, httpResp, err = citrixdaasclient.AddRequestData(rebootRequest, client).Async(true).Execute()
+	case MachinePowerActionSuspend:
+		suspendRequest := client.ApiClient.MachinesAPIsDAAS.MachinesSuspendMachine(ctx, machineId)
+		_, httpResp, err = citrixdaasclient.AddRequestData(suspendRequest, client).Async(true).Execute()
+	case MachinePowerActionResume:
+		resumeRequest := client.ApiClient.MachinesAPIsDAAS.MachinesResumeMachine(ctx, machineId)
+		_, httpResp, err = citrixdaasclient.AddRequestData(resumeRequest, client).Async(true).Execute()
+	}
+	errorMessage := fmt.Sprintf("Error running power action %s on Machine %s", powerAction, machineName)
+	if err != nil {
+		diagnostics.AddError(
+			errorMessage,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, errorMessage, diagnostics, 10)
+}
+
+// waitForMachineRegistration polls the machine until the VDA reports as registered or the timeout elapses.
+// After a reboot, the VDA often still reports as registered when the power job completes, so the machine must first
+// deregister: either its last deregistration is later than the start of the action, or it leaves the registered state.
+func waitForMachineRegistration(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineId string, machineName string, waitForDeregistration bool, actionStartTime time.Time, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	deregistered := !waitForDeregistration
+	for {
+		machine, err := getMachineProperties(ctx, client, diagnostics, machineId)
+		if err != nil {
+			return err
+		}
+		registered := machine.GetRegistrationState() == citrixorchestration.REGISTRATIONSTATE_REGISTERED
+		if !deregistered {
+			lastDeregistrationTime, err := time.Parse(time.RFC3339, machine.GetLastDeregistrationTime())
+			deregistered = !registered || (err == nil && lastDeregistrationTime.After(actionStartTime))
+		}
+		if deregistered && registered {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			err = fmt.Errorf("machine %s did not register within %s, current registration state is %s", machineName, timeout, machine.GetRegistrationState())
+			if !deregistered {
+				err = fmt.Errorf("machine %s did not deregister after the %s within %s", machineName, MachinePowerActionReboot, timeout)
+			}
+			diagnostics.AddError(
+				"Error waiting for Machine "+machineName+" to register",
+				"Error message: "+err.Error(),
+			)
+			return err
+		}
+
+		if err := sleepWithContext(ctx, machineRegistrationPollInterval); err != nil {
+			diagnostics.AddError(
+				"Error waiting for Machine "+machineName+" to register",
+				"Error message: "+err.Error(),
+			)
+			return err
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMachinePowerActionRebootWaitsForRegistration(t *testing.T) {
+	tests := map[string]struct {
+		// afterReboot changes the machine on each read after the reboot, starting with the first one
+		afterReboot   func(machine map[string]any, read int)
+		expectedReads int
+		expectedError string
+	}{
+		"machine deregisters then registers again": {
+			afterReboot: func(machine map[string]any, read int) {
+				switch read {
+				case 2:
+					machine["RegistrationState"] = "Unregistered"
+				case 3:
+					machine["RegistrationState"] = "Registered"
+				}
+			},
+			expectedReads: 3,
+		},
+		"machine registered again with a later deregistration": {
+			afterReboot: func(machine map[string]any, read int) {
+				machine["LastDeregistrationTime"] = time.Now().UTC().Add(time.Minute).Format(time.RFC3339)
+			},
+			expectedReads: 1,
+		},
+		"machine never deregisters": {
+			afterReboot:   func(machine map[string]any, read int) {},
+			expectedError: "did not deregister after the Reboot",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			server, client := fakeorchestration.NewClient(t)
+			machine_catalog.SetPollIntervals(t, time.Millisecond)
+
+			machineId := server.AddObject("Machines", map[string]any{
+				"Name":                   `DOMAIN\vm-1`,
+				"PowerState":             "On",
+				"RegistrationState":      "Registered",
+				"LastDeregistrationTime": "2026-01-10T08:00:00Z",
+			})
+			rebooted := false
+			reads := 0
+			server.OnObjectRequest("POST", "Machines", func(machine map[string]any, rest []string) {
+				rebooted = rebooted || (len(rest) == 1 && rest[0] == "$reboot")
+			})
+			server.OnObjectRequest("GET", "Machines", func(machine map[string]any, rest []string) {
+				if rebooted && len(rest) == 0 {
+					reads++
+					test.afterReboot(machine, reads)
+				}
+			})
+
+			a := machine_catalog.NewMachinePowerAction()
+			a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: client}, &action.ConfigureResponse{})
+			schemaResp := &action.SchemaResponse{}
+			a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+			// The timeout of the machine that never deregisters elapses after its first read
+			timeoutMinutes := int64(1)
+			if test.expectedError != "" {
+				timeoutMinutes = 0
+			}
+			configState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := configState.Set(ctx, machine_catalog.MachinePowerActionModel{
+				Machine:                    types.StringValue(machineId),
+				Action:                     types.StringValue(machine_catalog.MachinePowerActionReboot),
+				Force:                      types.BoolNull(),
+				WaitForRegistration:        types.BoolValue(true),
+				RegistrationTimeoutMinutes: types.Int64Value(timeoutMinutes),
+			})
+			if diags.HasError() {
+				t.Fatalf("error setting the configuration: %v", diags)
+			}
+
+			resp := &action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+			a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, resp)
+
+			if test.expectedError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), test.expectedError) {
+					t.Fatalf("expected an error containing %q, got %v", test.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !rebooted {
+				t.Fatalf("expected the machine to be rebooted")
+			}
+			if reads != test.expectedReads {
+				t.Errorf("expected the wait to end after %d reads of the machine, got %d", test.expectedReads, reads)
+			}
+		})
+	}
+}
@@ -68,7 +68,7 @@ func (r *MachinePropertiesResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	err := validateMachineAndMachineCatalogExistence(ctx, r.client, &resp.Diagnostics, plan)
+	_, err := validateMachineAndMachineCatalogExistence(ctx, r.client, &resp.Diagnostics, plan.Name.ValueString(), plan.MachineCatalogId.ValueString())
 	if err != nil {
 		return // error already added to diagnostics
 	}
@@ -111,7 +111,7 @@ func (r *MachinePropertiesResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	err := validateMachineAndMachineCatalogExistence(ctx, r.client, &resp.Diagnostics, state)
+	_, err := validateMachineAndMachineCatalogExistence(ctx, r.client, &resp.Diagnostics, state.Name.ValueString(), state.MachineCatalogId.ValueString())
 	if err != nil {
 		return // error already added to diagnostics
 	}
@@ -149,7 +149,7 @@ func (r *MachinePropertiesResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	err := validateMachineAndMachineCatalogExistence(ctx, r.client, &resp.Diagnostics, plan)
+	_, err := validateMachineAndMachineCatalogExistence(ctx, r.client, &resp.Diagnostics, plan.Name.ValueString(), plan.MachineCatalogId.ValueString())
 	if err != nil {
 		return // error already added to diagnostics
 	}
@@ -336,26 +336,25 @@ func setMachineTags(ctx context.Context, client *citrixdaasclient.CitrixDaasClie
 	return nil
 }
 
-func validateMachineAndMachineCatalogExistence(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machinePropertiesModel MachinePropertiesModel) error {
-	machineName := strings.ReplaceAll(machinePropertiesModel.Name.ValueString(), "\\", "|")
-	getMachinePropertiesRequest := client.ApiClient.MachinesAPIsDAAS.MachinesGetMachine(ctx, machineName)
+func validateMachineAndMachineCatalogExistence(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineName string, machineCatalogId string) (*citrixorchestration.MachineDetailResponseModel, error) {
+	getMachinePropertiesRequest := client.ApiClient.MachinesAPIsDAAS.MachinesGetMachine(ctx, strings.ReplaceAll(machineName, "\\", "|"))
 	machineProperties, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineDetailResponseModel](getMachinePropertiesRequest, client)
 	if err != nil {
 		diagnostics.AddError(
-			"Error reading the properties of Machine "+machinePropertiesModel.Name.ValueString(),
+			"Error reading the properties of Machine "+machineName,
 			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
 				"\nError message: "+util.ReadClientError(err),
 		)
-		return err
+		return nil, err
 	}
 	machineCatalog := machineProperties.GetMachineCatalog()
-	if !strings.EqualFold(machineCatalog.GetId(), machinePropertiesModel.MachineCatalogId.ValueString()) {
+	if !strings.EqualFold(machineCatalog.GetId(), machineCatalogId) {
 		err = fmt.Errorf("Machine catalog ID specified does not match the machine catalog ID of the machine")
 		diagnostics.AddError(
-			"Error reading the properties of Machine "+machinePropertiesModel.Name.ValueString(),
+			"Error reading the properties of Machine "+machineName,
 			err.Error(),
 		)
-		return err
+		return nil, err
 	}
-	return nil
+	return machineProperties, nil
 }
@@ -0,0 +1,244 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &MachineResource{}
+	_ resource.ResourceWithConfigure      = &MachineResource{}
+	_ resource.ResourceWithImportState    = &MachineResource{}
+	_ resource.ResourceWithValidateConfig = &MachineResource{}
+	_ resource.ResourceWithModifyPlan     = &MachineResource{}
+)
+
+// NewMachineResource is a helper function to simplify the provider implementation.
+func NewMachineResource() resource.Resource {
+	return &MachineResource{}
+}
+
+// MachineResource is the resource implementation.
+type MachineResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the resource type name.
+func (r *MachineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MachineResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// Schema defines the schema for the resource.
+func (r *MachineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = MachineResourceModel{}.GetSchema()
+}
+
+// Create implements resource.Resource.
+func (r *MachineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var plan MachineResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machine, err := validateMachineAndMachineCatalogExistence(ctx, r.client, &resp.Diagnostics, plan.Name.ValueString(), plan.MachineCatalogId.ValueString())
+	if err != nil {
+		return // error already added to diagnostics
+	}
+
+	err = applyMachineSettings(ctx, r.client, &resp.Diagnostics, plan, nil, machine)
+	if err != nil {
+		return
+	}
+
+	// Get refreshed machine properties from Orchestration
+	machine, err = getMachineProperties(ctx, r.client, &resp.Diagnostics, machine.GetId())
+	if err != nil {
+		return
+	}
+
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, machine)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read implements resource.Resource.
+func (r *MachineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var state MachineResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineNameOrId := state.Id.ValueString()
+	if machineNameOrId == "" {
+		// The id is not known yet after import
+		machineNameOrId = strings.ReplaceAll(state.Name.ValueString(), "\\", "|")
+	}
+	machine, err := readMachineProperties(ctx, r.client, resp, machineNameOrId)
+	if err != nil {
+		return
+	}
+
+	state = state.RefreshPropertyValues(ctx, &resp.Diagnostics, machine)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update implements resource.Resource.
+func (r *MachineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var plan, state MachineResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machine, err := getMachineProperties(ctx, r.client, &resp.Diagnostics, state.Id.ValueString())
+	if err != nil {
+		return
+	}
+
+	err = applyMachineSettings(ctx, r.client, &resp.Diagnostics, plan, &state, machine)
+	if err != nil {
+		return
+	}
+
+	// Get refreshed machine properties from Orchestration
+	machine, err = getMachineProperties(ctx, r.client, &resp.Diagnostics, state.Id.ValueString())
+	if err != nil {
+		return
+	}
+
+	plan = plan.RefreshPropertyValues(ctx, &resp.Diagnostics, machine)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete implements resource.Resource.
+func (r *MachineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	// Retrieve values from state
+	var state MachineResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getMachineRequest := r.client.ApiClient.MachinesAPIsDAAS.MachinesGetMachine(ctx, state.Id.ValueString())
+	machine, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineDetailResponseModel](getMachineRequest, r.client)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Machine "+state.Name.ValueString(),
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	// The machine itself belongs to its machine catalog, only the delivery group managed by the resource is released
+	deliveryGroup := machine.GetDeliveryGroup()
+	if state.DeliveryGroupId.IsNull() || !strings.EqualFold(deliveryGroup.GetId(), state.DeliveryGroupId.ValueString()) {
+		return
+	}
+
+	err = applyMachineSessionAction(ctx, r.client, &resp.Diagnostics, machine, state.SessionAction.ValueString())
+	if err != nil {
+		return
+	}
+	err = removeMachineFromDeliveryGroup(ctx, r.client, &resp.Diagnostics, deliveryGroup.GetId(), machine.GetId(), machine.GetName())
+	if err != nil {
+		return
+	}
+}
+
+func (r *MachineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to name attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func (r *MachineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	var data MachineResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaType, configValuesForSchema := util.GetConfigValuesForSchema(ctx, &resp.Diagnostics, &data)
+	tflog.Debug(ctx, "Validate Config - "+schemaType, configValuesForSchema)
+}
+
+func (r *MachineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if r.client != nil && r.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan MachineResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMachinePlan(ctx, r.client, &resp.Diagnostics, plan)
+}
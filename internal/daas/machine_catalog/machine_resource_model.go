@@ -0,0 +1,206 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	MachineSessionActionNone       = "None"
+	MachineSessionActionLogoff     = "Logoff"
+	MachineSessionActionDisconnect = "Disconnect"
+)
+
+// MachineResourceModel maps the resource schema data.
+type MachineResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	MachineCatalogId       types.String `tfsdk:"machine_catalog_id"`
+	DeliveryGroupId        types.String `tfsdk:"delivery_group_id"`
+	InMaintenanceMode      types.Bool   `tfsdk:"in_maintenance_mode"`
+	AssignedUsers          types.Set    `tfsdk:"assigned_users"` // Set[string]
+	PublishedName          types.String `tfsdk:"published_name"`
+	HypervisorConnectionId types.String `tfsdk:"hypervisor_connection_id"`
+	HostedMachineId        types.String `tfsdk:"hosted_machine_id"`
+	SessionAction          types.String `tfsdk:"session_action"`
+}
+
+func (MachineResourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		Description: "CVAD --- Manages the lifecycle settings of an existing machine in a machine catalog." +
+			"\n\n~> **Please Note** The machine is not created or deleted by this resource. Only the configured attributes are managed, attributes that are omitted keep their current value on the machine." +
+			"\n\n~> **Please Note** Machines added to a delivery group by this resource are counted in the `machine_count` of the `associated_machine_catalogs` of the `citrix_delivery_group` resource. Include them in `machine_count` when the delivery group is also managed with Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "GUID identifier of the machine.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The Name of the machine. For domain joined machines, the name must be in format <domain>\\<machine> format. Must be all in lowercase.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.LowerCaseRegex), "must be all in lowercase"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"machine_catalog_id": schema.StringAttribute{
+				Description: "The ID of the machine catalog to which the machine belongs.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delivery_group_id": schema.StringAttribute{
+				Description: "The ID of the delivery group of the machine. The machine is removed from the delivery group when the attribute is removed or the resource is destroyed. The delivery group cannot be one created by the `citrix_delivery_group` resource, which manages its machines through `associated_machine_catalogs`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"in_maintenance_mode": schema.BoolAttribute{
+				Description: "Indicates whether the machine is in maintenance mode. A machine in maintenance mode is not available for new sessions.",
+				Optional:    true,
+			},
+			"assigned_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Users assigned to the machine. Only supported for machines of machine catalogs with `Static` allocation type." +
+					"\n\n-> **Note** Users must be in SID, SAM account name (`DOMAIN\\UserName`) or UPN (`user@domain.com`) format.",
+				Optional: true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AlsoRequires(path.MatchRoot("delivery_group_id")),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(util.SamUpnSidOidRegex), "must be in SID, SAM account name or UPN format"),
+					),
+				},
+			},
+			"published_name": schema.StringAttribute{
+				Description: "Name of the machine displayed in Citrix Workspace. Only supported for machines of machine catalogs with `Static` allocation type.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("delivery_group_id")),
+				},
+			},
+			"hypervisor_connection_id": schema.StringAttribute{
+				Description: "The ID of the hypervisor connection used for the power management of the machine. Only supported for machines of machine catalogs with `Manual` provisioning type.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+					stringvalidator.AlsoRequires(path.MatchRoot("hosted_machine_id")),
+				},
+			},
+			"hosted_machine_id": schema.StringAttribute{
+				Description: "The ID by which the hypervisor recognizes the machine. Only supported for machines of machine catalogs with `Manual` provisioning type.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("hypervisor_connection_id")),
+				},
+			},
+			"session_action": schema.StringAttribute{
+				Description: "Action applied to the sessions of the machine when it is put in maintenance mode or removed from its delivery group. Available values are `None`, `Logoff` and `Disconnect`. Defaults to `None`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(MachineSessionActionNone),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						MachineSessionActionNone,
+						MachineSessionActionLogoff,
+						MachineSessionActionDisconnect,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (MachineResourceModel) GetAttributes() map[string]schema.Attribute {
+	return MachineResourceModel{}.GetSchema().Attributes
+}
+
+func (MachineResourceModel) GetAttributesNamesToMask() map[string]bool {
+	return map[string]bool{}
+}
+
+// RefreshPropertyValues only refreshes the optional attributes that are managed by the resource.
+func (r MachineResourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, machine *citrixorchestration.MachineDetailResponseModel) MachineResourceModel {
+	r.Id = types.StringValue(machine.GetId())
+	r.Name = types.StringValue(strings.ToLower(machine.GetName()))
+
+	machineCatalog := machine.GetMachineCatalog()
+	r.MachineCatalogId = types.StringValue(machineCatalog.GetId())
+
+	if !r.DeliveryGroupId.IsNull() {
+		deliveryGroup := machine.GetDeliveryGroup()
+		if deliveryGroupId := deliveryGroup.GetId(); deliveryGroupId != "" {
+			r.DeliveryGroupId = types.StringValue(deliveryGroupId)
+		} else {
+			r.DeliveryGroupId = types.StringNull()
+		}
+	}
+
+	if !r.InMaintenanceMode.IsNull() {
+		r.InMaintenanceMode = types.BoolValue(machine.GetInMaintenanceMode())
+	}
+
+	if !r.AssignedUsers.IsNull() {
+		if len(machine.GetAssignedUsers()) > 0 {
+			r.AssignedUsers = util.RefreshUsersList(ctx, diagnostics, r.AssignedUsers, machine.GetAssignedUsers())
+		} else {
+			r.AssignedUsers = types.SetNull(types.StringType)
+		}
+	}
+
+	if !r.PublishedName.IsNull() {
+		r.PublishedName = types.StringValue(machine.GetPublishedName())
+	}
+
+	hosting := machine.GetHosting()
+	hypervisorConnection := hosting.GetHypervisorConnection()
+	if !r.HypervisorConnectionId.IsNull() {
+		if hypervisorConnectionId := hypervisorConnection.GetId(); hypervisorConnectionId != "" {
+			r.HypervisorConnectionId = types.StringValue(hypervisorConnectionId)
+		} else {
+			r.HypervisorConnectionId = types.StringNull()
+		}
+	}
+	if !r.HostedMachineId.IsNull() {
+		if hostedMachineId := hosting.GetHostedMachineId(); hostedMachineId != "" {
+			r.HostedMachineId = types.StringValue(hostedMachineId)
+		} else {
+			r.HostedMachineId = types.StringNull()
+		}
+	}
+
+	if r.SessionAction.IsNull() {
+		// The default is not set on import
+		r.SessionAction = types.StringValue(MachineSessionActionNone)
+	}
+
+	return r
+}
@@ -0,0 +1,92 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/machine_catalog"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMachineResourceModifyPlanDeliveryGroupOwnership(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeorchestration.NewClient(t)
+
+	catalogId := server.AddObject("MachineCatalogs", map[string]any{"Name": "manual-catalog", "ProvisioningType": "Manual", "AllocationType": "Static"})
+	managedDeliveryGroupId := server.AddObject("DeliveryGroups", map[string]any{
+		"Name":     "managed-group",
+		"Metadata": []map[string]any{{"Name": "ManagedBy", "Value": "Terraform"}},
+	})
+	unmanagedDeliveryGroupId := server.AddObject("DeliveryGroups", map[string]any{"Name": "unmanaged-group"})
+	server.AddObject("Machines", map[string]any{"Name": "vm-1", "MachineCatalog": catalogId, "DeliveryGroup": unmanagedDeliveryGroupId})
+	server.AddObject("Machines", map[string]any{"Name": "vm-2", "MachineCatalog": catalogId, "DeliveryGroup": managedDeliveryGroupId})
+
+	r := &machine_catalog.MachineResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := map[string]struct {
+		machineName     string
+		deliveryGroupId string
+		expectedError   string
+	}{
+		"machine added to a delivery group managed by Terraform": {
+			machineName:     "vm-1",
+			deliveryGroupId: managedDeliveryGroupId,
+			expectedError:   "managed by the citrix_delivery_group resource",
+		},
+		"machine already in a delivery group managed by Terraform": {
+			machineName:     "vm-2",
+			deliveryGroupId: managedDeliveryGroupId,
+		},
+		"machine added to an unmanaged delivery group": {
+			machineName:     "vm-2",
+			deliveryGroupId: unmanagedDeliveryGroupId,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := plan.Set(ctx, machine_catalog.MachineResourceModel{
+				Id:                     types.StringUnknown(),
+				Name:                   types.StringValue(test.machineName),
+				MachineCatalogId:       types.StringValue(catalogId),
+				DeliveryGroupId:        types.StringValue(test.deliveryGroupId),
+				InMaintenanceMode:      types.BoolNull(),
+				AssignedUsers:          types.SetNull(types.StringType),
+				PublishedName:          types.StringNull(),
+				HypervisorConnectionId: types.StringNull(),
+				HostedMachineId:        types.StringNull(),
+				SessionAction:          types.StringValue(machine_catalog.MachineSessionActionNone),
+			})
+			if diags.HasError() {
+				t.Fatalf("error setting the plan: %v", diags)
+			}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+			if test.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), test.expectedError) {
+				t.Errorf("expected an error containing %q, got %v", test.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package machine_catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// applyMachineSettings applies the settings of the plan that differ from the machine. The state is nil during create.
+func applyMachineSettings(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, plan MachineResourceModel, state *MachineResourceModel, machine *citrixorchestration.MachineDetailResponseModel) error {
+	machineName := machine.GetName()
+	machineId := machine.GetId()
+	currentDeliveryGroup := machine.GetDeliveryGroup()
+	currentDeliveryGroupId := currentDeliveryGroup.GetId()
+	assignedUsers := util.StringSetToStringArray(ctx, diagnostics, plan.AssignedUsers)
+
+	if !plan.DeliveryGroupId.IsNull() && !strings.EqualFold(plan.DeliveryGroupId.ValueString(), currentDeliveryGroupId) {
+		if currentDeliveryGroupId != "" {
+			err := applyMachineSessionAction(ctx, client, diagnostics, machine, plan.SessionAction.ValueString())
+			if err != nil {
+				return err
+			}
+			err = removeMachineFromDeliveryGroup(ctx, client, diagnostics, currentDeliveryGroupId, machineId, machineName)
+			if err != nil {
+				return err
+			}
+		}
+		err := addMachineToDeliveryGroup(ctx, client, diagnostics, plan.DeliveryGroupId.ValueString(), plan.MachineCatalogId.ValueString(), machineId, machineName, assignedUsers)
+		if err != nil {
+			return err
+		}
+	} else if !plan.DeliveryGroupId.IsNull() && !plan.AssignedUsers.IsNull() {
+		refreshedUsers := util.RefreshUsersList(ctx, diagnostics, plan.AssignedUsers, machine.GetAssignedUsers())
+		if !refreshedUsers.Equal(plan.AssignedUsers) {
+			err := assignMachineUsers(ctx, client, diagnostics, currentDeliveryGroupId, machineId, machineName, assignedUsers)
+			if err != nil {
+				return err
+			}
+		}
+	} else if plan.DeliveryGroupId.IsNull() && state != nil && !state.DeliveryGroupId.IsNull() && strings.EqualFold(state.DeliveryGroupId.ValueString(), currentDeliveryGroupId) {
+		// The delivery group was managed by the resource and is removed from the configuration
+		err := applyMachineSessionAction(ctx, client, diagnostics, machine, plan.SessionAction.ValueString())
+		if err != nil {
+			return err
+		}
+		err = removeMachineFromDeliveryGroup(ctx, client, diagnostics, currentDeliveryGroupId, machineId, machineName)
+		if err != nil {
+			return err
+		}
+	}
+
+	var body citrixorchestration.UpdateMachineRequestModel
+	updateRequired := false
+	enteringMaintenanceMode := false
+	if !plan.InMaintenanceMode.IsNull() && plan.InMaintenanceMode.ValueBool() != machine.GetInMaintenanceMode() {
+		body.SetInMaintenanceMode(plan.InMaintenanceMode.ValueBool())
+		enteringMaintenanceMode = plan.InMaintenanceMode.ValueBool()
+		updateRequired = true
+	}
+	if !plan.PublishedName.IsNull() && plan.PublishedName.ValueString() != machine.GetPublishedName() {
+		body.SetPublishedName(plan.PublishedName.ValueString())
+		updateRequired = true
+	}
+	hosting := machine.GetHosting()
+	hypervisorConnection := hosting.GetHypervisorConnection()
+	if !plan.HypervisorConnectionId.IsNull() &&
+		(!strings.EqualFold(plan.HypervisorConnectionId.ValueString(), hypervisorConnection.GetId()) || plan.HostedMachineId.ValueString() != hosting.GetHostedMachineId()) {
+		body.SetHypervisorConnection(plan.HypervisorConnectionId.ValueString())
+		body.SetHostedMachineId(plan.HostedMachineId.ValueString())
+		updateRequired = true
+	}
+
+	if updateRequired {
+		err := updateMachine(ctx, client, diagnostics, machineId, machineName, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if enteringMaintenanceMode {
+		return applyMachineSessionAction(ctx, client, diagnostics, machine, plan.SessionAction.ValueString())
+	}
+
+	return nil
+}
+
+// validateMachinePlan checks that the plan does not manage settings that are owned by the machine catalog of the machine,
+// and that the machine is not added to a delivery group managed by the citrix_delivery_group resource.
+func validateMachinePlan(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, plan MachineResourceModel) {
+	if plan.MachineCatalogId.IsUnknown() {
+		return
+	}
+
+	catalog, err := util.GetMachineCatalog(ctx, client, diagnostics, plan.MachineCatalogId.ValueString(), false)
+	if err != nil {
+		// The machine catalog may be created in the same apply
+		return
+	}
+
+	if catalog.GetProvisioningType() != citrixorchestration.PROVISIONINGTYPE_MANUAL && !plan.HypervisorConnectionId.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("hypervisor_connection_id"),
+			"Incorrect Attribute Configuration",
+			fmt.Sprintf("hypervisor_connection_id and hosted_machine_id cannot be configured for machines of machine catalog %s with provisioning type %s. The hosting of the machines is managed by the machine catalog.", catalog.GetName(), catalog.GetProvisioningType()),
+		)
+	}
+
+	if catalog.GetAllocationType() != citrixorchestration.ALLOCATIONTYPE_STATIC {
+		if !plan.AssignedUsers.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("assigned_users"),
+				"Incorrect Attribute Configuration",
+				fmt.Sprintf("assigned_users can only be configured for machines of machine catalogs with allocation type Static. Machine catalog %s has allocation type %s.", catalog.GetName(), catalog.GetAllocationType()),
+			)
+		}
+		if !plan.PublishedName.IsNull() {
+			diagnostics.AddAttributeError(
+				path.Root("published_name"),
+				"Incorrect Attribute Configuration",
+				fmt.Sprintf("published_name can only be configured for machines of machine catalogs with allocation type Static. Machine catalog %s has allocation type %s.", catalog.GetName(), catalog.GetAllocationType()),
+			)
+		}
+	}
+
+	if plan.DeliveryGroupId.IsNull() || plan.DeliveryGroupId.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	// The machine may not exist yet when it is created by its machine catalog in the same apply
+	getMachineRequest := client.ApiClient.MachinesAPIsDAAS.MachinesGetMachine(ctx, strings.ReplaceAll(plan.Name.ValueString(), "\\", "|"))
+	machine, _, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineDetailResponseModel](getMachineRequest, client)
+	if err == nil {
+		currentDeliveryGroup := machine.GetDeliveryGroup()
+		if strings.EqualFold(currentDeliveryGroup.GetId(), plan.DeliveryGroupId.ValueString()) {
+			return
+		}
+	}
+
+	deliveryGroup, err := util.GetDeliveryGroup(ctx, client, &diag.Diagnostics{}, plan.DeliveryGroupId.ValueString())
+	if err != nil {
+		return
+	}
+	if isManagedByTerraform(deliveryGroup.GetMetadata()) {
+		// The citrix_delivery_group resource owns the machines of its delivery group through the machine_count of its
+		// associated_machine_catalogs, and would remove the machine again on its next apply
+		diagnostics.AddAttributeError(
+			path.Root("delivery_group_id"),
+			"Incorrect Attribute Configuration",
+			fmt.Sprintf("Machine %s cannot be added to delivery group %s, which is managed by the citrix_delivery_group resource. Include the machine in the machine_count of machine catalog %s in the associated_machine_catalogs of the delivery group instead.", plan.Name.ValueString(), deliveryGroup.GetName(), catalog.GetName()),
+		)
+	}
+}
+
+// isManagedByTerraform checks whether an object was created by the provider, which sets the ManagedBy metadata.
+func isManagedByTerraform(metadata []citrixorchestration.NameValueStringPairModel) bool {
+	for _, pair := range metadata {
+		if strings.EqualFold(pair.GetName(), util.MetadataTerraformName) && strings.EqualFold(pair.GetValue(), util.MetadataTerrafomValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func updateMachine(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineId string, machineName string, body citrixorchestration.UpdateMachineRequestModel) error {
+	updateMachineRequest := client.ApiClient.MachinesAPIsDAAS.MachinesUpdateMachineCatalogMachine(ctx, machineId)
+	updateMachineRequest = updateMachineRequest.UpdateMachineRequestModel(body)
+	httpResp, err := citrixdaasclient.AddRequestData(updateMachineRequest, client).Async(true).Execute()
+	if err != nil {
+		diagnostics.AddError(
+			"Error updating Machine "+machineName,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error updating Machine "+machineName, diagnostics, 5)
+}
+
+// applyMachineSessionAction logs off or disconnects the sessions of the machine.
+func applyMachineSessionAction(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machine *citrixorchestration.MachineDetailResponseModel, sessionAction string) error {
+	if sessionAction == MachineSessionActionNone || machine.GetSessionCount() == 0 {
+		return nil
+	}
+
+	machineName := machine.GetName()
+	var httpResp *http.Response
+	var err error
+	switch sessionAction {
+	case MachineSessionActionLogoff:
+		logoffRequest := client.ApiClient.MachinesAPIsDAAS.MachinesLogoffMachineSessions(ctx, machine.GetId())
+		_, httpResp, err = citrixdaasclient.AddRequestData(logoffRequest, client).Async(true).Execute()
+	case MachineSessionActionDisconnect:
+		disconnectRequest := client.ApiClient.MachinesAPIsDAAS.MachinesDisconnectSessions(ctx, machine.GetId())
+		_, httpResp, err = citrixdaasclient.AddRequestData(disconnectRequest, client).Async(true).Execute()
+	}
+	errorMessage := fmt.Sprintf("Error applying session action %s to Machine %s", sessionAction, machineName)
+	if err != nil {
+		diagnostics.AddError(
+			errorMessage,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, errorMessage, diagnostics, 5)
+}
+
+func addMachineToDeliveryGroup(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, deliveryGroupId string, machineCatalogId string, machineId string, machineName string, assignedUsers []string) error {
+	var assignMachineToUser citrixorchestration.AssignMachineToUserRequestModel
+	assignMachineToUser.SetMachine(machineId)
+	assignMachineToUser.SetUsers(assignedUsers)
+
+	var addMachinesRequestBody citrixorchestration.DeliveryGroupAddMachinesRequestModel
+	addMachinesRequestBody.SetMachineCatalog(machineCatalogId)
+	addMachinesRequestBody.SetAssignMachinesToUsers([]citrixorchestration.AssignMachineToUserRequestModel{assignMachineToUser})
+
+	addMachinesRequest := client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsDoAddMachines(ctx, deliveryGroupId)
+	addMachinesRequest = addMachinesRequest.DeliveryGroupAddMachinesRequestModel(addMachinesRequestBody)
+	_, httpResp, err := citrixdaasclient.AddRequestData(addMachinesRequest, client).Async(true).Execute()
+	errorMessage := fmt.Sprintf("Error adding Machine %s to Delivery Group %s", machineName, deliveryGroupId)
+	if err != nil {
+		diagnostics.AddError(
+			errorMessage,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, errorMessage, diagnostics, 5)
+}
+
+func assignMachineUsers(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, deliveryGroupId string, machineId string, machineName string, assignedUsers []string) error {
+	var assignMachineToUser citrixorchestration.AssignMachineToUserRequestModel
+	assignMachineToUser.SetMachine(machineId)
+	assignMachineToUser.SetUsers(assignedUsers)
+
+	var editDeliveryGroupRequestBody citrixorchestration.EditDeliveryGroupRequestModel
+	editDeliveryGroupRequestBody.SetAssignMachinesToUsers([]citrixorchestration.AssignMachineToUserRequestModel{assignMachineToUser})
+
+	updateDeliveryGroupRequest := client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsPatchDeliveryGroup(ctx, deliveryGroupId)
+	updateDeliveryGroupRequest = updateDeliveryGroupRequest.EditDeliveryGroupRequestModel(editDeliveryGroupRequestBody)
+	httpResp, err := citrixdaasclient.AddRequestData(updateDeliveryGroupRequest, client).Async(true).Execute()
+	errorMessage := "Error assigning users to Machine " + machineName
+	if err != nil {
+		diagnostics.AddError(
+			errorMessage,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, errorMessage, diagnostics, 5)
+}
+
+func removeMachineFromDeliveryGroup(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, deliveryGroupId string, machineId string, machineName string) error {
+	removeMachineRequest := client.ApiClient.DeliveryGroupsAPIsDAAS.DeliveryGroupsDoRemoveMachines(ctx, deliveryGroupId, machineId)
+	httpResp, err := citrixdaasclient.AddRequestData(removeMachineRequest, client).Async(true).Execute()
+	errorMessage := fmt.Sprintf("Error removing Machine %s from Delivery Group %s", machineName, deliveryGroupId)
+	if err != nil {
+		diagnostics.AddError(
+			errorMessage,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return err
+	}
+
+	return util.ProcessAsyncJobResponse(ctx, client, httpResp, errorMessage, diagnostics, 5)
+}
@@ -106,7 +106,7 @@ func (r *policySetResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	}
 
 	userSettings := []string{}
-	settingDefinitions, err := getGpoUserSettingDefinitions(ctx, &resp.Diagnostics, r.client)
+	settingDefinitions, err := GetSettingDefinitions(ctx, &resp.Diagnostics, r.client, true)
 	if err != nil {
 		return
 	}
@@ -12,6 +12,7 @@ import (
 
 	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -106,7 +107,7 @@ func getPolicySettings(ctx context.Context, client *citrixdaasclient.CitrixDaasC
 func generateBatchApiHeaders(client *citrixdaasclient.CitrixDaasClient) ([]citrixorchestration.NameValueStringPairModel, *http.Response, error) {
 	headers := []citrixorchestration.NameValueStringPairModel{}
 
-	cwsAuthToken, httpResp, err := client.SignIn()
+	cwsAuthToken, httpResp, err := middleware.SignIn(context.Background(), client)
 	var token string
 	if err != nil {
 		return headers, httpResp, err
@@ -842,39 +843,14 @@ func UpdateDeliveryGroupsWithPolicySet(ctx context.Context, diagnostics *diag.Di
 	return nil
 }
 
-func getGpoUserSettingDefinitions(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient) ([]citrixorchestration.SettingDefinition, error) {
-	getSettingDefinitionsRequest := client.ApiClient.GpoDAAS.GpoGetSettingDefinitions(ctx)
-	getSettingDefinitionsRequest = getSettingDefinitionsRequest.IsLean(true)
-	getSettingDefinitionsRequest = getSettingDefinitionsRequest.Limit(-1)
-	getSettingDefinitionsRequest = getSettingDefinitionsRequest.IsUserSetting(true)
-	settingResp, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.SettingDefinitionEnvelope](getSettingDefinitionsRequest, client)
-	if err != nil {
-		diagnostics.AddError(
-			"Unable to fetch user setting definitions",
-			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-				"\nError message: "+util.ReadClientError(err),
-		)
-		return []citrixorchestration.SettingDefinition{}, err
-	}
-	return settingResp.GetItems(), nil
-}
-
 func GetGpoBooleanSettingDefaultValueMap(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient) (map[string]string, error) {
 	defaultValueMap := map[string]string{}
-	getSettingDefinitionsRequest := client.ApiClient.GpoDAAS.GpoGetSettingDefinitions(ctx)
-	getSettingDefinitionsRequest = getSettingDefinitionsRequest.IsLean(true)
-	getSettingDefinitionsRequest = getSettingDefinitionsRequest.Limit(-1)
-	settingResp, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.SettingDefinitionEnvelope](getSettingDefinitionsRequest, client)
+	settingDefinitions, err := GetSettingDefinitions(ctx, diagnostics, client, true)
 	if err != nil {
-		diagnostics.AddError(
-			"Unable to fetch boolean setting definitions",
-			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-				"\nError message: "+util.ReadClientError(err),
-		)
 		return defaultValueMap, err
 	}
-	for _, setting := range settingResp.GetItems() {
-		if setting.GetValueType() == "State" || setting.GetValueType() == "StateAllowed" {
+	for _, setting := range settingDefinitions {
+		if IsBooleanSettingDefinition(setting) {
 			defaultValueMap[setting.GetSettingName()] = setting.GetDefaultValue()
 		}
 	}
@@ -0,0 +1,240 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	PolicySettingScopeUser     = "User"
+	PolicySettingScopeComputer = "Computer"
+
+	maxPolicySettingCloseMatches = 5
+)
+
+// settingDefinitionsCacheDuration is how long the setting definitions fetched by a client are reused. The definitions
+// only change when the delivery controller is upgraded, so they are reused for the whole of most Terraform runs.
+const settingDefinitionsCacheDuration = 15 * time.Minute
+
+// settingDefinitionsCacheKey identifies the setting definitions fetched by a client, with or without their lean parts only.
+type settingDefinitionsCacheKey struct {
+	client *citrixdaasclient.CitrixDaasClient
+	isLean bool
+}
+
+type settingDefinitionsCacheEntry struct {
+	settingDefinitions []citrixorchestration.SettingDefinition
+	expiresAt          time.Time
+}
+
+// settingDefinitionsCache holds the setting definitions fetched by each client. Expired entries are evicted on every
+// lookup, so that the definitions of clients that are no longer used are released.
+var (
+	settingDefinitionsCacheMutex sync.Mutex
+	settingDefinitionsCache      = map[settingDefinitionsCacheKey]settingDefinitionsCacheEntry{}
+)
+
+func getCachedSettingDefinitions(key settingDefinitionsCacheKey) ([]citrixorchestration.SettingDefinition, bool) {
+	settingDefinitionsCacheMutex.Lock()
+	defer settingDefinitionsCacheMutex.Unlock()
+
+	now := time.Now()
+	for cachedKey, entry := range settingDefinitionsCache {
+		if now.After(entry.expiresAt) {
+			delete(settingDefinitionsCache, cachedKey)
+		}
+	}
+	entry, ok := settingDefinitionsCache[key]
+	return entry.settingDefinitions, ok
+}
+
+func setCachedSettingDefinitions(key settingDefinitionsCacheKey, settingDefinitions []citrixorchestration.SettingDefinition) {
+	settingDefinitionsCacheMutex.Lock()
+	defer settingDefinitionsCacheMutex.Unlock()
+
+	settingDefinitionsCache[key] = settingDefinitionsCacheEntry{
+		settingDefinitions: settingDefinitions,
+		expiresAt:          time.Now().Add(settingDefinitionsCacheDuration),
+	}
+}
+
+// GetSettingDefinitions returns the definitions of all the policy settings of the site, for both user and computer settings.
+// Lean definitions only have the name, scope, value type and default value of the settings, which is enough to build
+// policy set requests. The definitions are cached per client.
+func GetSettingDefinitions(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, isLean bool) ([]citrixorchestration.SettingDefinition, error) {
+	key := settingDefinitionsCacheKey{client: client, isLean: isLean}
+	if settingDefinitions, ok := getCachedSettingDefinitions(key); ok {
+		return settingDefinitions, nil
+	}
+
+	getSettingDefinitionsRequest := client.ApiClient.GpoDAAS.GpoGetSettingDefinitions(ctx)
+	getSettingDefinitionsRequest = getSettingDefinitionsRequest.IsLean(isLean)
+	getSettingDefinitionsRequest = getSettingDefinitionsRequest.Limit(-1)
+	settingResp, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.SettingDefinitionEnvelope](getSettingDefinitionsRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Unable to fetch setting definitions",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return []citrixorchestration.SettingDefinition{}, err
+	}
+
+	settingDefinitions := settingResp.GetItems()
+	setCachedSettingDefinitions(key, settingDefinitions)
+	return settingDefinitions, nil
+}
+
+// GetSettingDefinition looks up the definition of a single policy setting by name. Lean definitions are enough to
+// tell whether the setting exists and how its value is set; the full definition is needed to validate the value.
+func GetSettingDefinition(ctx context.Context, diagnostics *diag.Diagnostics, client *citrixdaasclient.CitrixDaasClient, settingName string, isLean bool) (citrixorchestration.SettingDefinition, bool, error) {
+	getSettingDefinitionsRequest := client.ApiClient.GpoDAAS.GpoGetSettingDefinitions(ctx)
+	getSettingDefinitionsRequest = getSettingDefinitionsRequest.NamePattern(settingName)
+	getSettingDefinitionsRequest = getSettingDefinitionsRequest.IsLean(isLean)
+	settingResp, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.SettingDefinitionEnvelope](getSettingDefinitionsRequest, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error fetching setting definitions",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return citrixorchestration.SettingDefinition{}, false, err
+	}
+
+	// The name pattern is a regular expression, so the result can include settings with a similar name
+	settingDefinition, found := FindSettingDefinition(settingResp.GetItems(), settingName)
+	return settingDefinition, found, nil
+}
+
+// FindSettingDefinition returns the definition of the policy setting with the given name.
+func FindSettingDefinition(settingDefinitions []citrixorchestration.SettingDefinition, settingName string) (citrixorchestration.SettingDefinition, bool) {
+	index := slices.IndexFunc(settingDefinitions, func(definition citrixorchestration.SettingDefinition) bool {
+		return strings.EqualFold(definition.GetSettingName(), settingName)
+	})
+	if index < 0 {
+		return citrixorchestration.SettingDefinition{}, false
+	}
+	return settingDefinitions[index], true
+}
+
+// GetUnknownSettingNameError returns the error for a policy setting name that has no definition, listing close matches when available.
+func GetUnknownSettingNameError(settingDefinitions []citrixorchestration.SettingDefinition, settingName string) error {
+	settingNames := []string{}
+	for _, definition := range settingDefinitions {
+		settingNames = append(settingNames, definition.GetSettingName())
+	}
+
+	errorString := fmt.Sprintf("Policy setting %s is not a valid setting name.", settingName)
+	closeMatches := util.FindCloseMatches(settingName, settingNames, maxPolicySettingCloseMatches)
+	if len(closeMatches) > 0 {
+		errorString += fmt.Sprintf(" Did you mean: %s?", strings.Join(closeMatches, ", "))
+	}
+	return fmt.Errorf("%s", errorString)
+}
+
+// IsBooleanSettingDefinition checks whether the policy setting is set with `enabled` rather than `value`.
+func IsBooleanSettingDefinition(settingDefinition citrixorchestration.SettingDefinition) bool {
+	valueType := settingDefinition.GetValueType()
+	return valueType == util.POLICYSETTING_GO_VALUETYPE_STATE || valueType == util.POLICYSETTING_GO_VALUETYPE_STATEALLOWED
+}
+
+// GetSettingDefinitionScope returns whether the policy setting applies to users or computers.
+func GetSettingDefinitionScope(settingDefinition citrixorchestration.SettingDefinition) string {
+	if settingDefinition.GetIsUserSetting() {
+		return PolicySettingScopeUser
+	}
+	return PolicySettingScopeComputer
+}
+
+// GetSettingDefinitionAllowedValues returns the values accepted by an enumeration policy setting.
+func GetSettingDefinitionAllowedValues(settingDefinition citrixorchestration.SettingDefinition) []string {
+	allowedValues := []string{}
+	enumType := settingDefinition.GetEnumType()
+	for _, member := range enumType.GetMembers() {
+		allowedValues = append(allowedValues, member.GetName())
+	}
+	return allowedValues
+}
+
+// GetSettingDefinitionMinimumVdaVersion returns the lowest VDA version that supports the policy setting.
+func GetSettingDefinitionMinimumVdaVersion(settingDefinition citrixorchestration.SettingDefinition) string {
+	versions := settingDefinition.GetVdaVersions()
+	if len(versions) == 0 {
+		for _, versionDetail := range settingDefinition.GetVersionDetails() {
+			if strings.Contains(strings.ToUpper(versionDetail.GetProduct()), "VDA") && versionDetail.GetVersion() != "" {
+				versions = append(versions, versionDetail.GetVersion())
+			}
+		}
+	}
+	if len(versions) == 0 {
+		return ""
+	}
+	return slices.MinFunc(versions, compareVersions)
+}
+
+// compareVersions compares dotted version strings number by number.
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for index := 0; index < max(len(aParts), len(bParts)); index++ {
+		aValue, bValue := 0, 0
+		if index < len(aParts) {
+			aValue, _ = strconv.Atoi(aParts[index])
+		}
+		if index < len(bParts) {
+			bValue, _ = strconv.Atoi(bParts[index])
+		}
+		if aValue != bValue {
+			return aValue - bValue
+		}
+	}
+	return 0
+}
+
+// ValidateSettingValue checks the value of a policy setting with a complex value type against its definition.
+// Enumerations are checked against their members. The definition only has a minimum or maximum value for integer
+// settings, so the value is only checked to be a whole number within those bounds when the definition has them.
+func ValidateSettingValue(settingDefinition citrixorchestration.SettingDefinition, value string) error {
+	settingName := settingDefinition.GetSettingName()
+
+	allowedValues := GetSettingDefinitionAllowedValues(settingDefinition)
+	if len(allowedValues) > 0 {
+		enumType := settingDefinition.GetEnumType()
+		if slices.ContainsFunc(enumType.GetMembers(), func(member citrixorchestration.EnumerationMember) bool {
+			return strings.EqualFold(member.GetName(), value) || (member.Value != nil && strconv.Itoa(int(member.GetValue())) == value)
+		}) {
+			return nil
+		}
+		return fmt.Errorf("value %q of policy setting %s must be one of: %s", value, settingName, strings.Join(allowedValues, ", "))
+	}
+
+	minimumNumber, minimumErr := strconv.ParseInt(settingDefinition.GetValueMinimum(), 10, 64)
+	maximumNumber, maximumErr := strconv.ParseInt(settingDefinition.GetValueMaximum(), 10, 64)
+	if minimumErr != nil && maximumErr != nil {
+		return nil
+	}
+
+	number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return fmt.Errorf("value %q of policy setting %s must be a whole number", value, settingName)
+	}
+	if minimumErr == nil && number < minimumNumber {
+		return fmt.Errorf("value %d of policy setting %s must be at least %d", number, settingName, minimumNumber)
+	}
+	if maximumErr == nil && number > maximumNumber {
+		return fmt.Errorf("value %d of policy setting %s must be at most %d", number, settingName, maximumNumber)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policies
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+)
+
+func TestValidateSettingValue(t *testing.T) {
+	t.Parallel()
+
+	integerDefinition := citrixorchestration.SettingDefinition{}
+	integerDefinition.SetSettingName("ConcurrentLogonsTolerance")
+	integerDefinition.SetValueType("Integer")
+	integerDefinition.SetValueMinimum("1")
+	integerDefinition.SetValueMaximum("100")
+
+	enumDefinition := citrixorchestration.SettingDefinition{}
+	enumDefinition.SetSettingName("AudioQuality")
+	enumDefinition.SetValueType("Enum")
+	enumType := citrixorchestration.EnumerationType{}
+	for index, memberName := range []string{"Low", "Medium", "High"} {
+		member := citrixorchestration.EnumerationMember{}
+		member.SetName(memberName)
+		member.SetValue(int32(index))
+		enumType.Members = append(enumType.Members, member)
+	}
+	enumDefinition.SetEnumType(enumType)
+
+	minimumDefinition := citrixorchestration.SettingDefinition{}
+	minimumDefinition.SetSettingName("SessionReliabilityTimeout")
+	minimumDefinition.SetValueType("Integer")
+	minimumDefinition.SetValueMinimum("60")
+
+	unboundedDefinition := citrixorchestration.SettingDefinition{}
+	unboundedDefinition.SetSettingName("IcaListenerPortNumber")
+	unboundedDefinition.SetValueType("Integer")
+
+	textDefinition := citrixorchestration.SettingDefinition{}
+	textDefinition.SetSettingName("ClipboardSelectionUpdateMode")
+	textDefinition.SetValueType("String")
+
+	tests := map[string]struct {
+		definition    citrixorchestration.SettingDefinition
+		value         string
+		expectedError string
+	}{
+		"integer within range":     {definition: integerDefinition, value: "50"},
+		"integer at the minimum":   {definition: integerDefinition, value: "1"},
+		"integer below the range":  {definition: integerDefinition, value: "0", expectedError: "must be at least 1"},
+		"integer above the range":  {definition: integerDefinition, value: "101", expectedError: "must be at most 100"},
+		"integer is not a number":  {definition: integerDefinition, value: "ten", expectedError: "must be a whole number"},
+		"only a minimum":           {definition: minimumDefinition, value: "59", expectedError: "must be at least 60"},
+		"enum member name":         {definition: enumDefinition, value: "medium"},
+		"enum member value":        {definition: enumDefinition, value: "2"},
+		"enum value is not member": {definition: enumDefinition, value: "Lossless", expectedError: "must be one of: Low, Medium, High"},
+		"integer without bounds":   {definition: unboundedDefinition, value: "anything"},
+		"text is not checked":      {definition: textDefinition, value: "anything"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateSettingValue(test.definition, test.value)
+			if test.expectedError == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+				t.Errorf("expected error containing %q, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestGetSettingDefinitionMinimumVdaVersion(t *testing.T) {
+	t.Parallel()
+
+	versionDetail := func(product string, version string) citrixorchestration.VersionDetail {
+		detail := citrixorchestration.VersionDetail{}
+		detail.SetProduct(product)
+		detail.SetVersion(version)
+		return detail
+	}
+
+	tests := map[string]struct {
+		vdaVersions    []string
+		versionDetails []citrixorchestration.VersionDetail
+		expected       string
+	}{
+		"lowest VDA version is compared by number": {
+			vdaVersions: []string{"7.15", "7.9", "2203"},
+			expected:    "7.9",
+		},
+		"version details of the VDA product": {
+			versionDetails: []citrixorchestration.VersionDetail{versionDetail("Delivery Controller", "7.6"), versionDetail("VDA", "1912")},
+			expected:       "1912",
+		},
+		"no version information": {
+			expected: "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			definition := citrixorchestration.SettingDefinition{VdaVersions: test.vdaVersions, VersionDetails: test.versionDetails}
+			if version := GetSettingDefinitionMinimumVdaVersion(definition); version != test.expected {
+				t.Errorf("expected minimum VDA version %q, got %q", test.expected, version)
+			}
+		})
+	}
+}
+
+func TestFindSettingDefinition(t *testing.T) {
+	t.Parallel()
+
+	definitions := []citrixorchestration.SettingDefinition{}
+	for _, settingName := range []string{"ClientClipboardWriteAllowedFormats", "ClipboardRedirection", "DesktopLaunchForNonAdmins"} {
+		definition := citrixorchestration.SettingDefinition{}
+		definition.SetSettingName(settingName)
+		definitions = append(definitions, definition)
+	}
+
+	if definition, ok := FindSettingDefinition(definitions, "clipboardredirection"); !ok || definition.GetSettingName() != "ClipboardRedirection" {
+		t.Errorf("expected to find ClipboardRedirection regardless of case, got %q", definition.GetSettingName())
+	}
+	if _, ok := FindSettingDefinition(definitions, "ClipboardRedirect"); ok {
+		t.Errorf("expected ClipboardRedirect not to be found")
+	}
+	if err := GetUnknownSettingNameError(definitions, "ClipboardRedirect"); !strings.Contains(err.Error(), "Did you mean: ClipboardRedirection") {
+		t.Errorf("expected the error to suggest ClipboardRedirection, got %v", err)
+	}
+}
+
+func TestSettingDefinitionsCache(t *testing.T) {
+	definition := citrixorchestration.SettingDefinition{}
+	definition.SetSettingName("ClipboardRedirection")
+	expiredKey := settingDefinitionsCacheKey{client: &citrixdaasclient.CitrixDaasClient{}, isLean: true}
+	currentKey := settingDefinitionsCacheKey{client: &citrixdaasclient.CitrixDaasClient{}, isLean: true}
+	t.Cleanup(func() {
+		settingDefinitionsCacheMutex.Lock()
+		defer settingDefinitionsCacheMutex.Unlock()
+		delete(settingDefinitionsCache, expiredKey)
+		delete(settingDefinitionsCache, currentKey)
+	})
+
+	setCachedSettingDefinitions(expiredKey, []citrixorchestration.SettingDefinition{definition})
+	setCachedSettingDefinitions(currentKey, []citrixorchestration.SettingDefinition{definition})
+	settingDefinitionsCacheMutex.Lock()
+	settingDefinitionsCache[expiredKey] = settingDefinitionsCacheEntry{expiresAt: time.Now().Add(-time.Second)}
+	settingDefinitionsCacheMutex.Unlock()
+
+	if definitions, ok := getCachedSettingDefinitions(currentKey); !ok || len(definitions) != 1 {
+		t.Errorf("expected the cached setting definitions of the client, got %v", definitions)
+	}
+	if _, ok := getCachedSettingDefinitions(settingDefinitionsCacheKey{client: currentKey.client, isLean: false}); ok {
+		t.Errorf("expected lean and full setting definitions to be cached separately")
+	}
+
+	settingDefinitionsCacheMutex.Lock()
+	_, expiredCached := settingDefinitionsCache[expiredKey]
+	settingDefinitionsCacheMutex.Unlock()
+	if expiredCached {
+		t.Errorf("expected the expired setting definitions to be evicted")
+	}
+}
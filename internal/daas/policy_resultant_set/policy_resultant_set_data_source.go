@@ -0,0 +1,139 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policy_resultant_set
+
+import (
+	"context"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/policy_set_resource"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &policyResultantSetDataSource{}
+	_ datasource.DataSourceWithConfigure = &policyResultantSetDataSource{}
+)
+
+func NewPolicyResultantSetDataSource() datasource.DataSource {
+	return &policyResultantSetDataSource{}
+}
+
+type policyResultantSetDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *policyResultantSetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_resultant_set"
+}
+
+func (d *policyResultantSetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = PolicyResultantSetDataSourceModel{}.GetSchema()
+}
+
+func (d *policyResultantSetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+func (d *policyResultantSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data PolicyResultantSetDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policySetId, err := getPolicySetIdToModel(ctx, d.client, &resp.Diagnostics, data)
+	if err != nil {
+		return
+	}
+
+	userSid := ""
+	if !data.User.IsNull() {
+		userSid, err = getUserSid(ctx, d.client, &resp.Diagnostics, data.User.ValueString())
+		if err != nil {
+			return
+		}
+	}
+
+	runSimulationRequest := d.client.ApiClient.GpoDAAS.GpoRunSimulation(ctx)
+	runSimulationRequest = runSimulationRequest.PolicySetGuid(policySetId)
+	runSimulationRequest = runSimulationRequest.SimulationRequestContract(data.buildSimulationRequest(ctx, &resp.Diagnostics, userSid))
+	simulationResults, httpResp, err := citrixdaasclient.AddRequestData(runSimulationRequest, d.client).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error modeling policies of Policy Set "+policySetId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+util.ReadClientError(err),
+		)
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, simulationResults)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getPolicySetIdToModel returns the policy set to evaluate: the configured one, the policy set assigned to the delivery
+// group of the session, or the default site policy set.
+func getPolicySetIdToModel(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, data PolicyResultantSetDataSourceModel) (string, error) {
+	if !data.PolicySetId.IsNull() {
+		return data.PolicySetId.ValueString(), nil
+	}
+
+	if !data.DeliveryGroup.IsNull() {
+		deliveryGroup, err := util.GetDeliveryGroup(ctx, client, diagnostics, data.DeliveryGroup.ValueString())
+		if err != nil {
+			return "", err
+		}
+		if deliveryGroup.GetPolicySetGuid() != "" {
+			return deliveryGroup.GetPolicySetGuid(), nil
+		}
+	}
+
+	policySetId, err := policy_set_resource.GetDefaultPolicySetId(ctx, diagnostics, client)
+	if err != nil {
+		if !diagnostics.HasError() {
+			diagnostics.AddError(
+				"Error reading default Policy Set",
+				err.Error(),
+			)
+		}
+		return "", err
+	}
+	return policySetId, nil
+}
+
+// getUserSid resolves the user of the modeled session to its SID.
+func getUserSid(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, user string) (string, error) {
+	if strings.HasPrefix(strings.ToUpper(user), "S-1-") {
+		return user, nil
+	}
+
+	users, _, err := util.GetUsersUsingIdentity(ctx, client, diagnostics, []string{user}, "Error resolving user "+user)
+	if err != nil {
+		return "", err
+	}
+	var identityUser citrixorchestration.IdentityUserResponseModel
+	if len(users) > 0 {
+		identityUser = users[0]
+	}
+	return identityUser.GetSid(), nil
+}
@@ -0,0 +1,263 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policy_resultant_set
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/policies"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PolicyResultantSetDataSourceModel defines the policy resultant set data source implementation.
+type PolicyResultantSetDataSourceModel struct {
+	PolicySetId        types.String                  `tfsdk:"policy_set_id"`
+	User               types.String                  `tfsdk:"user"`
+	GroupSids          types.Set                     `tfsdk:"group_sids"` // Set[string]
+	ClientIp           types.String                  `tfsdk:"client_ip"`
+	ClientName         types.String                  `tfsdk:"client_name"`
+	ClientPlatform     types.String                  `tfsdk:"client_platform"`
+	DeliveryGroup      types.String                  `tfsdk:"delivery_group"`
+	DeliveryGroupType  types.String                  `tfsdk:"delivery_group_type"`
+	Tags               types.Set                     `tfsdk:"tags"` // Set[string]
+	Ou                 types.String                  `tfsdk:"ou"`
+	ComputerName       types.String                  `tfsdk:"computer_name"`
+	AccessGatewayFarm  types.String                  `tfsdk:"access_gateway_farm"`
+	AccessGatewayTags  types.Set                     `tfsdk:"access_gateway_tags"` // Set[string]
+	UseSdWan           types.Bool                    `tfsdk:"use_sd_wan"`
+	Settings           []PolicyResultantSettingModel `tfsdk:"settings"`
+	AppliedPolicies    types.List                    `tfsdk:"applied_policies"`     // List[string]
+	NotAppliedPolicies types.List                    `tfsdk:"not_applied_policies"` // List[string]
+}
+
+func (PolicyResultantSetDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source modeling the policy settings that a user session gets, like the Citrix Studio Modeling Wizard. " +
+			"The policies of the policy set are evaluated against the specified session with their filters and priorities, and each effective setting is returned with the policy it comes from. " +
+			"Filter conditions that are not specified do not match any filter.",
+
+		Attributes: map[string]schema.Attribute{
+			"policy_set_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the policy set to evaluate. Defaults to the policy set of `delivery_group`, or to the default site policy set when no delivery group is specified.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User of the session, in the format `{domain}\\{username}`, `{username}@{domain}` or as a SID. Used to evaluate `citrix_user_policy_filter`.",
+				Optional:            true,
+			},
+			"group_sids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "SIDs of the groups the user belongs to. Used to evaluate `citrix_user_policy_filter` on user groups.",
+				Optional:            true,
+			},
+			"client_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address of the client. Used to evaluate `citrix_client_ip_policy_filter`.",
+				Optional:            true,
+			},
+			"client_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the client. Used to evaluate `citrix_client_name_policy_filter`.",
+				Optional:            true,
+			},
+			"client_platform": schema.StringAttribute{
+				MarkdownDescription: "Platform of the client. Used to evaluate `citrix_client_platform_policy_filter`. Available values are `Windows`, `Linux`, `Mac`, `Ios`, `Android`, and `Html5`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Windows", "Linux", "Mac", "Ios", "Android", "Html5"),
+				},
+			},
+			"delivery_group": schema.StringAttribute{
+				MarkdownDescription: "Id of the delivery group of the session. Used to evaluate `citrix_delivery_group_policy_filter`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(util.GuidRegex), "must be specified with ID in GUID format"),
+				},
+			},
+			"delivery_group_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the delivery group of the session. Used to evaluate `citrix_delivery_group_type_policy_filter`. Possible values are `Private`, `PrivateApp`, `Shared`, and `SharedApp`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Private", "PrivateApp", "Shared", "SharedApp"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Ids of the tags of the machine running the session. Used to evaluate `citrix_tag_policy_filter`.",
+				Optional:            true,
+			},
+			"ou": schema.StringAttribute{
+				MarkdownDescription: "Organizational unit of the machine running the session. Used to evaluate `citrix_ou_policy_filter`.",
+				Optional:            true,
+			},
+			"computer_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the machine running the session.",
+				Optional:            true,
+			},
+			"access_gateway_farm": schema.StringAttribute{
+				MarkdownDescription: "Citrix Gateway farm the session connects through. Used to evaluate `citrix_access_control_policy_filter`. The session is modeled without Citrix Gateway when not specified.",
+				Optional:            true,
+			},
+			"access_gateway_tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Citrix Gateway access conditions of the session. Used to evaluate `citrix_access_control_policy_filter`.",
+				Optional:            true,
+			},
+			"use_sd_wan": schema.BoolAttribute{
+				MarkdownDescription: "Whether the session connects through Citrix SD-WAN. Used to evaluate `citrix_branch_repeater_policy_filter`.",
+				Optional:            true,
+			},
+			"settings": schema.ListNestedAttribute{
+				Description:  "The effective policy settings of the session, with the policy that wins for each setting.",
+				Computed:     true,
+				NestedObject: PolicyResultantSettingModel{}.GetSchema(),
+			},
+			"applied_policies": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Names of the policies that apply to the session.",
+				Computed:    true,
+			},
+			"not_applied_policies": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Names of the policies that do not apply to the session, because their filters do not match or they are disabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// PolicyResultantSettingModel defines a single effective policy setting.
+type PolicyResultantSettingModel struct {
+	Name   types.String `tfsdk:"name"`
+	Value  types.String `tfsdk:"value"`
+	Policy types.String `tfsdk:"policy"`
+	Scope  types.String `tfsdk:"scope"`
+}
+
+func (PolicyResultantSettingModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the policy setting.",
+				Computed:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "Effective value of the policy setting.",
+				Computed:    true,
+			},
+			"policy": schema.StringAttribute{
+				Description: "Name of the policy the effective value comes from.",
+				Computed:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Whether the policy setting applies to `User` or `Computer`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// buildSimulationRequest builds the modeled session from the data source configuration.
+func (r PolicyResultantSetDataSourceModel) buildSimulationRequest(ctx context.Context, diagnostics *diag.Diagnostics, userSid string) citrixorchestration.SimulationRequestContract {
+	simulationRequest := citrixorchestration.SimulationRequestContract{}
+	if userSid != "" {
+		simulationRequest.SetUserSid(userSid)
+	}
+	if !r.GroupSids.IsNull() {
+		simulationRequest.SetGroupSids(util.StringSetToStringArray(ctx, diagnostics, r.GroupSids))
+	}
+	if !r.ClientIp.IsNull() {
+		simulationRequest.SetClientIpAddress(r.ClientIp.ValueString())
+	}
+	if !r.ClientName.IsNull() {
+		simulationRequest.SetClientName(r.ClientName.ValueString())
+	}
+	if !r.ClientPlatform.IsNull() {
+		simulationRequest.SetClientPlatform(r.ClientPlatform.ValueString())
+	}
+	if !r.DeliveryGroup.IsNull() {
+		simulationRequest.SetDeliveryGroupGuid(r.DeliveryGroup.ValueString())
+	}
+	if !r.DeliveryGroupType.IsNull() {
+		simulationRequest.SetDeliveryGroupType(r.DeliveryGroupType.ValueString())
+	}
+	if !r.Tags.IsNull() {
+		simulationRequest.SetTags(util.StringSetToStringArray(ctx, diagnostics, r.Tags))
+	}
+	if !r.Ou.IsNull() {
+		simulationRequest.SetComputerOu(r.Ou.ValueString())
+	}
+	if !r.ComputerName.IsNull() {
+		simulationRequest.SetComputerName(r.ComputerName.ValueString())
+	}
+	simulationRequest.SetIsUsingAccessGateway(!r.AccessGatewayFarm.IsNull())
+	if !r.AccessGatewayFarm.IsNull() {
+		simulationRequest.SetAccessGatewayFarm(r.AccessGatewayFarm.ValueString())
+	}
+	if !r.AccessGatewayTags.IsNull() {
+		simulationRequest.SetAccessGatewayTags(util.StringSetToStringArray(ctx, diagnostics, r.AccessGatewayTags))
+	}
+	simulationRequest.SetIsUsingWanScaler(r.UseSdWan.ValueBool())
+	return simulationRequest
+}
+
+// RefreshPropertyValues maps the user and computer results of the simulation. Settings are sorted by name, and a policy
+// is listed once even when it applies to both users and computers.
+func (r PolicyResultantSetDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, simulationResults []citrixorchestration.SimulationResponseContract) PolicyResultantSetDataSourceModel {
+	settings := []PolicyResultantSettingModel{}
+	appliedPolicies := []string{}
+	notAppliedPolicies := []string{}
+	appendPolicy := func(policyNames []string, policyName string) []string {
+		if policyName == "" || slices.ContainsFunc(policyNames, func(existing string) bool { return strings.EqualFold(existing, policyName) }) {
+			return policyNames
+		}
+		return append(policyNames, policyName)
+	}
+
+	for _, simulationResult := range simulationResults {
+		scope := policies.PolicySettingScopeComputer
+		if simulationResult.GetIsUserRsop() {
+			scope = policies.PolicySettingScopeUser
+		}
+
+		for _, appliedSetting := range simulationResult.GetAppliedSettings() {
+			settings = append(settings, PolicyResultantSettingModel{
+				Name:   types.StringValue(appliedSetting.GetSettingName()),
+				Value:  types.StringValue(appliedSetting.GetSettingValue()),
+				Policy: types.StringValue(appliedSetting.GetPolicyName()),
+				Scope:  types.StringValue(scope),
+			})
+		}
+		for _, appliedPolicy := range simulationResult.GetAppliedPolicies() {
+			appliedPolicies = appendPolicy(appliedPolicies, appliedPolicy.GetPolicyName())
+		}
+		for _, losingPolicy := range simulationResult.GetLosingPolicies() {
+			notAppliedPolicies = appendPolicy(notAppliedPolicies, losingPolicy.GetPolicyName())
+		}
+	}
+
+	// A policy applied to users or computers is not reported as not applied
+	notAppliedPolicies = slices.DeleteFunc(notAppliedPolicies, func(policyName string) bool {
+		return slices.ContainsFunc(appliedPolicies, func(appliedPolicy string) bool { return strings.EqualFold(appliedPolicy, policyName) })
+	})
+
+	slices.SortStableFunc(settings, func(a, b PolicyResultantSettingModel) int {
+		return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	r.Settings = settings
+	r.AppliedPolicies = util.StringArrayToStringList(ctx, diagnostics, appliedPolicies)
+	r.NotAppliedPolicies = util.StringArrayToStringList(ctx, diagnostics, notAppliedPolicies)
+	return r
+}
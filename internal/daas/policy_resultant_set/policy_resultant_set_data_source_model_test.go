@@ -0,0 +1,73 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policy_resultant_set
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func newTestSimulationResult(isUserRsop bool, appliedSettings map[string]string, winningPolicy string, appliedPolicies []string, losingPolicies []string) citrixorchestration.SimulationResponseContract {
+	simulationResult := citrixorchestration.SimulationResponseContract{}
+	simulationResult.SetIsUserRsop(isUserRsop)
+	for settingName, settingValue := range appliedSettings {
+		appliedSetting := citrixorchestration.AppliedSetting{}
+		appliedSetting.SetSettingName(settingName)
+		appliedSetting.SetSettingValue(settingValue)
+		appliedSetting.SetPolicyName(winningPolicy)
+		simulationResult.AppliedSettings = append(simulationResult.AppliedSettings, appliedSetting)
+	}
+	for _, policyName := range appliedPolicies {
+		appliedPolicy := citrixorchestration.AppliedPolicy{}
+		appliedPolicy.SetPolicyName(policyName)
+		simulationResult.AppliedPolicies = append(simulationResult.AppliedPolicies, appliedPolicy)
+	}
+	for _, policyName := range losingPolicies {
+		losingPolicy := citrixorchestration.LosingPolicy{}
+		losingPolicy.SetPolicyName(policyName)
+		simulationResult.LosingPolicies = append(simulationResult.LosingPolicies, losingPolicy)
+	}
+	return simulationResult
+}
+
+func TestPolicyResultantSetRefreshPropertyValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	diagnostics := diag.Diagnostics{}
+	simulationResults := []citrixorchestration.SimulationResponseContract{
+		newTestSimulationResult(true, map[string]string{"ClipboardRedirection": "Prohibited", "ClientDriveRedirection": "Prohibited"}, "Finance", []string{"Finance", "Unfiltered"}, []string{"Contractors"}),
+		newTestSimulationResult(false, map[string]string{"ConcurrentLogonsTolerance": "4"}, "Unfiltered", []string{"Unfiltered"}, []string{"Finance", "Contractors"}),
+	}
+
+	data := PolicyResultantSetDataSourceModel{}.RefreshPropertyValues(ctx, &diagnostics, simulationResults)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	settings := []string{}
+	for _, setting := range data.Settings {
+		settings = append(settings, setting.Name.ValueString()+"="+setting.Value.ValueString()+" from "+setting.Policy.ValueString()+" ("+setting.Scope.ValueString()+")")
+	}
+	expectedSettings := []string{
+		"ClientDriveRedirection=Prohibited from Finance (User)",
+		"ClipboardRedirection=Prohibited from Finance (User)",
+		"ConcurrentLogonsTolerance=4 from Unfiltered (Computer)",
+	}
+	if !reflect.DeepEqual(settings, expectedSettings) {
+		t.Errorf("expected settings %v, got %v", expectedSettings, settings)
+	}
+
+	if appliedPolicies := util.StringListToStringArray(ctx, &diagnostics, data.AppliedPolicies); !reflect.DeepEqual(appliedPolicies, []string{"Finance", "Unfiltered"}) {
+		t.Errorf("expected applied policies [Finance Unfiltered], got %v", appliedPolicies)
+	}
+	// Finance only applies to users, it is not reported as not applied because it does not apply to computers
+	if notAppliedPolicies := util.StringListToStringArray(ctx, &diagnostics, data.NotAppliedPolicies); !reflect.DeepEqual(notAppliedPolicies, []string{"Contractors"}) {
+		t.Errorf("expected not applied policies [Contractors], got %v", notAppliedPolicies)
+	}
+}
@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &policySetV2Resource{}
 	_ resource.ResourceWithConfigure      = &policySetV2Resource{}
 	_ resource.ResourceWithImportState    = &policySetV2Resource{}
+	_ resource.ResourceWithIdentity       = &policySetV2Resource{}
 	_ resource.ResourceWithValidateConfig = &policySetV2Resource{}
 	_ resource.ResourceWithModifyPlan     = &policySetV2Resource{}
 )
@@ -44,6 +45,11 @@ func (r *policySetV2Resource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = PolicySetV2Model{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *policySetV2Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 func (r *policySetV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
 
@@ -117,6 +123,7 @@ func (r *policySetV2Resource) Create(ctx context.Context, req resource.CreateReq
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -124,6 +131,7 @@ func (r *policySetV2Resource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *policySetV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from state
 	var state PolicySetV2Model
@@ -167,6 +175,7 @@ func (r *policySetV2Resource) Read(ctx context.Context, req resource.ReadRequest
 
 func (r *policySetV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan PolicySetV2Model
@@ -318,7 +327,7 @@ func (r *policySetV2Resource) Configure(_ context.Context, req resource.Configur
 
 func (r *policySetV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *policySetV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -0,0 +1,67 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policy_set_resource
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/policies"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &policySetV2ListResource{}
+	_ list.ListResourceWithConfigure = &policySetV2ListResource{}
+)
+
+// NewPolicySetV2ListResource is a helper function to simplify the provider implementation.
+func NewPolicySetV2ListResource() list.ListResource {
+	return &policySetV2ListResource{}
+}
+
+// policySetV2ListResource is the list resource implementation.
+type policySetV2ListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *policySetV2ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_set_v2"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *policySetV2ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("policy sets")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *policySetV2ListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the policy sets of the site.
+func (r *policySetV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	policySets, _ := policies.GetPolicySets(ctx, r.client, &diags)
+	items := []util.ListResourceItem{}
+	for _, policySet := range policySets {
+		items = append(items, util.ListResourceItem{Id: policySet.GetPolicySetGuid(), DisplayName: policySet.GetName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewPolicySetV2Resource, r.client)
+}
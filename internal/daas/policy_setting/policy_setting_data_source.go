@@ -87,13 +87,13 @@ func (d *policySettingDataSource) Read(ctx context.Context, req datasource.ReadR
 		}
 	}
 
-	settingsDefinition, err := getSettingsDefinitions(ctx, d.client, &resp.Diagnostics, policySetting.GetSettingName())
+	settingDefinition, err := getPolicySettingDefinition(ctx, d.client, &resp.Diagnostics, policySetting.GetSettingName())
 	if err != nil {
 		return // error already added to diagnostics
 	}
 
 	// Refresh values
-	data = data.RefreshPropertyValues(policySetting, settingsDefinition)
+	data = data.RefreshPropertyValues(policySetting, settingDefinition)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
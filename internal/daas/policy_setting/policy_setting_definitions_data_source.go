@@ -0,0 +1,84 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policy_setting
+
+import (
+	"context"
+	"regexp"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/policies"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &policySettingDefinitionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &policySettingDefinitionsDataSource{}
+)
+
+func NewPolicySettingDefinitionsDataSource() datasource.DataSource {
+	return &policySettingDefinitionsDataSource{}
+}
+
+type policySettingDefinitionsDataSource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+func (d *policySettingDefinitionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_setting_definitions"
+}
+
+func (d *policySettingDefinitionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = PolicySettingDefinitionsDataSourceModel{}.GetSchema()
+}
+
+func (d *policySettingDefinitionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+func (d *policySettingDefinitionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer util.PanicHandler(&resp.Diagnostics)
+
+	if d.client != nil && d.client.ApiClient == nil {
+		resp.Diagnostics.AddError(util.ProviderInitializationErrorMsg, util.MissingProviderClientIdAndSecretErrorMsg)
+		return
+	}
+
+	var data PolicySettingDefinitionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"name_regex must be a valid regular expression. Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	settingDefinitions, err := policies.GetSettingDefinitions(ctx, &resp.Diagnostics, d.client, false)
+	if err != nil {
+		return
+	}
+
+	data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, data.filterSettingDefinitions(settingDefinitions, nameRegex))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
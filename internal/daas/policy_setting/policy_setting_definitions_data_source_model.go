@@ -0,0 +1,163 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package policy_setting
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/policies"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PolicySettingDefinitionsDataSourceModel defines the policy setting definitions data source implementation.
+type PolicySettingDefinitionsDataSourceModel struct {
+	NameRegex          types.String                   `tfsdk:"name_regex"`
+	Scope              types.String                   `tfsdk:"scope"`
+	Category           types.String                   `tfsdk:"category"`
+	SettingDefinitions []PolicySettingDefinitionModel `tfsdk:"setting_definitions"`
+}
+
+func (PolicySettingDefinitionsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the definitions of the policy settings available in the site. " +
+			"The filters are optional and are combined, only setting definitions matching every specified filter are returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression to filter the setting definitions by setting name.",
+				Optional:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the policy settings. Choose between `User` and `Computer`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(policies.PolicySettingScopeUser, policies.PolicySettingScopeComputer),
+				},
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Category of the policy settings, for example `ICA\\Audio`.",
+				Optional:            true,
+			},
+			"setting_definitions": schema.ListNestedAttribute{
+				Description:  "The definitions of the policy settings matching the specified filters.",
+				Computed:     true,
+				NestedObject: PolicySettingDefinitionModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// PolicySettingDefinitionModel defines the single policy setting definition data model implementation.
+type PolicySettingDefinitionModel struct {
+	Name              types.String `tfsdk:"name"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	Category          types.String `tfsdk:"category"`
+	Scope             types.String `tfsdk:"scope"`
+	ValueType         types.String `tfsdk:"value_type"`
+	AllowedValues     types.List   `tfsdk:"allowed_values"` // List[string]
+	DefaultValue      types.String `tfsdk:"default_value"`
+	ValueMinimum      types.String `tfsdk:"value_minimum"`
+	ValueMaximum      types.String `tfsdk:"value_maximum"`
+	MinimumVdaVersion types.String `tfsdk:"minimum_vda_version"`
+}
+
+func (PolicySettingDefinitionModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the policy setting, as used in the `name` of the `citrix_policy_setting` resource.",
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name of the policy setting.",
+				Computed:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Category of the policy setting.",
+				Computed:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Whether the policy setting applies to `User` or `Computer`.",
+				Computed:    true,
+			},
+			"value_type": schema.StringAttribute{
+				Description: "Value type of the policy setting. Settings of type `State` and `StateAllowed` are set with `enabled`, all other settings are set with `value`.",
+				Computed:    true,
+			},
+			"allowed_values": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Values accepted by the policy setting when its value is an enumeration. Empty for other value types.",
+				Computed:    true,
+			},
+			"default_value": schema.StringAttribute{
+				Description: "Default value of the policy setting.",
+				Computed:    true,
+			},
+			"value_minimum": schema.StringAttribute{
+				Description: "Minimum value of the policy setting, when the value is a number.",
+				Computed:    true,
+			},
+			"value_maximum": schema.StringAttribute{
+				Description: "Maximum value of the policy setting, when the value is a number.",
+				Computed:    true,
+			},
+			"minimum_vda_version": schema.StringAttribute{
+				Description: "Lowest VDA version that supports the policy setting. Empty when the setting does not depend on the VDA version.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r PolicySettingDefinitionsDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, settingDefinitions []citrixorchestration.SettingDefinition) PolicySettingDefinitionsDataSourceModel {
+	res := []PolicySettingDefinitionModel{}
+	for _, settingDefinition := range settingDefinitions {
+		res = append(res, PolicySettingDefinitionModel{}.RefreshPropertyValues(ctx, diagnostics, settingDefinition))
+	}
+
+	r.SettingDefinitions = res
+
+	return r
+}
+
+func (r PolicySettingDefinitionModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, settingDefinition citrixorchestration.SettingDefinition) PolicySettingDefinitionModel {
+	r.Name = types.StringValue(settingDefinition.GetSettingName())
+	r.DisplayName = types.StringValue(settingDefinition.GetDisplayName())
+	r.Category = types.StringValue(settingDefinition.GetCategory())
+	r.Scope = types.StringValue(policies.GetSettingDefinitionScope(settingDefinition))
+	r.ValueType = types.StringValue(settingDefinition.GetValueType())
+	r.AllowedValues = util.StringArrayToStringList(ctx, diagnostics, policies.GetSettingDefinitionAllowedValues(settingDefinition))
+	r.DefaultValue = types.StringValue(settingDefinition.GetDefaultValue())
+	r.ValueMinimum = types.StringValue(settingDefinition.GetValueMinimum())
+	r.ValueMaximum = types.StringValue(settingDefinition.GetValueMaximum())
+	r.MinimumVdaVersion = types.StringValue(policies.GetSettingDefinitionMinimumVdaVersion(settingDefinition))
+
+	return r
+}
+
+// filterSettingDefinitions returns the setting definitions matching all filters specified in the data source configuration.
+func (r PolicySettingDefinitionsDataSourceModel) filterSettingDefinitions(settingDefinitions []citrixorchestration.SettingDefinition, nameRegex *regexp.Regexp) []citrixorchestration.SettingDefinition {
+	filtered := []citrixorchestration.SettingDefinition{}
+	for _, settingDefinition := range settingDefinitions {
+		if nameRegex != nil && !nameRegex.MatchString(settingDefinition.GetSettingName()) {
+			continue
+		}
+		if !r.Scope.IsNull() && !strings.EqualFold(policies.GetSettingDefinitionScope(settingDefinition), r.Scope.ValueString()) {
+			continue
+		}
+		if !r.Category.IsNull() && !strings.EqualFold(settingDefinition.GetCategory(), r.Category.ValueString()) {
+			continue
+		}
+		filtered = append(filtered, settingDefinition)
+	}
+	return filtered
+}
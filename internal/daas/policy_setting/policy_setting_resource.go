@@ -7,11 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 
+	"github.com/citrix/terraform-provider-citrix/internal/daas/policies"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -88,12 +88,12 @@ func (r *policySettingResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	settingDefinitions, err := getSettingsDefinitions(ctx, r.client, &resp.Diagnostics, policySetting.GetSettingName())
+	settingDefinition, err := getPolicySettingDefinition(ctx, r.client, &resp.Diagnostics, policySetting.GetSettingName())
 	if err != nil {
 		return
 	}
 
-	plan = plan.RefreshPropertyValues(policySetting, settingDefinitions)
+	plan = plan.RefreshPropertyValues(policySetting, settingDefinition)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -126,12 +126,12 @@ func (r *policySettingResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	settingsDefinition, err := getSettingsDefinitions(ctx, r.client, &resp.Diagnostics, policySetting.GetSettingName())
+	settingDefinition, err := getPolicySettingDefinition(ctx, r.client, &resp.Diagnostics, policySetting.GetSettingName())
 	if err != nil {
 		return
 	}
 
-	state = state.RefreshPropertyValues(policySetting, settingsDefinition)
+	state = state.RefreshPropertyValues(policySetting, settingDefinition)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -160,12 +160,12 @@ func (r *policySettingResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	settingsDefinition, err := getSettingsDefinitions(ctx, r.client, &resp.Diagnostics, policySetting.GetSettingName())
+	settingDefinition, err := getPolicySettingDefinition(ctx, r.client, &resp.Diagnostics, policySetting.GetSettingName())
 	if err != nil {
 		return
 	}
 
-	plan = plan.RefreshPropertyValues(policySetting, settingsDefinition)
+	plan = plan.RefreshPropertyValues(policySetting, settingDefinition)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -214,6 +214,12 @@ func (r *policySettingResource) ValidateConfig(ctx context.Context, req resource
 
 	schemaType, configValuesForSchema := util.GetConfigValuesForSchema(ctx, &resp.Diagnostics, &data)
 	tflog.Debug(ctx, "Validate Config - "+schemaType, configValuesForSchema)
+
+	// Setting definitions can only be fetched once the provider is configured
+	if r.client == nil || r.client.ApiClient == nil {
+		return
+	}
+	validatePolicySettingAgainstDefinition(ctx, r.client, &resp.Diagnostics, data)
 }
 
 func (r *policySettingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -236,53 +242,77 @@ func (r *policySettingResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return
 	}
 
-	// Validation for policy settings with complex value types
-	hasComplexValueType := false
-	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
-		settingName := plan.Name.ValueString()
+	// Values that were unknown during ValidateConfig are known by now
+	validatePolicySettingAgainstDefinition(ctx, r.client, &resp.Diagnostics, plan)
+}
+
+// validatePolicySettingAgainstDefinition checks the policy setting against the setting definitions of the site. The setting name
+// must exist, boolean settings must use `enabled` while other settings use `value`, and the value must be in range for
+// whole numbers and one of the members for enumerations.
+func validatePolicySettingAgainstDefinition(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, policySetting PolicySettingModel) {
+	if policySetting.Name.IsNull() || policySetting.Name.IsUnknown() {
+		return
+	}
+	settingName := policySetting.Name.ValueString()
 
-		// Get setting definitions from API to check value type
-		settingDefinitions, err := getSettingsDefinitions(ctx, r.client, &resp.Diagnostics, settingName)
+	// The full definition is only fetched when there is a value to check against its bounds or enumeration members
+	isLean := policySetting.Value.IsNull() || policySetting.Value.IsUnknown()
+	settingDefinition, found, err := policies.GetSettingDefinition(ctx, diagnostics, client, settingName, isLean)
+	if err != nil {
+		return
+	}
+	if !found {
+		// The names of all the settings are only needed to suggest close matches
+		settingDefinitions, err := policies.GetSettingDefinitions(ctx, diagnostics, client, true)
 		if err != nil {
 			return
 		}
+		diagnostics.AddAttributeError(
+			path.Root("name"),
+			fmt.Sprintf("Invalid configuration for %s", settingName),
+			policies.GetUnknownSettingNameError(settingDefinitions, settingName).Error(),
+		)
+		return
+	}
 
-		validSettingName := false
-		// Check if this setting has a complex value type
-		for _, definition := range settingDefinitions.GetItems() {
-			if strings.EqualFold(definition.GetSettingName(), settingName) {
-				valueType := definition.GetValueType()
-				validSettingName = true
-				if valueType != util.POLICYSETTING_GO_VALUETYPE_STATE && valueType != util.POLICYSETTING_GO_VALUETYPE_STATEALLOWED {
-					hasComplexValueType = true
-				}
-				break
-			}
-		}
-		if !validSettingName {
-			resp.Diagnostics.AddError(
-				fmt.Sprintf("Invalid configuration for %s", settingName),
-				fmt.Sprintf("Policy setting %s is not a valid setting name.", settingName),
-			)
-			return
-		}
+	hasComplexValueType := !policies.IsBooleanSettingDefinition(settingDefinition)
+	if hasComplexValueType && !policySetting.Enabled.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("enabled"),
+			"Invalid configuration for policy setting",
+			fmt.Sprintf("Policy setting %s has a complex value type. Use the 'value' field instead of 'enabled'.", settingName),
+		)
+		return
+	}
 
-		if hasComplexValueType && !plan.Enabled.IsNull() {
-			resp.Diagnostics.AddError(
-				"Invalid configuration for policy setting",
-				fmt.Sprintf("Policy setting %s has a complex value type. Use the 'value' field instead of 'enabled'.", settingName),
-			)
-			return
-		}
+	if !hasComplexValueType && !policySetting.Value.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid configuration for policy setting",
+			fmt.Sprintf("Policy setting %s has a boolean value type. Use the 'enabled' field instead of 'value'.", settingName),
+		)
+		return
+	}
 
-		if !hasComplexValueType && !plan.Value.IsNull() {
-			resp.Diagnostics.AddError(
-				"Invalid configuration for policy setting",
-				fmt.Sprintf("Policy setting %s has a boolean value type. Use the 'enabled' field instead of 'value'.", settingName),
-			)
-			return
-		}
+	if policySetting.Value.IsNull() || policySetting.Value.IsUnknown() {
+		return
+	}
+	if err := policies.ValidateSettingValue(settingDefinition, policySetting.Value.ValueString()); err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid configuration for policy setting",
+			err.Error(),
+		)
+	}
+}
+
+// getPolicySettingDefinition returns the lean definition of a policy setting, or nil when the site has no definition for it.
+func getPolicySettingDefinition(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, settingName string) (*citrixorchestration.SettingDefinition, error) {
+	settingDefinition, found, err := policies.GetSettingDefinition(ctx, diagnostics, client, settingName, true)
+	if err != nil || !found {
+		return nil, err
 	}
+	return &settingDefinition, nil
 }
 
 func getPolicySetting(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, policySettingId string) (*citrixorchestration.SettingResponse, error) {
@@ -325,21 +355,3 @@ func updatePolicySetting(ctx context.Context, client *citrixdaasclient.CitrixDaa
 	}
 	return nil
 }
-
-func getSettingsDefinitions(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, settingName string) (*citrixorchestration.SettingDefinitionEnvelope, error) {
-	getSettingDefinitionsReq := client.ApiClient.GpoDAAS.GpoGetSettingDefinitions(ctx)
-	getSettingDefinitionsReq = getSettingDefinitionsReq.NamePattern(settingName)
-	getSettingDefinitionsReq = getSettingDefinitionsReq.IsLean(true)
-	settingDefinitions, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.SettingDefinitionEnvelope](getSettingDefinitionsReq, client)
-	if err != nil {
-		diagnostics.AddError(
-			"Error fetching setting definitions",
-			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
-				"\nError message: "+util.ReadClientError(err),
-		)
-
-		return nil, err
-	}
-
-	return settingDefinitions, nil
-}
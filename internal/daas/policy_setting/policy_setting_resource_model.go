@@ -126,22 +126,13 @@ func buildSettingRequest(ctx context.Context, client *citrixdaasclient.CitrixDaa
 	return settingRequest, nil
 }
 
-func (r PolicySettingModel) RefreshPropertyValues(policySetting *citrixorchestration.SettingResponse, settingsDefinitions *citrixorchestration.SettingDefinitionEnvelope) PolicySettingModel {
+func (r PolicySettingModel) RefreshPropertyValues(policySetting *citrixorchestration.SettingResponse, settingDefinition *citrixorchestration.SettingDefinition) PolicySettingModel {
 	r.Id = types.StringValue(policySetting.GetSettingGuid())
 	r.PolicyId = types.StringValue(policySetting.GetPolicyGuid())
 	r.Name = types.StringValue(policySetting.GetSettingName())
 	r.UseDefault = types.BoolValue(policySetting.GetUseDefault())
 
-	hasComplexValueType := false
-	for _, definition := range settingsDefinitions.GetItems() {
-		if strings.EqualFold(definition.GetSettingName(), policySetting.GetSettingName()) {
-			valueType := definition.GetValueType()
-			if valueType != util.POLICYSETTING_GO_VALUETYPE_STATE && valueType != util.POLICYSETTING_GO_VALUETYPE_STATEALLOWED {
-				hasComplexValueType = true
-			}
-			break
-		}
-	}
+	hasComplexValueType := settingDefinition != nil && !policies.IsBooleanSettingDefinition(*settingDefinition)
 
 	if !policySetting.GetUseDefault() {
 		settingValue := policySetting.GetSettingValue()
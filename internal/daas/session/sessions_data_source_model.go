@@ -0,0 +1,241 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package session
+
+import (
+	"strings"
+	"time"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SessionsDataSourceModel defines the sessions data source implementation.
+type SessionsDataSourceModel struct {
+	DeliveryGroup  types.String   `tfsdk:"delivery_group"`
+	MachineCatalog types.String   `tfsdk:"machine_catalog"`
+	User           types.String   `tfsdk:"user"`
+	State          types.String   `tfsdk:"state"`
+	MinIdleMinutes types.Int64    `tfsdk:"min_idle_minutes"`
+	Sessions       []SessionModel `tfsdk:"sessions"`
+}
+
+func (SessionsDataSourceModel) GetSchema() schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CVAD --- Data source for the list of user sessions in the site. All filters are optional and are combined, only sessions matching every specified filter are returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"delivery_group": schema.StringAttribute{
+				MarkdownDescription: "Name or Id of the delivery group the session machines belong to.",
+				Optional:            true,
+			},
+			"machine_catalog": schema.StringAttribute{
+				MarkdownDescription: "Name or Id of the machine catalog the session machines belong to.",
+				Optional:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The user of the sessions. Can be specified as `{domain}\\{username}`, user principal name or SID.",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the sessions. Choose between `Connected`, `Active`, `Disconnected` and `Unknown`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(citrixorchestration.SESSIONSTATE_CONNECTED),
+						string(citrixorchestration.SESSIONSTATE_ACTIVE),
+						string(citrixorchestration.SESSIONSTATE_DISCONNECTED),
+						string(citrixorchestration.SESSIONSTATE_UNKNOWN),
+					),
+				},
+			},
+			"min_idle_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Only return sessions whose state has not changed for at least the specified number of minutes. " +
+					"-> **Note** The idle time is measured from the last session state change, for example the time a session was disconnected.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"sessions": schema.ListNestedAttribute{
+				Description:  "The sessions matching the specified filters.",
+				Computed:     true,
+				NestedObject: SessionModel{}.GetSchema(),
+			},
+		},
+	}
+}
+
+// SessionModel defines the single session data model implementation.
+type SessionModel struct {
+	Id              types.String `tfsdk:"id"`
+	MachineId       types.String `tfsdk:"machine_id"`
+	MachineName     types.String `tfsdk:"machine_name"`
+	MachineCatalog  types.String `tfsdk:"machine_catalog"`
+	DeliveryGroup   types.String `tfsdk:"delivery_group"`
+	UserName        types.String `tfsdk:"user_name"`
+	UserSid         types.String `tfsdk:"user_sid"`
+	ClientName      types.String `tfsdk:"client_name"`
+	ClientAddress   types.String `tfsdk:"client_address"`
+	ClientPlatform  types.String `tfsdk:"client_platform"`
+	ClientVersion   types.String `tfsdk:"client_version"`
+	Protocol        types.String `tfsdk:"protocol"`
+	SessionType     types.String `tfsdk:"session_type"`
+	StartTime       types.String `tfsdk:"start_time"`
+	State           types.String `tfsdk:"state"`
+	StateChangeTime types.String `tfsdk:"state_change_time"`
+}
+
+func (SessionModel) GetSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Id of the session.",
+				Computed:    true,
+			},
+			"machine_id": schema.StringAttribute{
+				Description: "Id of the machine hosting the session.",
+				Computed:    true,
+			},
+			"machine_name": schema.StringAttribute{
+				Description: "Name of the machine hosting the session.",
+				Computed:    true,
+			},
+			"machine_catalog": schema.StringAttribute{
+				Description: "Id of the machine catalog of the machine hosting the session.",
+				Computed:    true,
+			},
+			"delivery_group": schema.StringAttribute{
+				Description: "Id of the delivery group of the machine hosting the session.",
+				Computed:    true,
+			},
+			"user_name": schema.StringAttribute{
+				Description: "Name of the session user in the format `{domain}\\{username}`.",
+				Computed:    true,
+			},
+			"user_sid": schema.StringAttribute{
+				Description: "SID of the session user.",
+				Computed:    true,
+			},
+			"client_name": schema.StringAttribute{
+				Description: "Name of the client device the session is connected from.",
+				Computed:    true,
+			},
+			"client_address": schema.StringAttribute{
+				Description: "IP address of the client device the session is connected from.",
+				Computed:    true,
+			},
+			"client_platform": schema.StringAttribute{
+				Description: "Platform of the client device the session is connected from.",
+				Computed:    true,
+			},
+			"client_version": schema.StringAttribute{
+				Description: "Version of the Citrix Workspace app the session is connected with.",
+				Computed:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Description: "Protocol of the session connection.",
+				Computed:    true,
+			},
+			"session_type": schema.StringAttribute{
+				Description: "Type of the session.",
+				Computed:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "Time the session was started.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the session.",
+				Computed:    true,
+			},
+			"state_change_time": schema.StringAttribute{
+				Description: "Time the session state last changed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r SessionsDataSourceModel) RefreshPropertyValues(sessions []citrixorchestration.SessionResponseModel) SessionsDataSourceModel {
+	res := []SessionModel{}
+	for _, session := range sessions {
+		machine := session.GetMachine()
+		machineCatalog := machine.GetMachineCatalog()
+		deliveryGroup := machine.GetDeliveryGroup()
+		user := session.GetUser()
+		client := session.GetClient()
+		connection := session.GetConnection()
+
+		res = append(res, SessionModel{
+			Id:              types.StringValue(session.GetId()),
+			MachineId:       types.StringValue(machine.GetId()),
+			MachineName:     types.StringValue(machine.GetName()),
+			MachineCatalog:  types.StringValue(machineCatalog.GetId()),
+			DeliveryGroup:   types.StringValue(deliveryGroup.GetId()),
+			UserName:        types.StringValue(user.GetSamName()),
+			UserSid:         types.StringValue(user.GetSid()),
+			ClientName:      types.StringValue(client.GetName()),
+			ClientAddress:   types.StringValue(client.GetIPAddress()),
+			ClientPlatform:  types.StringValue(client.GetPlatform()),
+			ClientVersion:   types.StringValue(client.GetVersion()),
+			Protocol:        types.StringValue(string(connection.GetProtocol())),
+			SessionType:     types.StringValue(string(session.GetSessionType())),
+			StartTime:       types.StringValue(session.GetStartTime()),
+			State:           types.StringValue(string(session.GetState())),
+			StateChangeTime: types.StringValue(session.GetStateChangeTime()),
+		})
+	}
+
+	r.Sessions = res
+
+	return r
+}
+
+// filterSessions returns the sessions matching all filters specified in the data source configuration.
+func (r SessionsDataSourceModel) filterSessions(sessions []citrixorchestration.SessionResponseModel, now time.Time) []citrixorchestration.SessionResponseModel {
+	filtered := []citrixorchestration.SessionResponseModel{}
+	for _, session := range sessions {
+		machine := session.GetMachine()
+		if !r.DeliveryGroup.IsNull() && !refMatches(machine.GetDeliveryGroup(), r.DeliveryGroup.ValueString()) {
+			continue
+		}
+		if !r.MachineCatalog.IsNull() && !refMatches(machine.GetMachineCatalog(), r.MachineCatalog.ValueString()) {
+			continue
+		}
+		if !r.User.IsNull() && !sessionUserMatches(session, r.User.ValueString()) {
+			continue
+		}
+		if !r.State.IsNull() && !strings.EqualFold(string(session.GetState()), r.State.ValueString()) {
+			continue
+		}
+		if !r.MinIdleMinutes.IsNull() {
+			stateChangeTime, err := time.Parse(time.RFC3339, session.GetStateChangeTime())
+			if err != nil || now.Sub(stateChangeTime) < time.Duration(r.MinIdleMinutes.ValueInt64())*time.Minute {
+				continue
+			}
+		}
+		filtered = append(filtered, session)
+	}
+	return filtered
+}
+
+func refMatches(ref citrixorchestration.RefResponseModel, nameOrId string) bool {
+	return strings.EqualFold(ref.GetId(), nameOrId) || strings.EqualFold(ref.GetName(), nameOrId)
+}
+
+func sessionUserMatches(session citrixorchestration.SessionResponseModel, user string) bool {
+	sessionUser := session.GetUser()
+	brokering := session.GetBrokering()
+	for _, identity := range []string{sessionUser.GetSamName(), sessionUser.GetPrincipalName(), sessionUser.GetSid(), brokering.GetUserName(), brokering.GetUserSid()} {
+		if identity != "" && strings.EqualFold(identity, user) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package session_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/session"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func addTestSession(server *fakeorchestration.Server, id string, catalog string, deliveryGroup string, user string, state string, stateChangeTime time.Time) {
+	server.AddObject("Sessions", map[string]any{
+		"Id": id,
+		"Machine": map[string]any{
+			"Id":             "machine-" + id,
+			"Name":           "DOMAIN\\vm-" + id,
+			"MachineCatalog": map[string]any{"Id": catalog + "-id", "Name": catalog},
+			"DeliveryGroup":  map[string]any{"Id": deliveryGroup + "-id", "Name": deliveryGroup},
+		},
+		"User":            map[string]any{"SamName": user},
+		"State":           state,
+		"StateChangeTime": stateChangeTime.UTC().Format(time.RFC3339),
+	})
+}
+
+func TestSessionsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeorchestration.NewClient(t)
+
+	now := time.Now()
+	addTestSession(server, "1", "catalog-a", "group-a", "DOMAIN\\alice", "Active", now.Add(-5*time.Minute))
+	addTestSession(server, "2", "catalog-a", "group-b", "DOMAIN\\bob", "Disconnected", now.Add(-2*time.Hour))
+	addTestSession(server, "3", "catalog-b", "group-b", "DOMAIN\\alice", "Disconnected", now.Add(-30*time.Minute))
+
+	d := &session.SessionsDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &datasource.ConfigureResponse{})
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	tests := map[string]struct {
+		filter   session.SessionsDataSourceModel
+		expected []string
+	}{
+		"no filter": {
+			filter:   session.SessionsDataSourceModel{},
+			expected: []string{"1", "2", "3"},
+		},
+		"machine catalog by name": {
+			filter:   session.SessionsDataSourceModel{MachineCatalog: types.StringValue("CATALOG-A")},
+			expected: []string{"1", "2"},
+		},
+		"delivery group by id and user": {
+			filter:   session.SessionsDataSourceModel{DeliveryGroup: types.StringValue("group-b-id"), User: types.StringValue("domain\\alice")},
+			expected: []string{"3"},
+		},
+		"disconnected sessions idle for an hour": {
+			filter:   session.SessionsDataSourceModel{State: types.StringValue("Disconnected"), MinIdleMinutes: types.Int64Value(60)},
+			expected: []string{"2"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The configuration is built through a state, which accepts a model with computed attributes
+			configState := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := configState.Set(ctx, test.filter); diags.HasError() {
+				t.Fatalf("error setting the configuration: %v", diags)
+			}
+
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data session.SessionsDataSourceModel
+			if diags := resp.State.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("error reading the state: %v", diags)
+			}
+			actual := []string{}
+			for _, s := range data.Sessions {
+				actual = append(actual, s.Id.ValueString())
+				if s.MachineCatalog.ValueString() == "" || s.DeliveryGroup.ValueString() == "" {
+					t.Errorf("expected the machine catalog and delivery group of session %s to be set", s.Id.ValueString())
+				}
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected sessions %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package tags
+
+import (
+	"context"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ list.ListResource              = &tagListResource{}
+	_ list.ListResourceWithConfigure = &tagListResource{}
+)
+
+// NewTagListResource is a helper function to simplify the provider implementation.
+func NewTagListResource() list.ListResource {
+	return &tagListResource{}
+}
+
+// tagListResource is the list resource implementation.
+type tagListResource struct {
+	client *citrixdaasclient.CitrixDaasClient
+}
+
+// Metadata returns the list resource type name.
+func (r *tagListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+// ListResourceConfigSchema defines the schema of the list resource config.
+func (r *tagListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = util.ListResourceNameFilterModel{}.GetSchema("tags")
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *tagListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*citrixdaasclient.CitrixDaasClient) //nolint:forcetypeassert // framework guarantee
+}
+
+// List streams the tags of the site.
+func (r *tagListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	var config util.ListResourceNameFilterModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tags, _ := util.GetTags(ctx, r.client, &diags, "Id,Name")
+	items := []util.ListResourceItem{}
+	for _, tag := range tags {
+		items = append(items, util.ListResourceItem{Id: tag.GetId(), DisplayName: tag.GetName()})
+	}
+
+	util.StreamListResults(ctx, diags, req, stream, config.NameRegex, items, NewTagResource, r.client)
+}
@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                   = &TagResource{}
 	_ resource.ResourceWithConfigure      = &TagResource{}
 	_ resource.ResourceWithImportState    = &TagResource{}
+	_ resource.ResourceWithIdentity       = &TagResource{}
 	_ resource.ResourceWithValidateConfig = &TagResource{}
 	_ resource.ResourceWithModifyPlan     = &TagResource{}
 )
@@ -47,6 +48,11 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	resp.Schema = TagResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *TagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *TagResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -103,6 +109,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -111,6 +118,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 // Read implements resource.Resource.
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state TagResourceModel
@@ -139,6 +147,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 // Update implements resource.Resource.
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan TagResourceModel
@@ -219,7 +228,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *TagResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -4,13 +4,18 @@ package vda
 
 import (
 	"context"
+	"regexp"
 
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
+// VdaMachineFields are the machine fields read for the VDAs of a machine catalog
+const VdaMachineFields = "Id,Name,Hosting,MachineCatalog,DeliveryGroup,RegistrationState,PowerState,InMaintenanceMode,AgentVersion,OSType,OSVersion,LoadIndex,SessionCount,LastDeregistrationReason,LastDeregistrationTime,Zone,Tags"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ datasource.DataSource              = &VdaDataSource{}
@@ -60,16 +65,30 @@ func (d *VdaDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	var machineNameRegex *regexp.Regexp
+	if !data.MachineNameRegex.IsNull() {
+		var err error
+		machineNameRegex, err = regexp.Compile(data.MachineNameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("machine_name_regex"),
+				"Invalid Machine Name Regex",
+				"machine_name_regex must be a valid regular expression. Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Get refreshed machine catalog state from Orchestration
 	machineCatalogId := data.MachineCatalog.ValueString()
 	if machineCatalogId != "" {
-		machineCatalogVdas, err := util.GetMachineCatalogMachines(ctx, d.client, &resp.Diagnostics, machineCatalogId)
+		machineCatalogVdas, err := util.GetMachineCatalogMachinesWithFields(ctx, d.client, &resp.Diagnostics, machineCatalogId, VdaMachineFields)
 
 		if err != nil {
 			return
 		}
 
-		data = data.RefreshPropertyValues(machineCatalogVdas)
+		data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, data.filterVdas(machineCatalogVdas, machineNameRegex))
 	}
 
 	deliveryGroupId := data.DeliveryGroup.ValueString()
@@ -79,7 +98,7 @@ func (d *VdaDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 			return
 		}
 
-		data = data.RefreshPropertyValues(deliveryGroupVdas)
+		data = data.RefreshPropertyValues(ctx, &resp.Diagnostics, data.filterVdas(deliveryGroupVdas, machineNameRegex))
 	}
 
 	// Save data into Terraform state
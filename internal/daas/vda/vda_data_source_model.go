@@ -3,9 +3,16 @@
 package vda
 
 import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,15 +20,22 @@ import (
 
 // VdaDataSourceModel defines the VDA data source implementation.
 type VdaDataSourceModel struct {
-	MachineCatalog types.String `tfsdk:"machine_catalog"`
-	DeliveryGroup  types.String `tfsdk:"delivery_group"`
-	Vdas           []VdaModel   `tfsdk:"vdas"`
+	MachineCatalog    types.String `tfsdk:"machine_catalog"`
+	DeliveryGroup     types.String `tfsdk:"delivery_group"`
+	MachineNameRegex  types.String `tfsdk:"machine_name_regex"`
+	RegistrationState types.String `tfsdk:"registration_state"`
+	PowerState        types.String `tfsdk:"power_state"`
+	InMaintenanceMode types.Bool   `tfsdk:"in_maintenance_mode"`
+	Zone              types.String `tfsdk:"zone"`
+	Tag               types.String `tfsdk:"tag"`
+	Vdas              []VdaModel   `tfsdk:"vdas"`
 }
 
 func (VdaDataSourceModel) GetSchema() schema.Schema {
 	return schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "CVAD --- Data source for the list of VDAs that belong to either a machine catalog or a delivery group. Machine catalog and delivery group cannot be specified at the same time.",
+		MarkdownDescription: "CVAD --- Data source for the list of VDAs that belong to either a machine catalog or a delivery group. Machine catalog and delivery group cannot be specified at the same time. " +
+			"The remaining filters are optional and are combined, only VDAs matching every specified filter are returned.",
 
 		Attributes: map[string]schema.Attribute{
 			"machine_catalog": schema.StringAttribute{
@@ -35,6 +49,39 @@ func (VdaDataSourceModel) GetSchema() schema.Schema {
 				MarkdownDescription: "The delivery group which the VDAs are associated with.",
 				Optional:            true,
 			},
+			"machine_name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression to filter the VDAs by machine name. The machine name is in the format `{domain}\\{machine name}`.",
+				Optional:            true,
+			},
+			"registration_state": schema.StringAttribute{
+				MarkdownDescription: "Registration state of the VDAs. Choose between `Registered`, `Unregistered`, `Initializing`, `AgentError` and `Unknown`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(citrixorchestration.REGISTRATIONSTATE_REGISTERED),
+						string(citrixorchestration.REGISTRATIONSTATE_UNREGISTERED),
+						string(citrixorchestration.REGISTRATIONSTATE_INITIALIZING),
+						string(citrixorchestration.REGISTRATIONSTATE_AGENT_ERROR),
+						string(citrixorchestration.REGISTRATIONSTATE_UNKNOWN),
+					),
+				},
+			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "Power state of the VDAs, for example `On`, `Off` or `Suspended`.",
+				Optional:            true,
+			},
+			"in_maintenance_mode": schema.BoolAttribute{
+				MarkdownDescription: "Whether the VDAs are in maintenance mode.",
+				Optional:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Name or Id of the zone of the VDAs.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Name of a tag the VDAs should have.",
+				Optional:            true,
+			},
 			"vdas": schema.ListNestedAttribute{
 				Description:  "The VDAs associated with the specified machine catalog or delivery group.",
 				Computed:     true,
@@ -51,6 +98,18 @@ type VdaModel struct {
 	HostedMachineId          types.String `tfsdk:"hosted_machine_id"`
 	AssociatedMachineCatalog types.String `tfsdk:"associated_machine_catalog"`
 	AssociatedDeliveryGroup  types.String `tfsdk:"associated_delivery_group"`
+	RegistrationState        types.String `tfsdk:"registration_state"`
+	PowerState               types.String `tfsdk:"power_state"`
+	InMaintenanceMode        types.Bool   `tfsdk:"in_maintenance_mode"`
+	AgentVersion             types.String `tfsdk:"agent_version"`
+	OsType                   types.String `tfsdk:"os_type"`
+	OsVersion                types.String `tfsdk:"os_version"`
+	LoadIndex                types.Int64  `tfsdk:"load_index"`
+	SessionCount             types.Int64  `tfsdk:"session_count"`
+	LastDeregistrationReason types.String `tfsdk:"last_deregistration_reason"`
+	LastDeregistrationTime   types.String `tfsdk:"last_deregistration_time"`
+	Zone                     types.String `tfsdk:"zone"`
+	Tags                     types.Set    `tfsdk:"tags"` // Set[string]
 }
 
 func (VdaModel) GetSchema() schema.NestedAttributeObject {
@@ -76,31 +135,129 @@ func (VdaModel) GetSchema() schema.NestedAttributeObject {
 				Description: "Delivery group which the VDA is associated with.",
 				Computed:    true,
 			},
+			"registration_state": schema.StringAttribute{
+				Description: "Registration state of the VDA.",
+				Computed:    true,
+			},
+			"power_state": schema.StringAttribute{
+				Description: "Power state of the VDA.",
+				Computed:    true,
+			},
+			"in_maintenance_mode": schema.BoolAttribute{
+				Description: "Whether the VDA is in maintenance mode.",
+				Computed:    true,
+			},
+			"agent_version": schema.StringAttribute{
+				Description: "Version of the VDA agent installed on the machine.",
+				Computed:    true,
+			},
+			"os_type": schema.StringAttribute{
+				Description: "Operating system type of the VDA.",
+				Computed:    true,
+			},
+			"os_version": schema.StringAttribute{
+				Description: "Operating system version of the VDA.",
+				Computed:    true,
+			},
+			"load_index": schema.Int64Attribute{
+				Description: "Current load index of the VDA, between `0` and `10000`. Only reported for multi-session VDAs.",
+				Computed:    true,
+			},
+			"session_count": schema.Int64Attribute{
+				Description: "Number of sessions on the VDA.",
+				Computed:    true,
+			},
+			"last_deregistration_reason": schema.StringAttribute{
+				Description: "Reason the VDA last deregistered.",
+				Computed:    true,
+			},
+			"last_deregistration_time": schema.StringAttribute{
+				Description: "Time the VDA last deregistered.",
+				Computed:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "Id of the zone the VDA belongs to.",
+				Computed:    true,
+			},
+			"tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "Tags associated with the VDA.",
+				Computed:    true,
+			},
 		},
 	}
 }
 
-func (r VdaDataSourceModel) RefreshPropertyValues(vdas []citrixorchestration.MachineResponseModel) VdaDataSourceModel {
+func (r VdaDataSourceModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, vdas []citrixorchestration.MachineResponseModel) VdaDataSourceModel {
 	res := []VdaModel{}
 	for _, model := range vdas {
-		machineName := model.GetName()
-		hosting := model.GetHosting()
-		hostedMachineId := hosting.GetHostedMachineId()
-		machineCatalog := model.GetMachineCatalog()
-		machineCatalogId := machineCatalog.GetId()
-		deliveryGroup := model.GetDeliveryGroup()
-		deliveryGroupId := deliveryGroup.GetId()
-
-		res = append(res, VdaModel{
-			Id:                       types.StringValue(model.GetId()),
-			MachineName:              types.StringValue(machineName),
-			HostedMachineId:          types.StringValue(hostedMachineId),
-			AssociatedMachineCatalog: types.StringValue(machineCatalogId),
-			AssociatedDeliveryGroup:  types.StringValue(deliveryGroupId),
-		})
+		res = append(res, VdaModel{}.RefreshPropertyValues(ctx, diagnostics, model))
 	}
 
 	r.Vdas = res
 
 	return r
 }
+
+// RefreshPropertyValues maps a single machine returned by Orchestration to the VDA model shared by the VDA, machine catalog and delivery group data sources.
+func (r VdaModel) RefreshPropertyValues(ctx context.Context, diagnostics *diag.Diagnostics, model citrixorchestration.MachineResponseModel) VdaModel {
+	hosting := model.GetHosting()
+	machineCatalog := model.GetMachineCatalog()
+	deliveryGroup := model.GetDeliveryGroup()
+	zone := model.GetZone()
+
+	loadIndex := types.Int64Null()
+	if model.LoadIndex.IsSet() && model.LoadIndex.Get() != nil {
+		loadIndex = types.Int64Value(int64(model.GetLoadIndex()))
+	}
+
+	r.Id = types.StringValue(model.GetId())
+	r.MachineName = types.StringValue(model.GetName())
+	r.HostedMachineId = types.StringValue(hosting.GetHostedMachineId())
+	r.AssociatedMachineCatalog = types.StringValue(machineCatalog.GetId())
+	r.AssociatedDeliveryGroup = types.StringValue(deliveryGroup.GetId())
+	r.RegistrationState = types.StringValue(string(model.GetRegistrationState()))
+	r.PowerState = types.StringValue(string(model.GetPowerState()))
+	r.InMaintenanceMode = types.BoolValue(model.GetInMaintenanceMode())
+	r.AgentVersion = types.StringValue(model.GetAgentVersion())
+	r.OsType = types.StringValue(model.GetOSType())
+	r.OsVersion = types.StringValue(model.GetOSVersion())
+	r.LoadIndex = loadIndex
+	r.SessionCount = types.Int64Value(int64(model.GetSessionCount()))
+	r.LastDeregistrationReason = types.StringValue(string(model.GetLastDeregistrationReason()))
+	r.LastDeregistrationTime = types.StringValue(model.GetLastDeregistrationTime())
+	r.Zone = types.StringValue(zone.GetId())
+	r.Tags = util.StringArrayToStringSet(ctx, diagnostics, model.GetTags())
+
+	return r
+}
+
+// filterVdas returns the VDAs matching all filters specified in the data source configuration.
+func (r VdaDataSourceModel) filterVdas(vdas []citrixorchestration.MachineResponseModel, machineNameRegex *regexp.Regexp) []citrixorchestration.MachineResponseModel {
+	filtered := []citrixorchestration.MachineResponseModel{}
+	for _, vda := range vdas {
+		if machineNameRegex != nil && !machineNameRegex.MatchString(vda.GetName()) {
+			continue
+		}
+		if !r.RegistrationState.IsNull() && !strings.EqualFold(string(vda.GetRegistrationState()), r.RegistrationState.ValueString()) {
+			continue
+		}
+		if !r.PowerState.IsNull() && !strings.EqualFold(string(vda.GetPowerState()), r.PowerState.ValueString()) {
+			continue
+		}
+		if !r.InMaintenanceMode.IsNull() && vda.GetInMaintenanceMode() != r.InMaintenanceMode.ValueBool() {
+			continue
+		}
+		if !r.Zone.IsNull() {
+			zone := vda.GetZone()
+			if !strings.EqualFold(zone.GetId(), r.Zone.ValueString()) && !strings.EqualFold(zone.GetName(), r.Zone.ValueString()) {
+				continue
+			}
+		}
+		if !r.Tag.IsNull() && !slices.ContainsFunc(vda.GetTags(), func(tag string) bool { return strings.EqualFold(tag, r.Tag.ValueString()) }) {
+			continue
+		}
+		filtered = append(filtered, vda)
+	}
+	return filtered
+}
@@ -0,0 +1,116 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package vda_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/citrix/terraform-provider-citrix/internal/daas/vda"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVdaDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	server, client := fakeorchestration.NewClient(t)
+
+	zoneAId := server.AddObject("Zones", map[string]any{"Name": "zone-a"})
+	zoneBId := server.AddObject("Zones", map[string]any{"Name": "zone-b"})
+	catalogId := server.AddObject("MachineCatalogs", map[string]any{"Name": "catalog", "Zone": zoneAId})
+	otherCatalogId := server.AddObject("MachineCatalogs", map[string]any{"Name": "other-catalog", "Zone": zoneAId})
+	deliveryGroupId := server.AddObject("DeliveryGroups", map[string]any{"Name": "group"})
+	addVda := func(name string, catalog string, registrationState string, inMaintenanceMode bool, zone string, tags []string) {
+		server.AddObject("Machines", map[string]any{
+			"Name":              name,
+			"MachineCatalog":    catalog,
+			"DeliveryGroup":     deliveryGroupId,
+			"RegistrationState": registrationState,
+			"PowerState":        "On",
+			"InMaintenanceMode": inMaintenanceMode,
+			"Zone":              zone,
+			"Tags":              tags,
+		})
+	}
+	addVda("DOMAIN\\web-1", catalogId, "Registered", false, zoneAId, []string{"web"})
+	addVda("DOMAIN\\web-2", catalogId, "Unregistered", true, zoneAId, []string{"web", "canary"})
+	addVda("DOMAIN\\db-1", catalogId, "Unregistered", false, zoneBId, []string{})
+	addVda("DOMAIN\\other-1", otherCatalogId, "Registered", false, zoneAId, []string{})
+
+	d := &vda.VdaDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &datasource.ConfigureResponse{})
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	tests := map[string]struct {
+		filter        vda.VdaDataSourceModel
+		expected      []string
+		expectedError string
+	}{
+		"machine catalog": {
+			filter:   vda.VdaDataSourceModel{MachineCatalog: types.StringValue(catalogId)},
+			expected: []string{"DOMAIN\\web-1", "DOMAIN\\web-2", "DOMAIN\\db-1"},
+		},
+		"delivery group": {
+			filter:   vda.VdaDataSourceModel{DeliveryGroup: types.StringValue(deliveryGroupId)},
+			expected: []string{"DOMAIN\\web-1", "DOMAIN\\web-2", "DOMAIN\\db-1", "DOMAIN\\other-1"},
+		},
+		"machine name regex": {
+			filter:   vda.VdaDataSourceModel{MachineCatalog: types.StringValue(catalogId), MachineNameRegex: types.StringValue(`\\web-\d+$`)},
+			expected: []string{"DOMAIN\\web-1", "DOMAIN\\web-2"},
+		},
+		"registration state and maintenance mode": {
+			filter:   vda.VdaDataSourceModel{MachineCatalog: types.StringValue(catalogId), RegistrationState: types.StringValue("unregistered"), InMaintenanceMode: types.BoolValue(false)},
+			expected: []string{"DOMAIN\\db-1"},
+		},
+		"zone by name and tag": {
+			filter:   vda.VdaDataSourceModel{DeliveryGroup: types.StringValue(deliveryGroupId), Zone: types.StringValue("zone-a"), Tag: types.StringValue("Canary")},
+			expected: []string{"DOMAIN\\web-2"},
+		},
+		"invalid machine name regex": {
+			filter:        vda.VdaDataSourceModel{MachineCatalog: types.StringValue(catalogId), MachineNameRegex: types.StringValue("web-(")},
+			expectedError: "Invalid Machine Name Regex",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The configuration is built through a state, which accepts a model with computed attributes
+			configState := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := configState.Set(ctx, test.filter); diags.HasError() {
+				t.Fatalf("error setting the configuration: %v", diags)
+			}
+
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, resp)
+			if test.expectedError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != test.expectedError {
+					t.Errorf("expected error %q, got %v", test.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data vda.VdaDataSourceModel
+			if diags := resp.State.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("error reading the state: %v", diags)
+			}
+			actual := []string{}
+			for _, v := range data.Vdas {
+				actual = append(actual, v.MachineName.ValueString())
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected VDAs %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
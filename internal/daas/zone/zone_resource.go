@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &zoneResource{}
 	_ resource.ResourceWithConfigure      = &zoneResource{}
 	_ resource.ResourceWithImportState    = &zoneResource{}
+	_ resource.ResourceWithIdentity       = &zoneResource{}
 	_ resource.ResourceWithValidateConfig = &zoneResource{}
 	_ resource.ResourceWithModifyPlan     = &zoneResource{}
 )
@@ -46,6 +47,11 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = ZoneResourceModel{}.GetSchema()
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *zoneResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = util.GetIdIdentitySchema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *zoneResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -119,6 +125,7 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 			diags = resp.State.Set(ctx, plan)
 			resp.Diagnostics.Append(diags...)
+			util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 		} else {
 			resp.Diagnostics.AddError(
 				"Error creating Zone",
@@ -167,6 +174,7 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, resp.State, resp.Identity)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -175,6 +183,7 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 // Read refreshes the Terraform state with the latest data.
 func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Get current state
 	var state ZoneResourceModel
@@ -203,6 +212,7 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	util.SetResourceIdentityFromState(ctx, &resp.Diagnostics, req.State, resp.Identity)
 
 	// Retrieve values from plan
 	var plan ZoneResourceModel
@@ -305,7 +315,7 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *zoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *zoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -0,0 +1,235 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package drift_report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	citrixprovider "github.com/citrix/terraform-provider-citrix/internal/provider"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Options configures a drift report.
+type Options struct {
+	// StateFile is the Terraform state to compare against, as written by `terraform state pull`.
+	StateFile string
+	// JsonReportFile is the path the JSON report is written to. The JSON report is not written when empty.
+	JsonReportFile string
+	// JunitReportFile is the path the JUnit report is written to. The JUnit report is not written when empty.
+	JunitReportFile string
+}
+
+// terraformState is the subset of the Terraform state file format read by the drift report.
+type terraformState struct {
+	Version   int                      `json:"version"`
+	Resources []terraformStateResource `json:"resources"`
+}
+
+type terraformStateResource struct {
+	Module    string                           `json:"module"`
+	Mode      string                           `json:"mode"`
+	Type      string                           `json:"type"`
+	Name      string                           `json:"name"`
+	Provider  string                           `json:"provider"`
+	Instances []terraformStateResourceInstance `json:"instances"`
+}
+
+type terraformStateResourceInstance struct {
+	IndexKey      any             `json:"index_key"`
+	SchemaVersion int64           `json:"schema_version"`
+	Attributes    json.RawMessage `json:"attributes"`
+}
+
+// Run reads every Citrix resource of the Terraform state with the Read implementation of its resource, compares the
+// refreshed values with the values recorded in the state and writes the differences as JSON and JUnit reports.
+// The provider is configured with the same environment variables as the provider blocks.
+func Run(ctx context.Context, version string, options Options) (*Report, error) {
+	stateContent, err := os.ReadFile(options.StateFile)
+	if err != nil {
+		return nil, err
+	}
+	var state terraformState
+	if err := json.Unmarshal(stateContent, &state); err != nil {
+		return nil, fmt.Errorf("error parsing Terraform state %s: %w", options.StateFile, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported Terraform state version %d, expected version 4", state.Version)
+	}
+
+	p := citrixprovider.NewStandalone(version)()
+	providerData, err := configureProvider(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataResp := &provider.MetadataResponse{}
+	p.Metadata(ctx, provider.MetadataRequest{}, metadataResp)
+	newResources := map[string]func() resource.Resource{}
+	for _, newResource := range p.Resources(ctx) {
+		newResources[util.GetResourceTypeName(ctx, metadataResp.TypeName, newResource())] = newResource
+	}
+
+	report := newReport()
+	for _, stateResource := range state.Resources {
+		if stateResource.Mode != "managed" || !isCitrixProviderAddress(stateResource.Provider) {
+			continue
+		}
+
+		newResource, ok := newResources[stateResource.Type]
+		if !ok {
+			continue
+		}
+
+		for _, instance := range stateResource.Instances {
+			result := checkResourceInstance(ctx, providerData, newResource, stateResource, instance)
+			report.addResult(result)
+		}
+	}
+
+	if options.JsonReportFile != "" {
+		if err := report.writeJson(options.JsonReportFile); err != nil {
+			return nil, err
+		}
+	}
+	if options.JunitReportFile != "" {
+		if err := report.writeJunit(options.JunitReportFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// isCitrixProviderAddress checks whether the provider address of a state resource, such as
+// `provider["registry.terraform.io/citrix/citrix"].alias`, is the Citrix provider. The address may be prefixed with
+// the module the provider is configured in and suffixed with the alias of the provider configuration.
+func isCitrixProviderAddress(providerAddress string) bool {
+	_, source, found := strings.Cut(providerAddress, `provider["`)
+	if !found {
+		return false
+	}
+	source, _, found = strings.Cut(source, `"]`)
+	if !found {
+		return false
+	}
+	sourceParts := strings.Split(source, "/")
+	if len(sourceParts) < 2 {
+		return false
+	}
+	return strings.EqualFold(sourceParts[len(sourceParts)-2], "citrix") && strings.EqualFold(sourceParts[len(sourceParts)-1], "citrix")
+}
+
+func configureProvider(ctx context.Context, p provider.Provider) (any, error) {
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	configureReq := provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    util.GetNullAttributesObject(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)), //nolint:forcetypeassert // schema type is always an object
+		},
+	}
+	configureResp := &provider.ConfigureResponse{}
+	p.Configure(ctx, configureReq, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return nil, util.DiagnosticsToError(configureResp.Diagnostics)
+	}
+
+	return configureResp.ResourceData, nil
+}
+
+// checkResourceInstance refreshes a resource instance the same way `terraform plan -refresh-only` does and compares it
+// with the state.
+func checkResourceInstance(ctx context.Context, providerData any, newResource func() resource.Resource, stateResource terraformStateResource, instance terraformStateResourceInstance) ResourceResult {
+	result := ResourceResult{
+		Address:   getResourceAddress(stateResource, instance),
+		Type:      stateResource.Type,
+		Subsystem: getSubsystem(stateResource.Type),
+		Status:    ResourceStatusInSync,
+	}
+	start := time.Now()
+	defer func() {
+		result.Duration = time.Since(start)
+	}()
+
+	r := newResource()
+	if resourceWithConfigure, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		resourceWithConfigure.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			return result.withError(util.DiagnosticsToError(configureResp.Diagnostics))
+		}
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if instance.SchemaVersion != schemaResp.Schema.Version {
+		return result.withError(fmt.Errorf("the state was written with schema version %d of %s, but the provider uses schema version %d. Run `terraform apply -refresh-only` with the current provider version first", instance.SchemaVersion, stateResource.Type, schemaResp.Schema.Version))
+	}
+
+	priorValue, err := tftypes.ValueFromJSONWithOpts(instance.Attributes, schemaResp.Schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+	if err != nil {
+		return result.withError(fmt.Errorf("error parsing the state of %s: %w", result.Address, err))
+	}
+
+	priorState := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    priorValue,
+	}
+	readResp := &resource.ReadResponse{
+		State: priorState,
+	}
+	r.Read(ctx, resource.ReadRequest{State: priorState}, readResp)
+	if readResp.Diagnostics.HasError() {
+		return result.withError(util.DiagnosticsToError(readResp.Diagnostics))
+	}
+
+	if readResp.State.Raw.IsNull() {
+		result.Status = ResourceStatusDeleted
+		return result
+	}
+
+	result.Differences = compareAttributes(schemaResp.Schema.Attributes, priorValue, readResp.State.Raw, "")
+	if len(result.Differences) > 0 {
+		result.Status = ResourceStatusDrifted
+	}
+	return result
+}
+
+func getResourceAddress(stateResource terraformStateResource, instance terraformStateResourceInstance) string {
+	address := stateResource.Type + "." + stateResource.Name
+	if stateResource.Module != "" {
+		address = stateResource.Module + "." + address
+	}
+
+	switch indexKey := instance.IndexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", indexKey)
+	case float64:
+		address += fmt.Sprintf("[%d]", int64(indexKey))
+	}
+	return address
+}
+
+// getSubsystem returns the Citrix product that manages the objects of a resource type.
+func getSubsystem(typeName string) string {
+	switch {
+	case strings.HasPrefix(typeName, "citrix_stf_"):
+		return SubsystemStoreFront
+	case strings.HasPrefix(typeName, "citrix_wem_"):
+		return SubsystemWem
+	case strings.HasPrefix(typeName, "citrix_gac_"):
+		return SubsystemGac
+	default:
+		return SubsystemDaaS
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package drift_report
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const sensitiveValue = "(sensitive value)"
+
+// compareAttributes returns the attributes of the object whose refreshed value differs from the prior value. Nested
+// attributes are compared attribute by attribute, other attributes are compared as a whole.
+func compareAttributes(attributes map[string]schema.Attribute, prior tftypes.Value, refreshed tftypes.Value, attributePath string) []AttributeDifference {
+	priorValues := map[string]tftypes.Value{}
+	refreshedValues := map[string]tftypes.Value{}
+	if prior.IsNull() || refreshed.IsNull() || prior.As(&priorValues) != nil || refreshed.As(&refreshedValues) != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	differences := []AttributeDifference{}
+	for _, name := range names {
+		attribute := attributes[name]
+		priorValue, priorOk := priorValues[name]
+		refreshedValue, refreshedOk := refreshedValues[name]
+		if !priorOk || !refreshedOk {
+			continue
+		}
+		differences = append(differences, compareAttribute(attribute, priorValue, refreshedValue, joinAttributePath(attributePath, name))...)
+	}
+	return differences
+}
+
+func compareAttribute(attribute schema.Attribute, prior tftypes.Value, refreshed tftypes.Value, attributePath string) []AttributeDifference {
+	if prior.Equal(refreshed) {
+		return nil
+	}
+
+	if !prior.IsNull() && !refreshed.IsNull() {
+		switch nestedAttribute := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			return compareAttributes(nestedAttribute.Attributes, prior, refreshed, attributePath)
+		case schema.ListNestedAttribute:
+			priorElements := []tftypes.Value{}
+			refreshedElements := []tftypes.Value{}
+			if prior.As(&priorElements) == nil && refreshed.As(&refreshedElements) == nil && len(priorElements) == len(refreshedElements) {
+				differences := []AttributeDifference{}
+				for i := range priorElements {
+					differences = append(differences, compareAttributes(nestedAttribute.NestedObject.Attributes, priorElements[i], refreshedElements[i], fmt.Sprintf("%s[%d]", attributePath, i))...)
+				}
+				return differences
+			}
+		}
+	}
+
+	difference := AttributeDifference{
+		Attribute: attributePath,
+		Expected:  sensitiveValue,
+		Actual:    sensitiveValue,
+	}
+	if !attribute.IsSensitive() {
+		difference.Expected = toJsonValue(prior)
+		difference.Actual = toJsonValue(refreshed)
+	}
+	return []AttributeDifference{difference}
+}
+
+func joinAttributePath(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// toJsonValue converts a Terraform value into a value that is marshalled to the equivalent JSON.
+func toJsonValue(value tftypes.Value) any {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		return s
+	case valueType.Is(tftypes.Number):
+		n := new(big.Float)
+		_ = value.As(&n)
+		return n.Text('f', -1)
+	case valueType.Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return b
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		elements := []tftypes.Value{}
+		_ = value.As(&elements)
+		result := []any{}
+		for _, element := range elements {
+			result = append(result, toJsonValue(element))
+		}
+		return result
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		elements := map[string]tftypes.Value{}
+		_ = value.As(&elements)
+		result := map[string]any{}
+		for key, element := range elements {
+			result[key] = toJsonValue(element)
+		}
+		return result
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package drift_report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCompareAttributes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+			"secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{Optional: true},
+				},
+			},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx)
+	settingsType := objectType.(tftypes.Object).AttributeTypes["settings"]
+	newValue := func(name string, secret string, scopes []string, enabled bool) tftypes.Value {
+		scopeValues := []tftypes.Value{}
+		for _, scope := range scopes {
+			scopeValues = append(scopeValues, tftypes.NewValue(tftypes.String, scope))
+		}
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"secret":   tftypes.NewValue(tftypes.String, secret),
+			"scopes":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, scopeValues),
+			"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{"enabled": tftypes.NewValue(tftypes.Bool, enabled)}),
+		})
+	}
+
+	testCases := map[string]struct {
+		prior     tftypes.Value
+		refreshed tftypes.Value
+		expected  []AttributeDifference
+	}{
+		"in-sync": {
+			prior:     newValue("catalog", "secret", []string{"All"}, true),
+			refreshed: newValue("catalog", "secret", []string{"All"}, true),
+			expected:  []AttributeDifference{},
+		},
+		"drifted": {
+			prior:     newValue("catalog", "secret", []string{"All"}, true),
+			refreshed: newValue("renamed", "changed", []string{"All", "Sales"}, false),
+			expected: []AttributeDifference{
+				{Attribute: "name", Expected: "catalog", Actual: "renamed"},
+				{Attribute: "scopes", Expected: []any{"All"}, Actual: []any{"All", "Sales"}},
+				{Attribute: "secret", Expected: sensitiveValue, Actual: sensitiveValue},
+				{Attribute: "settings.enabled", Expected: true, Actual: false},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			actual := compareAttributes(testSchema.Attributes, testCase.prior, testCase.refreshed, "")
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected differences (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package drift_report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	SubsystemDaaS       = "DaaS"
+	SubsystemStoreFront = "StoreFront"
+	SubsystemWem        = "WEM"
+	SubsystemGac        = "GAC"
+)
+
+type ResourceStatus string
+
+const (
+	ResourceStatusInSync  ResourceStatus = "in_sync"
+	ResourceStatusDrifted ResourceStatus = "drifted"
+	ResourceStatusDeleted ResourceStatus = "deleted"
+	ResourceStatusError   ResourceStatus = "error"
+)
+
+// Report is the result of a drift report run.
+type Report struct {
+	GeneratedAt   time.Time          `json:"generated_at"`
+	DriftDetected bool               `json:"drift_detected"`
+	Subsystems    []*SubsystemReport `json:"subsystems"`
+}
+
+// SubsystemReport groups the resources managed by the same Citrix product.
+type SubsystemReport struct {
+	Name      string           `json:"name"`
+	Resources []ResourceResult `json:"resources"`
+}
+
+// ResourceResult is the drift of a single resource instance.
+type ResourceResult struct {
+	Address     string                `json:"address"`
+	Type        string                `json:"type"`
+	Subsystem   string                `json:"-"`
+	Status      ResourceStatus        `json:"status"`
+	Error       string                `json:"error,omitempty"`
+	Differences []AttributeDifference `json:"differences,omitempty"`
+	Duration    time.Duration         `json:"-"`
+}
+
+// AttributeDifference is an attribute whose live value differs from the value recorded in the Terraform state.
+type AttributeDifference struct {
+	Attribute string `json:"attribute"`
+	Expected  any    `json:"expected"`
+	Actual    any    `json:"actual"`
+}
+
+func newReport() *Report {
+	report := &Report{
+		GeneratedAt: time.Now().UTC(),
+	}
+	for _, subsystem := range []string{SubsystemDaaS, SubsystemStoreFront, SubsystemWem, SubsystemGac} {
+		report.Subsystems = append(report.Subsystems, &SubsystemReport{
+			Name:      subsystem,
+			Resources: []ResourceResult{},
+		})
+	}
+	return report
+}
+
+func (report *Report) addResult(result ResourceResult) {
+	for _, subsystem := range report.Subsystems {
+		if subsystem.Name == result.Subsystem {
+			subsystem.Resources = append(subsystem.Resources, result)
+		}
+	}
+	if result.Status == ResourceStatusDrifted || result.Status == ResourceStatusDeleted {
+		report.DriftDetected = true
+	}
+}
+
+// HasErrors returns whether any resource could not be checked for drift.
+func (report *Report) HasErrors() bool {
+	for _, subsystem := range report.Subsystems {
+		for _, result := range subsystem.Resources {
+			if result.Status == ResourceStatusError {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (result ResourceResult) withError(err error) ResourceResult {
+	result.Status = ResourceStatusError
+	result.Error = err.Error()
+	return result
+}
+
+func (report *Report) writeJson(fileName string) error {
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, content, 0o600)
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      float64         `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+// writeJunit writes one test suite per subsystem and one test case per resource instance, so that drifted resources
+// show up as failed tests in CI.
+func (report *Report) writeJunit(fileName string) error {
+	testSuites := junitTestSuites{}
+	for _, subsystem := range report.Subsystems {
+		testSuite := junitTestSuite{
+			Name:      subsystem.Name,
+			Timestamp: report.GeneratedAt.Format(time.RFC3339),
+			TestCases: []junitTestCase{},
+		}
+		for _, result := range subsystem.Resources {
+			testCase := junitTestCase{
+				ClassName: result.Type,
+				Name:      result.Address,
+				Time:      result.Duration.Seconds(),
+			}
+			switch result.Status {
+			case ResourceStatusDrifted:
+				testCase.Failure = &junitMessage{
+					Message: fmt.Sprintf("%d attribute(s) drifted from the Terraform state", len(result.Differences)),
+					Content: formatDifferences(result.Differences),
+				}
+				testSuite.Failures++
+			case ResourceStatusDeleted:
+				testCase.Failure = &junitMessage{
+					Message: "the resource no longer exists",
+				}
+				testSuite.Failures++
+			case ResourceStatusError:
+				testCase.Error = &junitMessage{
+					Message: "the resource could not be checked for drift",
+					Content: result.Error,
+				}
+				testSuite.Errors++
+			}
+			testSuite.Tests++
+			testSuite.Time += testCase.Time
+			testSuite.TestCases = append(testSuite.TestCases, testCase)
+		}
+
+		testSuites.Tests += testSuite.Tests
+		testSuites.Failures += testSuite.Failures
+		testSuites.Errors += testSuite.Errors
+		testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+	}
+
+	content, err := xml.MarshalIndent(testSuites, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, append([]byte(xml.Header), content...), 0o600)
+}
+
+func formatDifferences(differences []AttributeDifference) string {
+	lines := []string{}
+	for _, difference := range differences {
+		expected, _ := json.Marshal(difference.Expected)
+		actual, _ := json.Marshal(difference.Actual)
+		lines = append(lines, fmt.Sprintf("%s: expected %s, actual %s", difference.Attribute, expected, actual))
+	}
+	return strings.Join(lines, "\n")
+}
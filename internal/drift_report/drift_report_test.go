@@ -0,0 +1,34 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package drift_report
+
+import (
+	"testing"
+)
+
+func TestIsCitrixProviderAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		providerAddress string
+		expected        bool
+	}{
+		"default provider":           {providerAddress: `provider["registry.terraform.io/citrix/citrix"]`, expected: true},
+		"aliased provider":           {providerAddress: `provider["registry.terraform.io/citrix/citrix"].onprem`, expected: true},
+		"provider of a module":       {providerAddress: `module.site.provider["registry.terraform.io/citrix/citrix"].cloud`, expected: true},
+		"other registry host":        {providerAddress: `provider["registry.opentofu.org/citrix/citrix"]`, expected: true},
+		"other provider type":        {providerAddress: `provider["registry.terraform.io/hashicorp/azurerm"]`, expected: false},
+		"other namespace":            {providerAddress: `provider["registry.terraform.io/example/citrix"]`, expected: false},
+		"not a provider address":     {providerAddress: "citrix/citrix", expected: false},
+		"unterminated provider type": {providerAddress: `provider["registry.terraform.io/citrix/citrix`, expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if actual := isCitrixProviderAddress(test.providerAddress); actual != test.expected {
+				t.Errorf("expected %t for %s, got %t", test.expected, test.providerAddress, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+# Roll an MCS machine catalog back to its previous image and reboot the machines over 1 hour
+action "citrix_catalog_rollback_to_previous_image" "example_catalog_rollback_image" {
+    config {
+        machine_catalog_id = citrix_machine_catalog.example-azure-mtsession.id
+        image_update_reboot_options = {
+            reboot_duration         = 60
+            warning_duration        = 15
+            warning_message         = "Your machine will reboot in %m% minutes to restore the previous image."
+            warning_repeat_interval = 5
+        }
+    }
+}
+
+# The action can be run on demand with `terraform apply -invoke=action.citrix_catalog_rollback_to_previous_image.example_catalog_rollback_image`
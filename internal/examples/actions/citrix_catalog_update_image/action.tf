@@ -0,0 +1,15 @@
+# Update the master image of an MCS machine catalog and reboot the machines over 2 hours
+action "citrix_catalog_update_image" "example_catalog_update_image" {
+    config {
+        machine_catalog_id = citrix_machine_catalog.example-azure-mtsession.id
+        master_image_note  = "Monthly patch"
+        image_update_reboot_options = {
+            reboot_duration         = 120
+            warning_duration        = 15
+            warning_message         = "Your machine will reboot in %m% minutes to apply an image update."
+            warning_repeat_interval = 5
+        }
+    }
+}
+
+# The action can be run on demand with `terraform apply -invoke=action.citrix_catalog_update_image.example_catalog_update_image`
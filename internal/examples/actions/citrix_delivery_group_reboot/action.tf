@@ -0,0 +1,27 @@
+# Reboot all machines of a delivery group over 60 minutes with a notification to the users
+action "citrix_delivery_group_reboot" "example_delivery_group_reboot" {
+    config {
+        delivery_group_id       = citrix_delivery_group.example-delivery-group.id
+        reboot_duration_minutes = 60
+        ignore_maintenance_mode = true
+        reboot_notification_to_users = {
+            notification_duration_minutes       = 15
+            notification_title                  = "Scheduled Reboot"
+            notification_message                = "Your machine will reboot in 15 minutes. Please save your work."
+            notification_repeat_every_5_minutes = true
+        }
+    }
+}
+
+# The action can be run on demand with `terraform apply -invoke=action.citrix_delivery_group_reboot.example_delivery_group_reboot`
+# or triggered by a resource lifecycle event
+resource "terraform_data" "example_maintenance_window" {
+    input = var.maintenance_window
+
+    lifecycle {
+        action_trigger {
+            events  = [after_update]
+            actions = [action.citrix_delivery_group_reboot.example_delivery_group_reboot]
+        }
+    }
+}
@@ -0,0 +1,19 @@
+# Reboot a machine and wait for the VDA to register again
+action "citrix_machine_power_action" "example_machine_reboot" {
+    config {
+        machine               = "domain\\machine-name"
+        action                = "Reboot"
+        force                 = false
+        wait_for_registration = true
+    }
+}
+
+# Log off all sessions on a machine
+action "citrix_machine_power_action" "example_machine_logoff" {
+    config {
+        machine = citrix_machine.example_machine.id
+        action  = "Logoff"
+    }
+}
+
+# The action can be run on demand with `terraform apply -invoke=action.citrix_machine_power_action.example_machine_reboot`
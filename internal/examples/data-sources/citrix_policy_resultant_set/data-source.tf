@@ -0,0 +1,19 @@
+# Model the policy settings of a finance user connecting from a Windows client
+data "citrix_policy_resultant_set" "finance_user" {
+    user                = "{domain}\\{username}"
+    delivery_group      = citrix_delivery_group.example_delivery_group.id
+    delivery_group_type = "Shared"
+    client_platform     = "Windows"
+    client_ip           = "10.0.0.15"
+}
+
+# Fail the plan when clipboard redirection is not prohibited for finance users
+check "finance_clipboard_prohibited" {
+    assert {
+        condition = anytrue([
+            for setting in data.citrix_policy_resultant_set.finance_user.settings :
+            setting.name == "ClipboardRedirection" && setting.value == "Prohibited"
+        ])
+        error_message = "Clipboard redirection is not prohibited for finance users."
+    }
+}
@@ -0,0 +1,8 @@
+# Get the definitions of all the policy settings
+data "citrix_policy_setting_definitions" "all_setting_definitions" {}
+
+# Get the definitions of the user policy settings related to the clipboard
+data "citrix_policy_setting_definitions" "clipboard_setting_definitions" {
+    name_regex = "(?i)clipboard"
+    scope      = "User"
+}
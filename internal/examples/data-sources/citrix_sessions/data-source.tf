@@ -0,0 +1,24 @@
+# Get all sessions of a delivery group
+data "citrix_sessions" "sessions_by_delivery_group" {
+    delivery_group = "{DeliveryGroup Name or Id}"
+}
+
+# Get disconnected sessions of a user that have been idle for more than 2 hours
+data "citrix_sessions" "idle_user_sessions" {
+    user             = "{domain}\\{username}"
+    state            = "Disconnected"
+    min_idle_minutes = 120
+}
+
+# Make sure no sessions are active on a machine catalog before updating its image
+check "no_active_sessions" {
+    data "citrix_sessions" "catalog_sessions" {
+        machine_catalog = citrix_machine_catalog.example-azure-mtsession.id
+        state           = "Active"
+    }
+
+    assert {
+        condition     = length(data.citrix_sessions.catalog_sessions.sessions) == 0
+        error_message = "Machine catalog still has active sessions."
+    }
+}
@@ -6,4 +6,20 @@ data "citrix_vda" "vda_by_machine_catalog" {
 # Get VDA resource by delivery group Name or Id
 data "citrix_vda" "vda_by_delivery_group" {
     delivery_group = "{DeliveryGroup Name or Id}"
+}
+
+# Get unregistered VDAs of a machine catalog that are not in maintenance mode
+data "citrix_vda" "unregistered_vdas" {
+    machine_catalog     = "{MachineCatalog Name or Id}"
+    machine_name_regex  = "^DOMAIN\\\\web-"
+    registration_state  = "Unregistered"
+    in_maintenance_mode = false
+}
+
+# Fail the plan when VDAs of the machine catalog are unregistered
+check "vdas_registered" {
+    assert {
+        condition     = length(data.citrix_vda.unregistered_vdas.vdas) == 0
+        error_message = "VDAs are unregistered: ${join(", ", data.citrix_vda.unregistered_vdas.vdas[*].machine_name)}"
+    }
 }
\ No newline at end of file
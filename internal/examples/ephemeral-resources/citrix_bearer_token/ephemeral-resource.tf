@@ -0,0 +1,11 @@
+# Bearer token can be fetched with the citrix_bearer_token ephemeral resource without being stored in the plan or state.
+ephemeral "citrix_bearer_token" "example_bearer_token" {}
+
+# The bearer token can then be passed to write-only attributes or provider configurations that accept ephemeral values.
+provider "restapi" {
+    uri = "https://api.cloud.com"
+    headers = {
+        Authorization     = "CwsAuth Bearer=${ephemeral.citrix_bearer_token.example_bearer_token.bearer_token}"
+        Citrix-CustomerId = var.customer_id
+    }
+}
@@ -0,0 +1,5 @@
+# Hypervisor resource path of an Azure Compute Gallery image definition.
+# Returns "image.folder\\example-rg.resourcegroup\\example_gallery.gallery\\example-definition.imagedefinition"
+output "example_gallery_image_definition" {
+    value = provider::citrix::azure_gallery_image_path("example-rg", "example_gallery", "example-definition", null)
+}
@@ -0,0 +1,11 @@
+# Hypervisor resource path of the resource group containing a managed disk or snapshot.
+# Returns "image.folder\\example-rg.resourcegroup"
+output "example_managed_disk_folder" {
+    value = provider::citrix::azure_image_path("example-rg", null, null, null)
+}
+
+# Hypervisor resource path of the storage container containing a VHD.
+# Returns "image.folder\\example-rg.resourcegroup\\examplestorage.storageaccount\\vhds.container"
+output "example_vhd_folder" {
+    value = provider::citrix::azure_image_path("example-rg", "examplestorage", "vhds", null)
+}
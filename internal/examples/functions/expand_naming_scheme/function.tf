@@ -0,0 +1,15 @@
+# Machine account names generated for the first 3 machines of a catalog using the `VDA-###` naming scheme.
+# Returns ["VDA-001", "VDA-002", "VDA-003"]
+output "example_machine_names" {
+    value = provider::citrix::expand_naming_scheme("VDA-###", "Numeric", null, 3)
+}
+
+# Names follow the machine_account_creation_rules block of a machine catalog
+locals {
+    machine_names = provider::citrix::expand_naming_scheme(
+        citrix_machine_catalog.example-azure-mtsession.provisioning_scheme.machine_account_creation_rules.naming_scheme,
+        citrix_machine_catalog.example-azure-mtsession.provisioning_scheme.machine_account_creation_rules.naming_scheme_type,
+        citrix_machine_catalog.example-azure-mtsession.provisioning_scheme.machine_account_creation_rules.starts_with,
+        citrix_machine_catalog.example-azure-mtsession.provisioning_scheme.number_of_total_machines
+    )
+}
@@ -0,0 +1,9 @@
+# Split the full path to a machine catalog into its admin folder path and name.
+locals {
+    catalog_path = provider::citrix::parse_folder_path("Production\\Azure\\example-catalog")
+}
+
+data "citrix_machine_catalog" "example_catalog" {
+    name                        = local.catalog_path.name
+    machine_catalog_folder_path = local.catalog_path.folder_path
+}
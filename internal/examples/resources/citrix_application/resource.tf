@@ -59,3 +59,47 @@ resource "citrix_application" "example-application" {
   limit_visibility_to_users = ["example\\user1"]
   cpu_priority_level = "High"
 }
+
+# Application resource with file type associations, opening PDF and text files from Citrix Workspace App with the application.
+resource "citrix_application" "example-application" {
+  name                    = "example-name"
+  published_name          = "example-published-name"
+  installed_app_properties = {
+    command_line_executable = "C:\\Program Files\\Adobe\\Acrobat DC\\Acrobat\\Acrobat.exe"
+  }
+  delivery_groups = [citrix_delivery_group.example-delivery-group.id]
+  file_type_associations = [
+    {
+      extension         = ".pdf"
+      content_type      = "application/pdf"
+      handler_arguments = "\"%**\""
+    },
+    {
+      extension = ".txt"
+      enabled   = false
+    }
+  ]
+}
+
+# Published content application opening a URL or a UNC path.
+resource "citrix_application" "example-published-content" {
+  name             = "example-intranet"
+  published_name   = "Intranet"
+  application_type = "PublishedContent"
+  published_content_properties = {
+    content_location = "https://intranet.example.com"
+  }
+  delivery_groups = [citrix_delivery_group.example-delivery-group.id]
+}
+
+# Application delivered from an App-V package.
+resource "citrix_application" "example-app-v-application" {
+  name             = "example-app-v-application"
+  published_name   = "example-app-v-application"
+  application_type = "AppV"
+  app_package_properties = {
+    package_id             = "<App-V package Id>"
+    package_application_id = "<Id of the application within the App-V package>"
+  }
+  delivery_groups = [citrix_delivery_group.example-delivery-group.id]
+}
@@ -118,6 +118,24 @@ resource "citrix_delivery_group" "example-delivery-group" {
         enable_anti_key_logging = true
         enable_anti_screen_capture = true
     }
+    session_prelaunch = {
+        enabled = true
+        associated_users = [
+            "user@example.com",
+            "DOMAIN\\Group"
+        ]
+        disconnect_timeout_minutes = 60
+        terminate_timeout_minutes = 120
+        max_average_load_threshold = 80
+        max_load_per_machine_threshold = 90
+    }
+    session_lingering = {
+        enabled = true
+        disconnect_timeout_minutes = 30
+        terminate_timeout_minutes = 60
+        max_average_load_threshold = 80
+        max_load_per_machine_threshold = 90
+    }
     default_access_policies = [
         {
             name = "Citrix Gateway Connections"
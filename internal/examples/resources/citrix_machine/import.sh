@@ -0,0 +1,2 @@
+# citrix_machine resource can be imported with the Machine Name 
+terraform import citrix_machine.example_break_glass_machine domain\break-glass-01
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "citrix_machine" "example_break_glass_machine" {
+    name                = "domain\\break-glass-01" // For workgroup machines, use machine-name only
+    machine_catalog_id  = "00000000-0000-0000-0000-000000000000" // Id of the machine catalog the machine belongs to
+    delivery_group_id   = "11111111-1111-1111-1111-111111111111" // Id of the delivery group the machine is added to
+    in_maintenance_mode = false
+    assigned_users      = [ "domain\\admin-user" ]
+    published_name      = "Break Glass Desktop"
+    session_action      = "Logoff"
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &ExpandNamingSchemeFunction{}
+)
+
+func NewExpandNamingSchemeFunction() function.Function {
+	return &ExpandNamingSchemeFunction{}
+}
+
+type ExpandNamingSchemeFunction struct{}
+
+func (f *ExpandNamingSchemeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expand_naming_scheme"
+}
+
+func (f *ExpandNamingSchemeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expand an MCS machine account naming scheme.",
+		Description: "Returns the machine account names MCS generates for a machine catalog `machine_account_creation_rules` block. " +
+			"The wildcard (#) characters in `naming_scheme` are replaced with the `Numeric` or `Alphabetic` sequence defined by `naming_scheme_type`, beginning at `starts_with`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "naming_scheme",
+				Description: "The template name for the machine accounts, for example `VDA-###`.",
+			},
+			function.StringParameter{
+				Name:        "naming_scheme_type",
+				Description: "Type of naming scheme. Choose between `Numeric` and `Alphabetic`.",
+			},
+			function.StringParameter{
+				Name:           "starts_with",
+				Description:    "Numbers or uppercase letters, depending on the naming scheme type, for the account names to start with. When `null`, the sequence begins at `1` for `Numeric` and at `A` for `Alphabetic`.",
+				AllowNullValue: true,
+			},
+			function.Int64Parameter{
+				Name:        "count",
+				Description: fmt.Sprintf("The number of machine account names to return, at most %d.", util.MaxNamingSchemeNameCount),
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ExpandNamingSchemeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var namingScheme string
+	var namingSchemeType string
+	var startsWith types.String
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &namingScheme, &namingSchemeType, &startsWith, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	names, err := util.ExpandNamingScheme(namingScheme, namingSchemeType, startsWith.ValueString(), int(count))
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, names))
+}
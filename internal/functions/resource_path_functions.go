@@ -0,0 +1,197 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package functions
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &ParseFolderPathFunction{}
+	_ function.Function = &AzureImagePathFunction{}
+	_ function.Function = &AzureGalleryImagePathFunction{}
+)
+
+type parsedFolderPath struct {
+	FolderPath  types.String `tfsdk:"folder_path"`
+	Name        types.String `tfsdk:"name"`
+	RequestPath types.String `tfsdk:"request_path"`
+}
+
+func NewParseFolderPathFunction() function.Function {
+	return &ParseFolderPathFunction{}
+}
+
+type ParseFolderPathFunction struct{}
+
+func (f *ParseFolderPathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_folder_path"
+}
+
+func (f *ParseFolderPathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split the admin folder path of a Citrix resource.",
+		Description: "Splits the backslash separated path to a resource in an admin folder, for example `Folder1\\Folder2\\Catalog1`, into the `folder_path` accepted by attributes such as `machine_catalog_folder_path` and the resource `name`. " +
+			"`request_path` is the path the provider uses to look the resource up in the Orchestration API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "The backslash separated path to the resource, including the resource name.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"folder_path":  types.StringType,
+				"name":         types.StringType,
+				"request_path": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseFolderPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourcePath string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourcePath))
+	if resp.Error != nil {
+		return
+	}
+
+	folderPath, name := util.SplitResourcePath(resourcePath)
+	if name == "" {
+		resp.Error = function.NewArgumentFuncError(0, "path must contain a resource name.")
+		return
+	}
+
+	result := parsedFolderPath{
+		FolderPath:  types.StringNull(),
+		Name:        types.StringValue(name),
+		RequestPath: types.StringValue(util.BuildResourcePathForGetRequest(folderPath, name)),
+	}
+	if folderPath != "" {
+		if !regexp.MustCompile(util.AdminFolderPathSpecialCharactersRegex).MatchString(folderPath) {
+			resp.Error = function.NewArgumentFuncError(0, "Admin Folder Path must not contain any of the following special characters: / ; : # . * ? = < > | [ ] ( ) { } \" ' ` ~ ")
+			return
+		}
+		result.FolderPath = types.StringValue(folderPath)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func NewAzureImagePathFunction() function.Function {
+	return &AzureImagePathFunction{}
+}
+
+type AzureImagePathFunction struct{}
+
+func (f *AzureImagePathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "azure_image_path"
+}
+
+func (f *AzureImagePathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build the hypervisor resource path of an Azure master image folder.",
+		Description: "Returns the hypervisor resource path under which the provider resolves an Azure managed disk, snapshot or VHD configured in `azure_master_image`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_group",
+				Description: "The Azure Resource Group where the image VHD / managed disk / snapshot is located.",
+			},
+			function.StringParameter{
+				Name:           "storage_account",
+				Description:    "The Azure Storage Account where the image VHD is located. `null` for managed disks and snapshots.",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "container",
+				Description:    "The Azure storage container where the image VHD is located. `null` for managed disks and snapshots.",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "shared_subscription",
+				Description:    "The Azure Subscription ID where the image is shared from. `null` when the image is in the hypervisor subscription.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AzureImagePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceGroup string
+	var storageAccount types.String
+	var container types.String
+	var sharedSubscription types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceGroup, &storageAccount, &container, &sharedSubscription))
+	if resp.Error != nil {
+		return
+	}
+
+	if storageAccount.IsNull() != container.IsNull() {
+		resp.Error = function.NewFuncError("storage_account and container must be specified together.")
+		return
+	}
+
+	imagePath := util.BuildAzureImageFolderPath(sharedSubscription.ValueString(), resourceGroup, storageAccount.ValueString(), container.ValueString())
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, imagePath))
+}
+
+func NewAzureGalleryImagePathFunction() function.Function {
+	return &AzureGalleryImagePathFunction{}
+}
+
+type AzureGalleryImagePathFunction struct{}
+
+func (f *AzureGalleryImagePathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "azure_gallery_image_path"
+}
+
+func (f *AzureGalleryImagePathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build the hypervisor resource path of an Azure Compute Gallery image definition.",
+		Description: "Returns the hypervisor resource path under which the provider resolves the image version configured in `azure_master_image.gallery_image`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_group",
+				Description: "The Azure Resource Group where the Azure Compute Gallery is located.",
+			},
+			function.StringParameter{
+				Name:        "gallery",
+				Description: "The Azure Compute Gallery name.",
+			},
+			function.StringParameter{
+				Name:        "definition",
+				Description: "The image definition name in the Azure Compute Gallery.",
+			},
+			function.StringParameter{
+				Name:           "shared_subscription",
+				Description:    "The Azure Subscription ID where the gallery is shared from. `null` when the gallery is in the hypervisor subscription.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AzureGalleryImagePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceGroup string
+	var gallery string
+	var definition string
+	var sharedSubscription types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceGroup, &gallery, &definition, &sharedSubscription))
+	if resp.Error != nil {
+		return
+	}
+
+	imagePath := util.BuildAzureGalleryImagePath(sharedSubscription.ValueString(), resourceGroup, gallery, definition)
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, imagePath))
+}
@@ -0,0 +1,156 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ApiFamily is a group of Citrix APIs that share the same request limits.
+type ApiFamily string
+
+const (
+	ApiFamilyOrchestration ApiFamily = "orchestration"
+	ApiFamilyGac           ApiFamily = "gac"
+	ApiFamilyQuickCreate   ApiFamily = "quick_create"
+	ApiFamilyWem           ApiFamily = "wem"
+	ApiFamilyStoreFront    ApiFamily = "storefront"
+)
+
+// RequestLimits are the client-side limits of the requests sent to an API family. A zero value means no limit.
+type RequestLimits struct {
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+}
+
+// RequestLimiter limits the number of requests in flight and the rate at which requests are sent to an API family.
+type RequestLimiter struct {
+	family    ApiFamily
+	semaphore chan struct{}
+	interval  time.Duration
+
+	mutex    sync.Mutex
+	nextSend time.Time
+}
+
+var (
+	storeFrontLimiters     = map[*citrixclient.CitrixDaasClient]*RequestLimiter{}
+	storeFrontLimiterMutex sync.Mutex
+)
+
+// NewRequestLimiter returns a limiter enforcing the limits. It returns nil when no limit is set.
+func NewRequestLimiter(family ApiFamily, limits RequestLimits) *RequestLimiter {
+	if limits.MaxConcurrentRequests <= 0 && limits.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	limiter := &RequestLimiter{family: family}
+	if limits.MaxConcurrentRequests > 0 {
+		limiter.semaphore = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+	if limits.RequestsPerSecond > 0 {
+		limiter.interval = time.Duration(float64(time.Second) / limits.RequestsPerSecond)
+	}
+	return limiter
+}
+
+// Acquire waits until a request can be sent within the limits, or until ctx is done. The returned function must be
+// called once the request completes.
+func (l *RequestLimiter) Acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	start := time.Now()
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.semaphore != nil {
+			<-l.semaphore
+		}
+	}
+
+	if l.interval > 0 {
+		// Requests are spaced evenly, so that a burst of requests is spread over time instead of sent at once
+		l.mutex.Lock()
+		sendAt := time.Now()
+		if l.nextSend.After(sendAt) {
+			sendAt = l.nextSend
+		}
+		l.nextSend = sendAt.Add(l.interval)
+		l.mutex.Unlock()
+
+		if wait := time.Until(sendAt); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	if waited := time.Since(start); waited > time.Second {
+		tflog.Debug(ctx, "Citrix API request delayed by the request limits", map[string]interface{}{
+			"apiFamily": string(l.family),
+			"delay":     waited.String(),
+		})
+	}
+	return release, nil
+}
+
+type limitTransport struct {
+	next    http.RoundTripper
+	limiter *RequestLimiter
+}
+
+// RoundTrip sends the request within the limits. The request is no longer counted as in flight once the response
+// headers are received.
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return t.next.RoundTrip(req)
+}
+
+// SetStoreFrontRequestLimiter sets the limiter of the StoreFront operations of the client.
+func SetStoreFrontRequestLimiter(client *citrixclient.CitrixDaasClient, limiter *RequestLimiter) {
+	storeFrontLimiterMutex.Lock()
+	defer storeFrontLimiterMutex.Unlock()
+	if limiter == nil {
+		delete(storeFrontLimiters, client)
+		return
+	}
+	storeFrontLimiters[client] = limiter
+}
+
+// LimitStoreFrontOperation waits until a StoreFront operation can run within the limits of the client and returns the
+// function to call once it completes. StoreFront runs PowerShell commands instead of HTTP requests, so the limits
+// apply to each resource operation rather than to each request.
+func LimitStoreFrontOperation(ctx context.Context, client *citrixclient.CitrixDaasClient) func() {
+	storeFrontLimiterMutex.Lock()
+	limiter := storeFrontLimiters[client]
+	storeFrontLimiterMutex.Unlock()
+
+	release, err := limiter.Acquire(ctx)
+	if err != nil {
+		// The operation fails on the cancelled context by itself
+		return func() {}
+	}
+	return release
+}
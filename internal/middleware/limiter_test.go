@@ -0,0 +1,88 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRequestLimiterWithoutLimits(t *testing.T) {
+	if limiter := NewRequestLimiter(ApiFamilyOrchestration, RequestLimits{}); limiter != nil {
+		t.Errorf("expected no limiter without limits")
+	}
+}
+
+func TestRequestLimiterMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+	}))
+	defer server.Close()
+
+	limiter := NewRequestLimiter(ApiFamilyOrchestration, RequestLimits{MaxConcurrentRequests: 2})
+	httpClient := WrapHttpClient(&http.Client{}, HttpClientOptions{Limiter: limiter})
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			resp, err := httpClient.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+		})
+	}
+	wg.Wait()
+
+	if maxInFlight.Load() > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight.Load())
+	}
+}
+
+func TestRequestLimiterRequestsPerSecond(t *testing.T) {
+	limiter := NewRequestLimiter(ApiFamilyStoreFront, RequestLimits{RequestsPerSecond: 50})
+
+	start := time.Now()
+	for range 5 {
+		release, err := limiter.Acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		release()
+	}
+
+	// The first request is sent at once and the next four are spaced by 20ms
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("expected the requests to be spaced over at least 80ms, took %s", elapsed)
+	}
+}
+
+func TestRequestLimiterCancelledContext(t *testing.T) {
+	limiter := NewRequestLimiter(ApiFamilyOrchestration, RequestLimits{MaxConcurrentRequests: 1})
+	release, err := limiter.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Acquire(ctx); err == nil {
+		t.Errorf("expected an error when the context is done before a request slot is free")
+	}
+}
@@ -15,9 +15,11 @@ import (
 func MiddlewareAuthWithCustomerIdHeaderFunc(authClient *citrixclient.CitrixDaasClient, r *http.Request) {
 	// Auth
 	if authClient != nil && r.Header.Get("Authorization") == "" {
-		token, _, err := authClient.SignIn()
+		token, _, err := SignIn(r.Context(), authClient)
 		if err != nil {
 			tflog.Error(r.Context(), "Could not sign into Citrix DaaS, error: "+err.Error())
+			setSignInError(r, err)
+			return
 		}
 		r.Header["Authorization"] = []string{token}
 	}
@@ -44,9 +46,11 @@ func MiddlewareAuthWithCustomerIdHeaderFunc(authClient *citrixclient.CitrixDaasC
 func MiddlewareAuthFunc(authClient *citrixclient.CitrixDaasClient, r *http.Request) {
 	// Auth
 	if authClient != nil && r.Header.Get("Authorization") == "" {
-		token, _, err := authClient.SignIn()
+		token, _, err := SignIn(r.Context(), authClient)
 		if err != nil {
 			tflog.Error(r.Context(), "Could not sign into Citrix DaaS, error: "+err.Error())
+			setSignInError(r, err)
+			return
 		}
 		r.Header["Authorization"] = []string{token}
 	}
@@ -72,6 +76,8 @@ func MiddlewareAuthWithSessionIdFunc(authClient *citrixclient.CitrixDaasClient,
 		token, _, err := authClient.SignInWemOnPrem()
 		if err != nil {
 			tflog.Error(r.Context(), "Could not sign into Citrix Wem On-Premise Host, error: "+err.Error())
+			setSignInError(r, err)
+			return
 		}
 		r.Header["Authorization"] = []string{token}
 	}
@@ -0,0 +1,172 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultRetryMaxAttempts = 5
+	DefaultRetryBackoffBase = 1 * time.Second
+	DefaultRetryBackoffCap  = 30 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the status codes returned by the Citrix APIs when they are rate limiting requests or
+// are temporarily unavailable.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures how requests to the Citrix APIs are retried when they fail with a retryable status code.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a request is sent, including the first attempt.
+	MaxAttempts int
+	// BackoffBase is the delay before the first retry. It doubles with every retry, up to BackoffCap.
+	BackoffBase time.Duration
+	BackoffCap  time.Duration
+	// Jitter randomizes each delay between half and the full backoff, so that parallel requests do not retry in lockstep.
+	Jitter               bool
+	RetryableStatusCodes []int
+	// HonorRetryAfter waits for the delay of the Retry-After response header instead of the backoff when it is set.
+	HonorRetryAfter bool
+}
+
+// NewDefaultRetryPolicy returns the retry policy used for the attributes not set in the provider `retry` block.
+func NewDefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          DefaultRetryMaxAttempts,
+		BackoffBase:          DefaultRetryBackoffBase,
+		BackoffCap:           DefaultRetryBackoffCap,
+		Jitter:               true,
+		RetryableStatusCodes: slices.Clone(DefaultRetryableStatusCodes),
+		HonorRetryAfter:      true,
+	}
+}
+
+type retryTransport struct {
+	next   http.RoundTripper
+	policy RetryPolicy
+	// random returns a random duration in [0, n). It is replaced in tests.
+	random func(n int64) int64
+}
+
+func newRetryTransport(next http.RoundTripper, policy RetryPolicy) *retryTransport {
+	return &retryTransport{
+		next:   next,
+		policy: policy,
+		random: rand.Int64N,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A request whose body cannot be read again is sent once
+	canRetry := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err != nil || !canRetry || attempt >= t.policy.MaxAttempts || !t.isRetryable(req, resp) {
+			return resp, err
+		}
+
+		delay := t.getDelay(attempt, resp)
+		transactionId := resp.Header.Get("Citrix-TransactionId")
+		if transactionId == "" {
+			transactionId = req.Header.Get("Citrix-TransactionId")
+		}
+		tflog.Warn(req.Context(), "Retrying Citrix API request", map[string]interface{}{
+			"url":           req.URL.String(),
+			"method":        req.Method,
+			"statusCode":    resp.StatusCode,
+			"attempt":       attempt,
+			"maxAttempts":   t.policy.MaxAttempts,
+			"delay":         delay.String(),
+			"transactionId": transactionId,
+		})
+
+		// Drain the body so that the connection is reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close() //nolint:errcheck // The response of a retried request is discarded
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		attemptReq = req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+	}
+}
+
+// isRetryable checks whether the response of the request is retried. A request that is not idempotent, such as the
+// creation of a machine catalog or an asynchronous job, may have been processed when the server fails with a 5xx status,
+// so it is only retried when it was rejected by the rate limit.
+func (t *retryTransport) isRetryable(req *http.Request, resp *http.Response) bool {
+	if !slices.Contains(t.policy.RetryableStatusCodes, resp.StatusCode) {
+		return false
+	}
+	return isIdempotentMethod(req.Method) || resp.StatusCode == http.StatusTooManyRequests
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// getDelay returns the delay before retrying a request that failed for the attempt-th time.
+func (t *retryTransport) getDelay(attempt int, resp *http.Response) time.Duration {
+	if t.policy.HonorRetryAfter {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+	}
+
+	delay := t.policy.BackoffBase
+	for i := 1; i < attempt && delay < t.policy.BackoffCap; i++ {
+		delay *= 2
+	}
+	delay = min(delay, t.policy.BackoffCap)
+
+	if t.policy.Jitter && delay > 1 {
+		delay = delay/2 + time.Duration(t.random(int64(delay/2)))
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header holding either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
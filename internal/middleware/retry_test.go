@@ -0,0 +1,177 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransportGetDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     RetryPolicy
+		attempt    int
+		retryAfter string
+		expected   time.Duration
+	}{
+		{
+			name:     "first retry waits for the base backoff",
+			policy:   RetryPolicy{BackoffBase: time.Second, BackoffCap: 30 * time.Second},
+			attempt:  1,
+			expected: time.Second,
+		},
+		{
+			name:     "backoff doubles with every retry",
+			policy:   RetryPolicy{BackoffBase: time.Second, BackoffCap: 30 * time.Second},
+			attempt:  4,
+			expected: 8 * time.Second,
+		},
+		{
+			name:     "backoff is capped",
+			policy:   RetryPolicy{BackoffBase: time.Second, BackoffCap: 30 * time.Second},
+			attempt:  10,
+			expected: 30 * time.Second,
+		},
+		{
+			name:     "jitter waits at least half of the backoff",
+			policy:   RetryPolicy{BackoffBase: 4 * time.Second, BackoffCap: 30 * time.Second, Jitter: true},
+			attempt:  1,
+			expected: 2 * time.Second,
+		},
+		{
+			name:       "Retry-After in seconds is honored",
+			policy:     RetryPolicy{BackoffBase: time.Second, BackoffCap: 30 * time.Second, HonorRetryAfter: true},
+			attempt:    1,
+			retryAfter: "45",
+			expected:   45 * time.Second,
+		},
+		{
+			name:       "Retry-After is ignored when not honored",
+			policy:     RetryPolicy{BackoffBase: time.Second, BackoffCap: 30 * time.Second},
+			attempt:    1,
+			retryAfter: "45",
+			expected:   time.Second,
+		},
+		{
+			name:       "invalid Retry-After falls back to the backoff",
+			policy:     RetryPolicy{BackoffBase: time.Second, BackoffCap: 30 * time.Second, HonorRetryAfter: true},
+			attempt:    2,
+			retryAfter: "soon",
+			expected:   2 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := newRetryTransport(http.DefaultTransport, test.policy)
+			transport.random = func(n int64) int64 { return 0 }
+			resp := &http.Response{Header: http.Header{}}
+			if test.retryAfter != "" {
+				resp.Header.Set("Retry-After", test.retryAfter)
+			}
+
+			if delay := transport.getDelay(test.attempt, resp); delay != test.expected {
+				t.Errorf("expected delay %s, got %s", test.expected, delay)
+			}
+		})
+	}
+}
+
+func TestRetryTransportRoundTrip(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		statusCodes      []int
+		maxAttempts      int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			name:             "retryable status is retried until success",
+			method:           http.MethodPut,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			maxAttempts:      5,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "last response is returned after max attempts",
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			maxAttempts:      2,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "status not retryable is returned",
+			method:           http.MethodPut,
+			statusCodes:      []int{http.StatusInternalServerError, http.StatusOK},
+			maxAttempts:      5,
+			expectedStatus:   http.StatusInternalServerError,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "post is retried when rate limited",
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			maxAttempts:      5,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "post is not retried on a server error",
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxAttempts:      5,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "patch is not retried on a server error",
+			method:           http.MethodPatch,
+			statusCodes:      []int{http.StatusBadGateway, http.StatusOK},
+			maxAttempts:      5,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"Name":"zone"}` {
+					t.Errorf("attempt %d: expected the request body to be sent again, got %q", attempts+1, body)
+				}
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(test.statusCodes[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			policy := NewDefaultRetryPolicy()
+			policy.MaxAttempts = test.maxAttempts
+			httpClient := WrapHttpClient(&http.Client{}, HttpClientOptions{RetryPolicy: policy})
+
+			req, _ := http.NewRequest(test.method, server.URL, strings.NewReader(`{"Name":"zone"}`))
+			resp, err := httpClient.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != test.expectedStatus {
+				t.Errorf("expected status %d, got %d", test.expectedStatus, resp.StatusCode)
+			}
+			if attempts != test.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", test.expectedAttempts, attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+const tokenCacheKeyIterations = 100000
+
+// encryptedToken is the content of a token cache file. The token is encrypted with AES-GCM using a key derived from
+// the client secret, so that the cache is only readable with the credentials that can sign in anyway.
+type encryptedToken struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// getTokenCacheFileName returns the cache file of a token. The file name is a hash, so that it does not disclose the
+// customer and client ids. It includes the hash of the client secret, so that the token of a rotated secret is not
+// overwritten by the runs still using the previous secret.
+func getTokenCacheFileName(directory string, key tokenKey) string {
+	hash := sha256.Sum256([]byte(key.customerId + "\n" + key.clientId + "\n" + key.authUrl + "\n" + key.clientSecretHash))
+	return filepath.Join(directory, "citrix-token-"+hex.EncodeToString(hash[:])+".json")
+}
+
+func getTokenCacheCipher(clientSecret string, salt []byte) (cipher.AEAD, error) {
+	encryptionKey, err := pbkdf2.Key(sha256.New, clientSecret, salt, tokenCacheKeyIterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func readCachedToken(directory string, key tokenKey, clientSecret string) (cachedToken, error) {
+	token := cachedToken{}
+	content, err := os.ReadFile(getTokenCacheFileName(directory, key))
+	if err != nil {
+		return token, err
+	}
+
+	encrypted := encryptedToken{}
+	if err := json.Unmarshal(content, &encrypted); err != nil {
+		return token, err
+	}
+	aead, err := getTokenCacheCipher(clientSecret, encrypted.Salt)
+	if err != nil {
+		return token, err
+	}
+	if len(encrypted.Nonce) != aead.NonceSize() {
+		return token, errors.New("invalid token cache file")
+	}
+	plaintext, err := aead.Open(nil, encrypted.Nonce, encrypted.Ciphertext, []byte(key.clientId))
+	if err != nil {
+		return token, errors.New("the token cache file was written with other credentials")
+	}
+
+	err = json.Unmarshal(plaintext, &token)
+	return token, err
+}
+
+func writeCachedToken(directory string, key tokenKey, clientSecret string, token cachedToken) error {
+	plaintext, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	encrypted := encryptedToken{Salt: make([]byte, 16)}
+	if _, err := rand.Read(encrypted.Salt); err != nil {
+		return err
+	}
+	aead, err := getTokenCacheCipher(clientSecret, encrypted.Salt)
+	if err != nil {
+		return err
+	}
+	encrypted.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(encrypted.Nonce); err != nil {
+		return err
+	}
+	encrypted.Ciphertext = aead.Seal(nil, encrypted.Nonce, plaintext, []byte(key.clientId))
+
+	content, err := json.Marshal(encrypted)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that concurrent runs never read a partially written file
+	fileName := getTokenCacheFileName(directory, key)
+	temporaryFile, err := os.CreateTemp(directory, filepath.Base(fileName)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temporaryFile.Name()) //nolint:errcheck // The temporary file no longer exists once renamed
+	if _, err := temporaryFile.Write(content); err != nil {
+		temporaryFile.Close() //nolint:errcheck // The write error is returned
+		return err
+	}
+	if err := temporaryFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temporaryFile.Name(), fileName)
+}
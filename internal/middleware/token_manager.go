@@ -0,0 +1,215 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TokenRefreshMargin is how long before its expiry a token is refreshed, so that it does not expire during a request.
+// It matches the margin within which the Citrix API client signs in again.
+const TokenRefreshMargin = time.Minute
+
+// tokenKey identifies the tokens that can be shared between clients. The authentication URL tells apart the Citrix
+// Cloud environments and the on-premises sites, and the hash of the client secret tells apart rotated secrets.
+type tokenKey struct {
+	customerId       string
+	clientId         string
+	authUrl          string
+	clientSecretHash string
+}
+
+type cachedToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type tokenRefresh struct {
+	done     chan struct{}
+	token    string
+	httpResp *http.Response
+	err      error
+}
+
+// tokenManager caches the access tokens of the clients signed in by the provider. Concurrent requests that need a
+// new token wait for a single sign in.
+type tokenManager struct {
+	mutex            sync.Mutex
+	tokens           map[tokenKey]cachedToken
+	refreshes        map[tokenKey]*tokenRefresh
+	cacheDirectories map[tokenKey]string
+}
+
+// defaultTokenManager is shared by all the provider instances of the process.
+var defaultTokenManager = newTokenManager()
+
+type signInErrorContextKey struct{}
+
+func newTokenManager() *tokenManager {
+	return &tokenManager{
+		tokens:           map[tokenKey]cachedToken{},
+		refreshes:        map[tokenKey]*tokenRefresh{},
+		cacheDirectories: map[tokenKey]string{},
+	}
+}
+
+// SignIn returns an access token of the client. The token is shared with the other clients signed in with the same
+// customer id, client id and environment, and is refreshed shortly before it expires.
+func SignIn(ctx context.Context, client *citrixclient.CitrixDaasClient) (string, *http.Response, error) {
+	return defaultTokenManager.getToken(ctx, client)
+}
+
+// GetTokenExpiration returns the expiration time of the access token that SignIn returns for the client.
+func GetTokenExpiration(client *citrixclient.CitrixDaasClient) (time.Time, bool) {
+	defaultTokenManager.mutex.Lock()
+	defer defaultTokenManager.mutex.Unlock()
+	token, ok := defaultTokenManager.tokens[getTokenKey(client)]
+	return token.ExpiresAt, ok
+}
+
+// SetTokenCacheDirectory saves the access tokens of the client, encrypted with its client secret, to a directory so
+// that they can be reused by the next runs of the provider. The tokens are only kept in memory when directory is empty.
+func SetTokenCacheDirectory(client *citrixclient.CitrixDaasClient, directory string) {
+	defaultTokenManager.mutex.Lock()
+	defer defaultTokenManager.mutex.Unlock()
+	defaultTokenManager.cacheDirectories[getTokenKey(client)] = directory
+}
+
+func getTokenKey(client *citrixclient.CitrixDaasClient) tokenKey {
+	key := tokenKey{}
+	if client.ClientConfig != nil {
+		key.customerId = client.ClientConfig.CustomerId
+	}
+	if client.AuthConfig != nil {
+		key.clientId = client.AuthConfig.ClientId
+		key.authUrl = client.AuthConfig.AuthUrl
+		clientSecretHash := sha256.Sum256([]byte(client.AuthConfig.ClientSecret))
+		key.clientSecretHash = hex.EncodeToString(clientSecretHash[:])
+	}
+	return key
+}
+
+func (token cachedToken) isValid() bool {
+	return token.Token != "" && time.Now().Add(TokenRefreshMargin).Before(token.ExpiresAt)
+}
+
+func (m *tokenManager) getToken(ctx context.Context, client *citrixclient.CitrixDaasClient) (string, *http.Response, error) {
+	if client == nil || client.AuthConfig == nil {
+		return "", nil, errors.New("the Citrix API client is not configured")
+	}
+	key := getTokenKey(client)
+
+	m.mutex.Lock()
+	if token, ok := m.tokens[key]; ok && token.isValid() {
+		m.mutex.Unlock()
+		return token.Token, nil, nil
+	}
+	if refresh, ok := m.refreshes[key]; ok {
+		// Wait for the sign in already in progress
+		m.mutex.Unlock()
+		select {
+		case <-refresh.done:
+			return refresh.token, refresh.httpResp, refresh.err
+		case <-ctx.Done():
+			return "", nil, ctx.Err()
+		}
+	}
+	refresh := &tokenRefresh{done: make(chan struct{})}
+	m.refreshes[key] = refresh
+	cacheDirectory := m.cacheDirectories[key]
+	m.mutex.Unlock()
+
+	token, httpResp, err := m.refreshToken(ctx, client, key, cacheDirectory)
+
+	m.mutex.Lock()
+	delete(m.refreshes, key)
+	if err == nil {
+		m.tokens[key] = token
+	}
+	m.mutex.Unlock()
+
+	refresh.token, refresh.httpResp, refresh.err = token.Token, httpResp, err
+	close(refresh.done)
+	return refresh.token, refresh.httpResp, refresh.err
+}
+
+// refreshToken returns the token of the client when it is still valid, such as a token seeded with CITRIX_ACCESS_TOKEN,
+// then the token of the disk cache, and signs in otherwise.
+func (m *tokenManager) refreshToken(ctx context.Context, client *citrixclient.CitrixDaasClient, key tokenKey, cacheDirectory string) (cachedToken, *http.Response, error) {
+	if token, ok := getClientToken(client); ok && token.isValid() {
+		return token, nil, nil
+	}
+
+	if cacheDirectory != "" {
+		token, err := readCachedToken(cacheDirectory, key, client.AuthConfig.ClientSecret)
+		if err != nil {
+			tflog.Debug(ctx, "Ignoring the cached Citrix API access token: "+err.Error())
+		} else if token.isValid() {
+			tflog.Debug(ctx, "Using the cached Citrix API access token")
+			client.AuthToken = &citrixclient.AuthTokenModel{
+				Token:     token.Token,
+				ExpiresAt: token.ExpiresAt.UTC().Format(time.RFC3339),
+			}
+			return token, nil, nil
+		}
+	}
+
+	// Drop the token of the client so that SignIn does not return it while it is about to expire
+	client.AuthToken = nil
+	tflog.Debug(ctx, "Signing in to Citrix API")
+	_, httpResp, err := client.SignInWithContext(ctx)
+	if err != nil {
+		return cachedToken{}, httpResp, fmt.Errorf("could not sign in to Citrix API: %w", err)
+	}
+
+	token, ok := getClientToken(client)
+	if !ok {
+		return cachedToken{}, httpResp, errors.New("could not sign in to Citrix API: no access token returned")
+	}
+
+	if cacheDirectory != "" {
+		if err := writeCachedToken(cacheDirectory, key, client.AuthConfig.ClientSecret, token); err != nil {
+			tflog.Warn(ctx, "Could not cache the Citrix API access token: "+err.Error())
+		}
+	}
+	return token, httpResp, nil
+}
+
+func getClientToken(client *citrixclient.CitrixDaasClient) (cachedToken, bool) {
+	if client.AuthToken == nil || client.AuthToken.Token == "" {
+		return cachedToken{}, false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, client.AuthToken.ExpiresAt)
+	if err != nil {
+		return cachedToken{}, false
+	}
+	return cachedToken{Token: client.AuthToken.Token, ExpiresAt: expiresAt}, true
+}
+
+// setSignInError records the sign in error of a request, so that the request fails with it instead of being sent
+// without an Authorization header.
+func setSignInError(r *http.Request, err error) {
+	*r = *r.WithContext(context.WithValue(r.Context(), signInErrorContextKey{}, err))
+}
+
+// signInErrorTransport fails the requests whose sign in failed.
+type signInErrorTransport struct {
+	next http.RoundTripper
+}
+
+func (t *signInErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err, ok := req.Context().Value(signInErrorContextKey{}).(error); ok {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,164 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/citrix-daas-rest-go/test"
+)
+
+// newTokenServer returns an on-premises token endpoint counting the sign ins.
+func newTokenServer(t *testing.T, status int, signIns *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signIns.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			w.Write([]byte("invalid credentials")) //nolint:errcheck // Test server
+			return
+		}
+		json.NewEncoder(w).Encode(citrixclient.TrustAuthResponse{ //nolint:errcheck // Test server
+			Token:     "trust-token",
+			ExpiresAt: time.Now().UTC().Add(time.Hour).Format(time.RFC3339),
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTokenTestClient(authUrl string, clientSecret string) *citrixclient.CitrixDaasClient {
+	client, _ := test.NewTestDaaSClient()
+	client.ApiClient.GetConfig().HTTPClient = &http.Client{}
+	client.AuthToken = nil
+	client.AuthConfig = &citrixclient.AuthenticationConfiguration{
+		ClientId:     "client-id",
+		ClientSecret: clientSecret,
+		AuthUrl:      authUrl,
+		OnPremises:   true,
+	}
+	return client
+}
+
+func TestTokenManagerSingleSignIn(t *testing.T) {
+	var signIns atomic.Int32
+	server := newTokenServer(t, http.StatusOK, &signIns)
+	manager := newTokenManager()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			// Every client has its own token, so the sign ins are only shared through the manager
+			token, _, err := manager.getToken(context.Background(), newTokenTestClient(server.URL, "secret"))
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if token != "CWSAuth bearer=trust-token" {
+				t.Errorf("unexpected token %q", token)
+			}
+		})
+	}
+	wg.Wait()
+
+	if signIns.Load() != 1 {
+		t.Errorf("expected a single sign in, got %d", signIns.Load())
+	}
+}
+
+func TestTokenManagerRefreshesExpiringToken(t *testing.T) {
+	var signIns atomic.Int32
+	server := newTokenServer(t, http.StatusOK, &signIns)
+	manager := newTokenManager()
+	client := newTokenTestClient(server.URL, "secret")
+	manager.tokens[getTokenKey(client)] = cachedToken{Token: "expiring-token", ExpiresAt: time.Now().Add(TokenRefreshMargin / 2)}
+
+	token, _, err := manager.getToken(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "CWSAuth bearer=trust-token" || signIns.Load() != 1 {
+		t.Errorf("expected the token expiring within %s to be refreshed, got %q after %d sign ins", TokenRefreshMargin, token, signIns.Load())
+	}
+}
+
+func TestTokenManagerDiskCache(t *testing.T) {
+	var signIns atomic.Int32
+	server := newTokenServer(t, http.StatusOK, &signIns)
+	directory := t.TempDir()
+
+	tests := []struct {
+		name            string
+		clientSecret    string
+		expectedSignIns int32
+	}{
+		{
+			name:            "first run signs in",
+			clientSecret:    "secret",
+			expectedSignIns: 1,
+		},
+		{
+			name:            "next run uses the cached token",
+			clientSecret:    "secret",
+			expectedSignIns: 1,
+		},
+		{
+			name:            "another secret signs in",
+			clientSecret:    "other-secret",
+			expectedSignIns: 2,
+		},
+		{
+			name:            "cached token of the first secret is kept",
+			clientSecret:    "secret",
+			expectedSignIns: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// A new manager stands for a new run of the provider
+			manager := newTokenManager()
+			client := newTokenTestClient(server.URL, test.clientSecret)
+			manager.cacheDirectories[getTokenKey(client)] = directory
+
+			if _, _, err := manager.getToken(context.Background(), client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if signIns.Load() != test.expectedSignIns {
+				t.Errorf("expected %d sign ins, got %d", test.expectedSignIns, signIns.Load())
+			}
+		})
+	}
+}
+
+func TestSignInErrorFailsRequest(t *testing.T) {
+	var signIns atomic.Int32
+	tokenServer := newTokenServer(t, http.StatusUnauthorized, &signIns)
+	apiCalled := false
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiCalled = true
+	}))
+	defer apiServer.Close()
+
+	client := newTokenTestClient(tokenServer.URL, "wrong-secret")
+	httpClient := WrapHttpClient(&http.Client{}, HttpClientOptions{})
+	req, _ := http.NewRequest(http.MethodGet, apiServer.URL, nil)
+	MiddlewareAuthFunc(client, req)
+
+	_, err := httpClient.Do(req)
+	if err == nil || !strings.Contains(err.Error(), "could not sign in to Citrix API") {
+		t.Errorf("expected the sign in error, got %v", err)
+	}
+	if apiCalled {
+		t.Errorf("expected the request not to be sent without a token")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package middleware
+
+import (
+	"net/http"
+)
+
+// TransportWrapper wraps the HTTP transport of the Citrix API clients when set.
+// It is used by the acceptance tests to record and replay the API traffic.
+var TransportWrapper func(http.RoundTripper) http.RoundTripper
+
+// HttpClientOptions configures the behavior that WrapHttpClient adds to an HTTP client.
+type HttpClientOptions struct {
+	// RetryPolicy retries failed requests. Requests are not retried when nil.
+	RetryPolicy *RetryPolicy
+	// Limiter limits the concurrency and rate of the requests. Requests are not limited when nil.
+	Limiter *RequestLimiter
+}
+
+// WrapHttpClient returns a copy of httpClient whose transport is wrapped by TransportWrapper, sends requests within
+// the limits of options.Limiter and retries them according to options.RetryPolicy. Every retry counts against the limits.
+// Requests whose sign in failed in the middleware fail with the sign in error.
+func WrapHttpClient(httpClient *http.Client, options HttpClientOptions) *http.Client {
+	wrappedClient := &http.Client{}
+	if httpClient != nil {
+		*wrappedClient = *httpClient
+	}
+	transport := wrappedClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if TransportWrapper != nil {
+		transport = TransportWrapper(transport)
+	}
+	if options.Limiter != nil {
+		transport = &limitTransport{next: transport, limiter: options.Limiter}
+	}
+	if options.RetryPolicy != nil {
+		transport = newRetryTransport(transport, *options.RetryPolicy)
+	}
+	wrappedClient.Transport = &signInErrorTransport{next: transport}
+	return wrappedClient
+}
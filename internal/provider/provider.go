@@ -35,13 +35,16 @@ import (
 	"github.com/citrix/terraform-provider-citrix/internal/daas/policy_filters"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/policy_priority"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/policy_resource"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/policy_resultant_set"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/policy_set_resource"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/policy_setting"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/service_account"
+	"github.com/citrix/terraform-provider-citrix/internal/daas/session"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/site_backup_schedule"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/storefront_server"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/tags"
 	"github.com/citrix/terraform-provider-citrix/internal/daas/vda"
+	"github.com/citrix/terraform-provider-citrix/internal/functions"
 	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/quickcreate/qcs_account"
 	"github.com/citrix/terraform-provider-citrix/internal/quickcreate/qcs_connection"
@@ -72,9 +75,16 @@ import (
 
 	"golang.org/x/mod/semver"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -86,7 +96,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &citrixProvider{}
+	_ provider.Provider                       = &citrixProvider{}
+	_ provider.ProviderWithFunctions          = &citrixProvider{}
+	_ provider.ProviderWithEphemeralResources = &citrixProvider{}
+	_ provider.ProviderWithListResources      = &citrixProvider{}
+	_ provider.ProviderWithActions            = &citrixProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -100,12 +114,25 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// NewStandalone creates the provider for the tools that run the provider binary outside of Terraform, such as the
+// drift report. The Terraform Registry version check is skipped, as its warning is only shown by Terraform.
+func NewStandalone(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &citrixProvider{
+			version:          version,
+			skipVersionCheck: true,
+		}
+	}
+}
+
 // citrixProvider is the provider implementation.
 type citrixProvider struct {
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// skipVersionCheck disables the check for a newer provider version in the Terraform Registry.
+	skipVersionCheck bool
 }
 
 // citrixProviderModel maps provider schema data to a Go type.
@@ -113,6 +140,8 @@ type citrixProviderModel struct {
 	CvadConfig           *cvadConfig       `tfsdk:"cvad_config"`
 	StoreFrontRemoteHost *storefrontConfig `tfsdk:"storefront_remote_host"`
 	WemOnPremConfig      *wemonpremconfig  `tfsdk:"wem_on_prem_config"`
+	Retry                *retryConfig      `tfsdk:"retry"`
+	RequestLimits        *requestLimits    `tfsdk:"request_limits"`
 }
 
 type cvadConfig struct {
@@ -124,6 +153,7 @@ type cvadConfig struct {
 	DisableSslVerification types.Bool   `tfsdk:"disable_ssl_verification"`
 	DisableDaaSClient      types.Bool   `tfsdk:"disable_daas_client"`
 	WemRegion              types.String `tfsdk:"wem_region"`
+	TokenCacheDirectory    types.String `tfsdk:"token_cache_directory"`
 }
 
 type storefrontConfig struct {
@@ -140,6 +170,28 @@ type wemonpremconfig struct {
 	DisableSslVerification types.Bool   `tfsdk:"disable_ssl_verification"`
 }
 
+type retryConfig struct {
+	MaxAttempts          types.Int64 `tfsdk:"max_attempts"`
+	BackoffBaseSeconds   types.Int64 `tfsdk:"backoff_base_seconds"`
+	BackoffCapSeconds    types.Int64 `tfsdk:"backoff_cap_seconds"`
+	Jitter               types.Bool  `tfsdk:"jitter"`
+	RetryableStatusCodes types.List  `tfsdk:"retryable_status_codes"` // List[int64]
+	HonorRetryAfter      types.Bool  `tfsdk:"honor_retry_after"`
+}
+
+type requestLimits struct {
+	Orchestration *requestLimit `tfsdk:"orchestration"`
+	Gac           *requestLimit `tfsdk:"gac"`
+	QuickCreate   *requestLimit `tfsdk:"quick_create"`
+	Wem           *requestLimit `tfsdk:"wem"`
+	StoreFront    *requestLimit `tfsdk:"storefront"`
+}
+
+type requestLimit struct {
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+}
+
 // Metadata returns the provider type name.
 func (p *citrixProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "citrix"
@@ -229,6 +281,13 @@ func (p *citrixProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 							),
 						},
 					},
+					"token_cache_directory": schema.StringAttribute{
+						Description: "Directory to cache the access tokens in, so that the next runs of the provider reuse them instead of signing in again until they expire. " +
+							"\nThe tokens are encrypted with the `client_secret`. Tokens are only cached in memory when not set." +
+							"\n\n-> **Note** Can be set via Environment Variable **CITRIX_TOKEN_CACHE_DIRECTORY**." +
+							"\n\n~> **Please Note** Use a directory that is only readable by the user running Terraform, such as a temporary directory of the CI job.",
+						Optional: true,
+					},
 				},
 			},
 			"storefront_remote_host": schema.SingleNestedAttribute{
@@ -302,6 +361,90 @@ func (p *citrixProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					},
 				},
 			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "Retry policy for the requests to the Citrix APIs that are rate limited or fail with a transient error. " +
+					"\nWhen set, requests that fail with one of the `retryable_status_codes` are sent again after an exponential backoff, and every retry is logged with the `Citrix-TransactionId` of the request. " +
+					"\n\n~> **Please Note** The retries are in addition to the retries of individual operations in the provider. Requests whose body cannot be sent again and requests that fail with a network error are not retried. `POST` and `PATCH` requests, which may create or change objects again, are only retried when they fail with status `429`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of times a request is sent, including the first attempt. Defaults to `%d`.", middleware.DefaultRetryMaxAttempts),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 20),
+						},
+					},
+					"backoff_base_seconds": schema.Int64Attribute{
+						Description: fmt.Sprintf("Delay in seconds before the first retry. The delay doubles with every retry. Defaults to `%d`.", int(middleware.DefaultRetryBackoffBase.Seconds())),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"backoff_cap_seconds": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum delay in seconds between two attempts. Defaults to `%d`.", int(middleware.DefaultRetryBackoffCap.Seconds())),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"jitter": schema.BoolAttribute{
+						Description: "Randomize each delay between half and the full backoff, so that parallel requests do not retry at the same time. Defaults to `true`.",
+						Optional:    true,
+					},
+					"retryable_status_codes": schema.ListAttribute{
+						ElementType: types.Int64Type,
+						Description: "HTTP status codes of the responses to retry. Defaults to `[429, 502, 503, 504]`.",
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+						},
+					},
+					"honor_retry_after": schema.BoolAttribute{
+						Description: "Wait for the delay of the `Retry-After` response header instead of the backoff when the response sets it. Defaults to `true`.",
+						Optional:    true,
+					},
+				},
+			},
+			"request_limits": schema.SingleNestedAttribute{
+				Description: "Client-side limits of the requests sent to each family of Citrix APIs, so that a high Terraform parallelism does not overload the Delivery Controllers or trip the API rate limits. " +
+					"\nRequests above the limits wait until they can be sent. Each API family is unlimited when not set.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"orchestration": getRequestLimitSchema("the Citrix DaaS / CVAD Orchestration API"),
+					"gac":           getRequestLimitSchema("the Global App Configuration API"),
+					"quick_create":  getRequestLimitSchema("the DaaS Quick Create API"),
+					"wem":           getRequestLimitSchema("the Workspace Environment Management API"),
+					"storefront": getRequestLimitSchema("StoreFront. " +
+						"\n\n~> **Please Note** StoreFront resources run PowerShell commands on the StoreFront server instead of sending HTTP requests, so the limits apply to each resource operation"),
+				},
+			},
+		},
+	}
+}
+
+// getRequestLimitSchema returns the schema of the request limits of an API family.
+func getRequestLimitSchema(apiFamily string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Request limits of " + apiFamily + ".",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests in flight at the same time.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests sent per second. Requests are spaced evenly.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
 		},
 	}
 }
@@ -395,7 +538,9 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	tflog.Info(ctx, "Configuring Citrix Cloud client")
 	defer util.PanicHandler(&resp.Diagnostics)
 
-	p.versionCheck(ctx, &resp.Diagnostics)
+	if !p.skipVersionCheck {
+		p.versionCheck(ctx, &resp.Diagnostics)
+	}
 
 	// Retrieve provider data from configuration
 	var config citrixProviderModel
@@ -452,6 +597,7 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	wemHostName := os.Getenv("CITRIX_WEM_HOSTNAME")
 	quick_create_host_name := os.Getenv("CITRIX_QUICK_CREATE_HOST_NAME")
 	catalog_service_host_name := os.Getenv("CITRIX_QUICK_DEPLOY_HOST_NAME")
+	tokenCacheDirectory := os.Getenv("CITRIX_TOKEN_CACHE_DIRECTORY")
 
 	// Initialize WEM on-prem client if WEM on-prem config is provided
 	wemOnPremHostName := os.Getenv("WEM_HOSTNAME")
@@ -459,6 +605,13 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	wem_admin_password := os.Getenv("WEM_ADMIN_PASSWORD")
 	wem_disable_ssl_verification := strings.EqualFold(os.Getenv("WEM_DISABLE_SSL_VERIFICATION"), "true")
 
+	retryPolicy := getRetryPolicy(ctx, &resp.Diagnostics, config.Retry)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestLimiters := getRequestLimiters(config.RequestLimits)
+	middleware.SetStoreFrontRequestLimiter(client, requestLimiters[middleware.ApiFamilyStoreFront])
+
 	if cvadConfig := config.CvadConfig; cvadConfig != nil || (clientId != "" && clientSecret != "") {
 		if cvadConfig != nil {
 			if !cvadConfig.ClientId.IsNull() {
@@ -492,6 +645,10 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 			if !cvadConfig.WemRegion.IsNull() {
 				wemRegion = cvadConfig.WemRegion.ValueString()
 			}
+
+			if !cvadConfig.TokenCacheDirectory.IsNull() {
+				tokenCacheDirectory = cvadConfig.TokenCacheDirectory.ValueString()
+			}
 		}
 
 		if wemOnPremConfig := config.WemOnPremConfig; wemOnPremConfig != nil || (wemOnPremHostName != "" && wem_admin_username != "" && wem_admin_password != "") {
@@ -516,7 +673,7 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 			}
 		}
 
-		validateAndInitializeDaaSClient(ctx, resp, client, clientId, clientSecret, hostname, environment, wemHostName, wemRegion, customerId, quick_create_host_name, catalog_service_host_name, p.version, wemOnPremHostName, wem_admin_username, wem_admin_password, disableSslVerification, disableDaasClient, wem_disable_ssl_verification)
+		validateAndInitializeDaaSClient(ctx, resp, client, clientId, clientSecret, hostname, environment, wemHostName, wemRegion, customerId, quick_create_host_name, catalog_service_host_name, p.version, wemOnPremHostName, wem_admin_username, wem_admin_password, disableSslVerification, disableDaasClient, wem_disable_ssl_verification, retryPolicy, requestLimiters, tokenCacheDirectory)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -539,10 +696,42 @@ func (p *citrixProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
 
 	tflog.Info(ctx, "Configured Citrix API client", map[string]any{"success": true})
 }
 
+// NewDaaSClientFromEnvironment creates the Citrix DaaS client from the CITRIX_* environment variables that back the `cvad_config` attributes.
+// It is used when the provider binary runs outside of Terraform, such as in site export mode.
+func NewDaaSClientFromEnvironment(ctx context.Context, version string) (*citrixclient.CitrixDaasClient, diag.Diagnostics) {
+	resp := &provider.ConfigureResponse{}
+	client := &citrixclient.CitrixDaasClient{}
+
+	validateAndInitializeDaaSClient(ctx, resp, client,
+		os.Getenv("CITRIX_CLIENT_ID"),
+		os.Getenv("CITRIX_CLIENT_SECRET"),
+		os.Getenv("CITRIX_HOSTNAME"),
+		os.Getenv("CITRIX_ENVIRONMENT"),
+		os.Getenv("CITRIX_WEM_HOSTNAME"),
+		os.Getenv("CITRIX_WEM_REGION"),
+		os.Getenv("CITRIX_CUSTOMER_ID"),
+		os.Getenv("CITRIX_QUICK_CREATE_HOST_NAME"),
+		os.Getenv("CITRIX_QUICK_DEPLOY_HOST_NAME"),
+		version,
+		"", "", "",
+		strings.EqualFold(os.Getenv("CITRIX_DISABLE_SSL_VERIFICATION"), "true"),
+		false,
+		false,
+		nil,
+		nil,
+		os.Getenv("CITRIX_TOKEN_CACHE_DIRECTORY"),
+	)
+
+	return client, resp.Diagnostics
+}
+
 func validateWemOnPremClient(resp *provider.ConfigureResponse, wem_hostname, wem_admin_username, wem_admin_password string) {
 	if wem_hostname == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -604,7 +793,7 @@ func validateAndInitializeStorefrontClient(ctx context.Context, resp *provider.C
 	client.InitializeStoreFrontClient(ctx, storefront_computer_name, storefront_ad_admin_username, storefront_ad_admin_password, storefront_disable_ssl_verification)
 }
 
-func validateAndInitializeDaaSClient(ctx context.Context, resp *provider.ConfigureResponse, client *citrixclient.CitrixDaasClient, clientId, clientSecret, hostname, environment, wemHostName, wemRegion, customerId, quick_create_host_name, catalog_service_host_name, version, wemOnPremHostName, wem_admin_username, wem_admin_password string, disableSslVerification, disableDaasClient, wem_disable_ssl_verification bool) {
+func validateAndInitializeDaaSClient(ctx context.Context, resp *provider.ConfigureResponse, client *citrixclient.CitrixDaasClient, clientId, clientSecret, hostname, environment, wemHostName, wemRegion, customerId, quick_create_host_name, catalog_service_host_name, version, wemOnPremHostName, wem_admin_username, wem_admin_password string, disableSslVerification, disableDaasClient, wem_disable_ssl_verification bool, retryPolicy *middleware.RetryPolicy, requestLimiters map[middleware.ApiFamily]*middleware.RequestLimiter, tokenCacheDirectory string) {
 	if clientId == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("cvad_config").AtName("client_id"),
@@ -857,7 +1046,12 @@ func validateAndInitializeDaaSClient(ctx context.Context, resp *provider.Configu
 	}
 
 	// Setup the Citrix API Client
-	token, httpResp, err := client.SetupCitrixClientsContext(ctx, authUrl, ccUrl, hostname, customerId, clientId, clientSecret, onPremises, apiGateway, isGov, disableSslVerification, &userAgent, environment, middleware.MiddlewareAuthFunc, middleware.MiddlewareAuthWithCustomerIdHeaderFunc)
+	client.SetupApiClient(hostname, middleware.MiddlewareAuthFunc, onPremises, disableSslVerification, apiGateway)
+	client.SetupAuthConfig(authUrl, clientId, clientSecret, onPremises, apiGateway, isGov, environment)
+	client.ClientConfig = &citrixclient.ClientConfiguration{CustomerId: customerId}
+	client.ApiClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.ApiClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy, Limiter: requestLimiters[middleware.ApiFamilyOrchestration]})
+	middleware.SetTokenCacheDirectory(client, tokenCacheDirectory)
+	token, httpResp, err := middleware.SignIn(ctx, client)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == http.StatusUnauthorized {
 			resp.Diagnostics.AddError(
@@ -933,6 +1127,103 @@ func validateAndInitializeDaaSClient(ctx context.Context, resp *provider.Configu
 	if wemHostName != "" {
 		client.InitializeWemClient(ctx, wemHostName, middleware.MiddlewareAuthFunc, false, false)
 	}
+
+	wrapCitrixCloudClientTransports(client, retryPolicy, requestLimiters)
+}
+
+// getRetryPolicy returns the retry policy of the provider `retry` block, with the default value of the attributes that are not set.
+// It returns nil when the block is not set.
+func getRetryPolicy(ctx context.Context, diagnostics *diag.Diagnostics, config *retryConfig) *middleware.RetryPolicy {
+	if config == nil {
+		return nil
+	}
+
+	retryPolicy := middleware.NewDefaultRetryPolicy()
+	if !config.MaxAttempts.IsNull() {
+		retryPolicy.MaxAttempts = int(config.MaxAttempts.ValueInt64())
+	}
+	if !config.BackoffBaseSeconds.IsNull() {
+		retryPolicy.BackoffBase = time.Duration(config.BackoffBaseSeconds.ValueInt64()) * time.Second
+	}
+	if !config.BackoffCapSeconds.IsNull() {
+		retryPolicy.BackoffCap = time.Duration(config.BackoffCapSeconds.ValueInt64()) * time.Second
+	}
+	if !config.Jitter.IsNull() {
+		retryPolicy.Jitter = config.Jitter.ValueBool()
+	}
+	if !config.HonorRetryAfter.IsNull() {
+		retryPolicy.HonorRetryAfter = config.HonorRetryAfter.ValueBool()
+	}
+	if !config.RetryableStatusCodes.IsNull() {
+		statusCodes := []int64{}
+		diagnostics.Append(config.RetryableStatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		retryPolicy.RetryableStatusCodes = []int{}
+		for _, statusCode := range statusCodes {
+			retryPolicy.RetryableStatusCodes = append(retryPolicy.RetryableStatusCodes, int(statusCode))
+		}
+	}
+
+	if retryPolicy.BackoffCap < retryPolicy.BackoffBase {
+		diagnostics.AddAttributeError(
+			path.Root("retry").AtName("backoff_cap_seconds"),
+			"Invalid Retry Configuration",
+			"`backoff_cap_seconds` must be greater than or equal to `backoff_base_seconds`.",
+		)
+	}
+	return retryPolicy
+}
+
+// getRequestLimiters returns the limiter of each API family with limits in the provider `request_limits` block.
+func getRequestLimiters(config *requestLimits) map[middleware.ApiFamily]*middleware.RequestLimiter {
+	requestLimiters := map[middleware.ApiFamily]*middleware.RequestLimiter{}
+	if config == nil {
+		return requestLimiters
+	}
+
+	for apiFamily, limit := range map[middleware.ApiFamily]*requestLimit{
+		middleware.ApiFamilyOrchestration: config.Orchestration,
+		middleware.ApiFamilyGac:           config.Gac,
+		middleware.ApiFamilyQuickCreate:   config.QuickCreate,
+		middleware.ApiFamilyWem:           config.Wem,
+		middleware.ApiFamilyStoreFront:    config.StoreFront,
+	} {
+		if limit == nil {
+			continue
+		}
+		limiter := middleware.NewRequestLimiter(apiFamily, middleware.RequestLimits{
+			MaxConcurrentRequests: int(limit.MaxConcurrentRequests.ValueInt64()),
+			RequestsPerSecond:     limit.RequestsPerSecond.ValueFloat64(),
+		})
+		if limiter != nil {
+			requestLimiters[apiFamily] = limiter
+		}
+	}
+	return requestLimiters
+}
+
+// wrapCitrixCloudClientTransports wraps the HTTP transport of the API clients created after sign in.
+func wrapCitrixCloudClientTransports(client *citrixclient.CitrixDaasClient, retryPolicy *middleware.RetryPolicy, requestLimiters map[middleware.ApiFamily]*middleware.RequestLimiter) {
+	if client.GacClient != nil {
+		client.GacClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.GacClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy, Limiter: requestLimiters[middleware.ApiFamilyGac]})
+	}
+	if client.CCAdminsClient != nil {
+		client.CCAdminsClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.CCAdminsClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy})
+	}
+	if client.ResourceLocationsClient != nil {
+		client.ResourceLocationsClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.ResourceLocationsClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy})
+	}
+	if client.QuickCreateClient != nil {
+		client.QuickCreateClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.QuickCreateClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy, Limiter: requestLimiters[middleware.ApiFamilyQuickCreate]})
+	}
+	if client.QuickDeployClient != nil {
+		client.QuickDeployClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.QuickDeployClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy})
+	}
+	if client.CwsClient != nil {
+		client.CwsClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.CwsClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy})
+	}
+	if client.WemClient != nil {
+		client.WemClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.WemClient.GetConfig().HTTPClient, middleware.HttpClientOptions{RetryPolicy: retryPolicy, Limiter: requestLimiters[middleware.ApiFamilyWem]})
+	}
 }
 
 func handleNetworkError(err error, resp *provider.ConfigureResponse) {
@@ -986,6 +1277,7 @@ func (p *citrixProvider) DataSources(_ context.Context) []func() datasource.Data
 		machine_catalog.NewMachineCatalogDataSource,
 		delivery_group.NewDeliveryGroupDataSource,
 		vda.NewVdaDataSource,
+		session.NewSessionsDataSource,
 		application.NewApplicationDataSourceSource,
 		admin_folder.NewAdminFolderDataSource,
 		admin_role.NewAdminRoleDataSource,
@@ -1002,6 +1294,8 @@ func (p *citrixProvider) DataSources(_ context.Context) []func() datasource.Data
 		policy_priority.NewPolicyPriorityDataSource,
 		policy_resource.NewPolicyDataSource,
 		policy_setting.NewPolicySettingDataSource,
+		policy_setting.NewPolicySettingDefinitionsDataSource,
+		policy_resultant_set.NewPolicyResultantSetDataSource,
 		policy_filters.NewAccessControlPolicyFilterDataSource,
 		policy_filters.NewBranchRepeaterPolicyFilterDataSource,
 		policy_filters.NewClientIPPolicyFilterDataSource,
@@ -1066,6 +1360,7 @@ func (p *citrixProvider) Resources(_ context.Context) []func() resource.Resource
 		hypervisor_resource_pool.NewAmazonWorkSpacesCoreHypervisorResourcePoolResource,
 		machine_catalog.NewMachineCatalogResource,
 		machine_catalog.NewMachinePropertiesResource,
+		machine_catalog.NewMachineResource,
 		delivery_group.NewDeliveryGroupResource,
 		storefront_server.NewStoreFrontServerResource,
 		application.NewApplicationResource,
@@ -1127,3 +1422,69 @@ func (p *citrixProvider) Resources(_ context.Context) []func() resource.Resource
 		// Add resource here
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *citrixProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		bearer_token.NewBearerTokenEphemeralResource,
+		// Add ephemeral resource here
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *citrixProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		zone.NewZoneListResource,
+		hypervisor.NewAzureHypervisorListResource,
+		hypervisor.NewAwsHypervisorListResource,
+		hypervisor.NewGcpHypervisorListResource,
+		hypervisor.NewVsphereHypervisorListResource,
+		hypervisor.NewXenserverHypervisorListResource,
+		hypervisor.NewNutanixHypervisorListResource,
+		hypervisor.NewSCVMMHypervisorListResource,
+		hypervisor.NewOpenShiftHypervisorListResource,
+		hypervisor.NewHpeMoonshotHypervisorListResource,
+		hypervisor.NewRemotePCWakeOnLANHypervisorListResource,
+		hypervisor.NewAmazonWorkSpacesCoreHypervisorListResource,
+		hypervisor_resource_pool.NewAzureHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewAwsHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewGcpHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewXenserverHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewVsphereHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewNutanixHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewSCVMMHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewOpenShiftHypervisorResourcePoolListResource,
+		hypervisor_resource_pool.NewAmazonWorkSpacesCoreHypervisorResourcePoolListResource,
+		machine_catalog.NewMachineCatalogListResource,
+		delivery_group.NewDeliveryGroupListResource,
+		application.NewApplicationListResource,
+		application.NewApplicationGroupListResource,
+		policy_set_resource.NewPolicySetV2ListResource,
+		admin_scope.NewAdminScopeListResource,
+		admin_role.NewAdminRoleListResource,
+		tags.NewTagListResource,
+		// Add list resource here
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *citrixProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		delivery_group.NewDeliveryGroupRebootAction,
+		machine_catalog.NewMachinePowerAction,
+		machine_catalog.NewMachineCatalogUpdateImageAction,
+		machine_catalog.NewMachineCatalogRollbackImageAction,
+		// Add action here
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *citrixProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewExpandNamingSchemeFunction,
+		functions.NewParseFolderPathFunction,
+		functions.NewAzureImagePathFunction,
+		functions.NewAzureGalleryImagePathFunction,
+		// Add function here
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	"github.com/citrix/citrix-daas-rest-go/citrixquickcreate"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -610,7 +611,7 @@ func WaitForQcsDeployment(ctx context.Context, diagnostics *diag.Diagnostics, cl
 func generateBatchApiHeaders(ctx context.Context, client *citrixdaasclient.CitrixDaasClient) (context.Context, []citrixorchestration.NameValueStringPairModel, *http.Response, error) {
 	headers := []citrixorchestration.NameValueStringPairModel{}
 
-	cwsAuthToken, httpResp, err := client.SignIn()
+	cwsAuthToken, httpResp, err := middleware.SignIn(ctx, client)
 	ctx = tflog.SetField(ctx, "cws_auth_token", cwsAuthToken)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "cws_auth_token")
 	if err != nil {
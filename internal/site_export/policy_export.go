@@ -0,0 +1,510 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package site_export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// policyExportFileName is the file the translated group policies are written to.
+const policyExportFileName = "policies.tf"
+
+// supportedPolicyExportFormats describes the policy exports that can be translated, for errors about other files.
+const supportedPolicyExportFormats = "the folder written by `Export-CtxGroupPolicy`, a zip archive of that folder, or a single CLIXML file"
+
+var clixmlEscapedCharacter = regexp.MustCompile(`_x([0-9A-Fa-f]{4})_`)
+
+// importedPolicy is a Citrix group policy read from a policy export. The user and computer halves of a group policy
+// with the same name are merged, as they are a single policy in policy sets.
+type importedPolicy struct {
+	name        string
+	description string
+	enabled     bool
+	priority    int
+	settings    []importedPolicySetting
+	filters     []importedPolicyFilter
+}
+
+type importedPolicySetting struct {
+	name  string
+	state string
+	value string
+}
+
+type importedPolicyFilter struct {
+	filterType string
+	value      string
+	enabled    bool
+	allowed    bool
+}
+
+// policyFilterDataSource is a data source resolving the name of a delivery group or a tag referenced by a policy filter.
+type policyFilterDataSource struct {
+	reference string
+	block     string
+}
+
+// clixmlElement is an element of a PowerShell CLIXML document, as written by `Export-Clixml`.
+type clixmlElement struct {
+	XMLName  xml.Name
+	Name     string          `xml:"N,attr"`
+	Text     string          `xml:",chardata"`
+	Children []clixmlElement `xml:",any"`
+}
+
+// properties returns the properties of a CLIXML object by name.
+func (e clixmlElement) properties() map[string]clixmlElement {
+	properties := map[string]clixmlElement{}
+	for _, child := range e.Children {
+		if child.XMLName.Local != "Props" && child.XMLName.Local != "MS" {
+			continue
+		}
+		for _, property := range child.Children {
+			if property.Name != "" {
+				properties[property.Name] = property
+			}
+		}
+	}
+	return properties
+}
+
+// stringValue returns the value of a primitive CLIXML element. Enumerations are serialized as objects, their name is
+// kept in the ToString element.
+func (e clixmlElement) stringValue() string {
+	switch e.XMLName.Local {
+	case "Nil", "":
+		return ""
+	case "Obj":
+		for _, child := range e.Children {
+			if child.XMLName.Local == "ToString" {
+				return decodeClixmlString(child.Text)
+			}
+		}
+		return ""
+	}
+	return decodeClixmlString(e.Text)
+}
+
+// decodeClixmlString restores the characters that CLIXML escapes as _xHHHH_.
+func decodeClixmlString(s string) string {
+	return clixmlEscapedCharacter.ReplaceAllStringFunc(s, func(escaped string) string {
+		code, err := strconv.ParseUint(escaped[2:6], 16, 16)
+		if err != nil {
+			return escaped
+		}
+		return string(rune(code))
+	})
+}
+
+// runPolicyExport translates the group policies of a policy export into the configuration of a policy set, its
+// policies, policy settings, policy filters and policy priority. The site is not contacted, references to delivery
+// groups and tags are resolved by name with data sources when the configuration is applied.
+func runPolicyExport(options Options) error {
+	documents, err := readPolicyExportDocuments(options.PolicyFile)
+	if err != nil {
+		return err
+	}
+
+	policies, err := parsePolicyExport(documents)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return fmt.Errorf("no group policies found in %s", options.PolicyFile)
+	}
+
+	policySetName := options.PolicySetName
+	if policySetName == "" {
+		policySetName = strings.TrimSuffix(filepath.Base(options.PolicyFile), filepath.Ext(options.PolicyFile))
+	}
+
+	var content strings.Builder
+	warnings := writePolicyExport(&content, policySetName, policies)
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	if err := os.MkdirAll(options.OutputDir, 0o755); err != nil {
+		return err
+	}
+	fileName := filepath.Join(options.OutputDir, policyExportFileName)
+	if err := os.WriteFile(fileName, hclwrite.Format([]byte(content.String())), 0o600); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "Exported %d policies of %s to %s\n", len(policies), options.PolicyFile, fileName)
+	return nil
+}
+
+// readPolicyExportDocuments reads the CLIXML documents of a policy export. The export is either a folder with the files
+// written by `Export-CtxGroupPolicy`, a zip archive of that folder, or a single CLIXML file. The .gpf files exported
+// from Citrix Studio use a different format and are rejected.
+func readPolicyExportDocuments(policyFile string) ([][]byte, error) {
+	info, err := os.Stat(policyFile)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() && strings.EqualFold(filepath.Ext(policyFile), ".gpf") {
+		return nil, fmt.Errorf("policy export %s is a Citrix Studio .gpf export, which is not supported. Export the policies with `Export-CtxGroupPolicy` instead and use %s", policyFile, supportedPolicyExportFormats)
+	}
+
+	documents := [][]byte{}
+	if info.IsDir() {
+		fileNames, err := filepath.Glob(filepath.Join(policyFile, "*.xml"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(fileNames)
+		for _, fileName := range fileNames {
+			content, err := os.ReadFile(fileName)
+			if err != nil {
+				return nil, err
+			}
+			documents = append(documents, content)
+		}
+		return documents, nil
+	}
+
+	content, err := os.ReadFile(policyFile)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(content, []byte("PK\x03\x04")) {
+		if !bytes.HasPrefix(bytes.TrimSpace(decodeUtf16(content)), []byte("<")) {
+			return nil, fmt.Errorf("policy export %s is neither a CLIXML file nor a zip archive, supported policy exports are %s", policyFile, supportedPolicyExportFormats)
+		}
+		return [][]byte{content}, nil
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read policy export archive %s: %w", policyFile, err)
+	}
+	files := archive.File
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	for _, file := range files {
+		if !strings.EqualFold(filepath.Ext(file.Name), ".xml") {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		document, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
+
+// decodeUtf16 converts a document with a UTF-16 byte order mark to UTF-8. Windows PowerShell writes CLIXML as UTF-16.
+func decodeUtf16(document []byte) []byte {
+	var byteOrder binary.ByteOrder
+	switch {
+	case bytes.HasPrefix(document, []byte{0xFF, 0xFE}):
+		byteOrder = binary.LittleEndian
+	case bytes.HasPrefix(document, []byte{0xFE, 0xFF}):
+		byteOrder = binary.BigEndian
+	default:
+		return bytes.TrimPrefix(document, []byte{0xEF, 0xBB, 0xBF})
+	}
+
+	document = document[2:]
+	units := make([]uint16, 0, len(document)/2)
+	for i := 0; i+1 < len(document); i += 2 {
+		units = append(units, byteOrder.Uint16(document[i:]))
+	}
+	return []byte(string(utf16.Decode(units)))
+}
+
+// parsePolicyExport reads the group policies, their settings and their filters from the CLIXML documents of a policy
+// export. Objects are recognized by their properties: filters have a FilterType, policies have a Priority, and the
+// remaining objects with a PolicyName hold the settings of the policy.
+func parsePolicyExport(documents [][]byte) ([]importedPolicy, error) {
+	policies := []*importedPolicy{}
+	policiesByName := map[string]*importedPolicy{}
+	getPolicy := func(name string) *importedPolicy {
+		key := strings.ToLower(name)
+		if policy, ok := policiesByName[key]; ok {
+			return policy
+		}
+		policy := &importedPolicy{name: name, enabled: true}
+		policiesByName[key] = policy
+		policies = append(policies, policy)
+		return policy
+	}
+
+	for _, document := range documents {
+		root := clixmlElement{}
+		decoder := xml.NewDecoder(bytes.NewReader(decodeUtf16(document)))
+		decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+			// The document is converted to UTF-8 before decoding, regardless of the declared encoding
+			return input, nil
+		}
+		if err := decoder.Decode(&root); err != nil {
+			return nil, fmt.Errorf("unable to read policy export: %w", err)
+		}
+
+		for _, object := range root.Children {
+			if object.XMLName.Local != "Obj" {
+				continue
+			}
+			properties := object.properties()
+			policyName := properties["PolicyName"].stringValue()
+			if policyName == "" {
+				continue
+			}
+			policy := getPolicy(policyName)
+
+			if filterType, ok := properties["FilterType"]; ok {
+				filter := importedPolicyFilter{
+					filterType: filterType.stringValue(),
+					value:      properties["FilterValue"].stringValue(),
+					enabled:    !strings.EqualFold(properties["Enabled"].stringValue(), "false"),
+					allowed:    !strings.EqualFold(properties["Mode"].stringValue(), "Deny"),
+				}
+				if !containsPolicyFilter(policy.filters, filter) {
+					policy.filters = append(policy.filters, filter)
+				}
+				continue
+			}
+
+			if priorityProperty, ok := properties["Priority"]; ok {
+				if description := properties["Description"].stringValue(); description != "" {
+					policy.description = description
+				}
+				policy.enabled = policy.enabled && !strings.EqualFold(properties["Enabled"].stringValue(), "false")
+				// The user and computer halves can have a different priority, the highest one is kept
+				if priority, err := strconv.Atoi(priorityProperty.stringValue()); err == nil && (policy.priority == 0 || priority < policy.priority) {
+					policy.priority = priority
+				}
+				continue
+			}
+
+			for _, property := range object.Children {
+				if property.XMLName.Local != "Props" && property.XMLName.Local != "MS" {
+					continue
+				}
+				for _, setting := range property.Children {
+					settingProperties := setting.properties()
+					state, ok := settingProperties["State"]
+					if setting.XMLName.Local != "Obj" || !ok {
+						continue
+					}
+					policy.settings = append(policy.settings, importedPolicySetting{
+						name:  setting.Name,
+						state: state.stringValue(),
+						value: settingProperties["Value"].stringValue(),
+					})
+				}
+			}
+		}
+	}
+
+	result := []importedPolicy{}
+	for _, policy := range policies {
+		result = append(result, *policy)
+	}
+	// Policies are listed from the highest to the lowest priority, policies without priority come last
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].priority == 0 || result[j].priority == 0 {
+			return result[j].priority == 0 && result[i].priority != 0
+		}
+		return result[i].priority < result[j].priority
+	})
+	return result, nil
+}
+
+func containsPolicyFilter(filters []importedPolicyFilter, filter importedPolicyFilter) bool {
+	for _, existing := range filters {
+		if strings.EqualFold(existing.filterType, filter.filterType) && existing.value == filter.value && existing.enabled == filter.enabled && existing.allowed == filter.allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// writePolicyExport writes the policy set with its policies, policy settings, policy filters and policy priority.
+// The returned warnings list the parts of the export that could not be translated, including the delivery groups of the
+// policy set, which are not part of the export.
+func writePolicyExport(b *strings.Builder, policySetName string, policies []importedPolicy) []string {
+	warnings := []string{
+		fmt.Sprintf("policy set %q is not assigned to any delivery group, none of its policies are applied until `delivery_groups` is set", policySetName),
+	}
+	usedNames := map[string]bool{}
+	usedDataNames := map[string]bool{}
+	dataSources := map[string]policyFilterDataSource{}
+
+	policySetResourceName := getUniqueResourceName(policySetName, map[string]bool{})
+	policySetReference := "citrix_policy_set_v2." + policySetResourceName + ".id"
+	fmt.Fprintf(b, "resource \"citrix_policy_set_v2\" %q {\n", policySetResourceName)
+	fmt.Fprintf(b, "name = %s\n", quoteString(policySetName))
+	b.WriteString("}\n\n")
+
+	policyReferences := []string{}
+	for _, policy := range policies {
+		policyResourceName := getUniqueResourceName(policy.name, usedNames)
+		policyReference := "citrix_policy." + policyResourceName + ".id"
+		policyReferences = append(policyReferences, policyReference)
+
+		fmt.Fprintf(b, "resource \"citrix_policy\" %q {\n", policyResourceName)
+		fmt.Fprintf(b, "policy_set_id = %s\n", policySetReference)
+		fmt.Fprintf(b, "name = %s\n", quoteString(policy.name))
+		if policy.description != "" {
+			fmt.Fprintf(b, "description = %s\n", quoteString(policy.description))
+		}
+		fmt.Fprintf(b, "enabled = %t\n", policy.enabled)
+		b.WriteString("}\n\n")
+
+		for _, setting := range policy.settings {
+			attributes, ok := getPolicySettingAttributes(setting)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(b, "resource \"citrix_policy_setting\" %q {\n", getUniqueResourceName(policyResourceName+"_"+setting.name, usedNames))
+			fmt.Fprintf(b, "policy_id = %s\n", policyReference)
+			fmt.Fprintf(b, "name = %s\n", quoteString(setting.name))
+			b.WriteString(attributes)
+			b.WriteString("}\n\n")
+		}
+
+		for _, filter := range policy.filters {
+			filterType, attributes, err := getPolicyFilterAttributes(filter, usedDataNames, dataSources)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("skipping filter of policy %q: %s", policy.name, err.Error()))
+				continue
+			}
+			fmt.Fprintf(b, "resource %q %q {\n", filterType, getUniqueResourceName(policyResourceName+"_"+strings.TrimPrefix(filterType, "citrix_"), usedNames))
+			fmt.Fprintf(b, "policy_id = %s\n", policyReference)
+			b.WriteString(attributes)
+			b.WriteString("}\n\n")
+		}
+	}
+
+	fmt.Fprintf(b, "resource \"citrix_policy_priority\" %q {\n", policySetResourceName)
+	fmt.Fprintf(b, "policy_set_id = %s\n", policySetReference)
+	fmt.Fprintf(b, "policy_priority = [\n%s,\n]\n", strings.Join(policyReferences, ",\n"))
+	b.WriteString("}\n")
+
+	dataSourceKeys := make([]string, 0, len(dataSources))
+	for key := range dataSources {
+		dataSourceKeys = append(dataSourceKeys, key)
+	}
+	sort.Strings(dataSourceKeys)
+	for _, key := range dataSourceKeys {
+		b.WriteString("\n")
+		b.WriteString(dataSources[key].block)
+	}
+
+	return warnings
+}
+
+// getPolicySettingAttributes returns the value attributes of a policy setting. Settings that are not configured are skipped.
+func getPolicySettingAttributes(setting importedPolicySetting) (string, bool) {
+	state := strings.ToLower(setting.state)
+	switch {
+	case state == "usedefault":
+		return "use_default = true\n", true
+	case setting.value != "":
+		return fmt.Sprintf("use_default = false\nvalue = %s\n", quoteString(setting.value)), true
+	case state == "enabled" || state == "allowed":
+		return "use_default = false\nenabled = true\n", true
+	case state == "disabled" || state == "prohibited":
+		return "use_default = false\nenabled = false\n", true
+	}
+	return "", false
+}
+
+// getPolicyFilterAttributes returns the resource type and the attributes of a policy filter. Delivery groups and tags
+// are referenced by name in the export, they are resolved with data sources added to dataSources.
+func getPolicyFilterAttributes(filter importedPolicyFilter, usedDataNames map[string]bool, dataSources map[string]policyFilterDataSource) (string, string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "allowed = %t\n", filter.allowed)
+
+	var filterType string
+	switch strings.ToLower(filter.filterType) {
+	case "accesscontrol":
+		parts := strings.SplitN(filter.value, ",", 3)
+		if len(parts) != 3 {
+			return "", "", fmt.Errorf("access control filter value %q is not in the format `{connection type},{gateway},{condition}`", filter.value)
+		}
+		filterType = "citrix_access_control_policy_filter"
+		fmt.Fprintf(&b, "enabled = %t\nconnection_type = %s\ngateway = %s\ncondition = %s\n", filter.enabled, quoteString(parts[0]), quoteString(parts[1]), quoteString(parts[2]))
+	case "branchrepeater":
+		filterType = "citrix_branch_repeater_policy_filter"
+	case "clientip":
+		filterType = "citrix_client_ip_policy_filter"
+		fmt.Fprintf(&b, "enabled = %t\nip_address = %s\n", filter.enabled, quoteString(filter.value))
+	case "clientname":
+		filterType = "citrix_client_name_policy_filter"
+		fmt.Fprintf(&b, "enabled = %t\nclient_name = %s\n", filter.enabled, quoteString(filter.value))
+	case "clientplatform":
+		filterType = "citrix_client_platform_policy_filter"
+		fmt.Fprintf(&b, "enabled = %t\nplatform = %s\n", filter.enabled, quoteString(filter.value))
+	case "desktopgroup":
+		filterType = "citrix_delivery_group_policy_filter"
+		reference := getPolicyFilterDataSource("citrix_delivery_group", filter.value, usedDataNames, dataSources)
+		fmt.Fprintf(&b, "enabled = %t\ndelivery_group_id = %s\n", filter.enabled, reference)
+	case "desktopkind":
+		filterType = "citrix_delivery_group_type_policy_filter"
+		fmt.Fprintf(&b, "enabled = %t\ndelivery_group_type = %s\n", filter.enabled, quoteString(filter.value))
+	case "desktoptag":
+		filterType = "citrix_tag_policy_filter"
+		reference := getPolicyFilterDataSource("citrix_tag", filter.value, usedDataNames, dataSources)
+		fmt.Fprintf(&b, "enabled = %t\ntag = %s\n", filter.enabled, reference)
+	case "ou":
+		filterType = "citrix_ou_policy_filter"
+		fmt.Fprintf(&b, "enabled = %t\nou = %s\n", filter.enabled, quoteString(filter.value))
+	case "user":
+		// The SID can only be resolved from the account name by a domain controller, which the export does not contact
+		if !strings.HasPrefix(strings.ToUpper(filter.value), "S-1-") {
+			return "", "", fmt.Errorf("user filter for account %s has no SID, add a citrix_user_policy_filter with the SID of the account", filter.value)
+		}
+		filterType = "citrix_user_policy_filter"
+		fmt.Fprintf(&b, "enabled = %t\nsid = %s\n", filter.enabled, quoteString(filter.value))
+	default:
+		return "", "", fmt.Errorf("filter type %q is not supported", filter.filterType)
+	}
+	return filterType, b.String(), nil
+}
+
+// getPolicyFilterDataSource adds a data source looking up a delivery group or a tag by name, and returns the reference to its id.
+// Delivery group names can include their folder path, such as `Finance\Desktops`.
+func getPolicyFilterDataSource(dataSourceType string, name string, usedDataNames map[string]bool, dataSources map[string]policyFilterDataSource) string {
+	key := dataSourceType + "/" + strings.ToLower(name)
+	if dataSource, ok := dataSources[key]; ok {
+		return dataSource.reference
+	}
+
+	dataName := getUniqueResourceName(name, usedDataNames)
+	var b strings.Builder
+	fmt.Fprintf(&b, "data %q %q {\n", dataSourceType, dataName)
+	if folderIndex := strings.LastIndex(name, "\\"); dataSourceType == "citrix_delivery_group" && folderIndex > 0 {
+		fmt.Fprintf(&b, "name = %s\n", quoteString(name[folderIndex+1:]))
+		fmt.Fprintf(&b, "delivery_group_folder_path = %s\n", quoteString(name[:folderIndex]))
+	} else {
+		fmt.Fprintf(&b, "name = %s\n", quoteString(name))
+	}
+	b.WriteString("}\n")
+	reference := "data." + dataSourceType + "." + dataName + ".id"
+	dataSources[key] = policyFilterDataSource{reference: reference, block: b.String()}
+	return reference
+}
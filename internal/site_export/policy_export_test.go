@@ -0,0 +1,272 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package site_export
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+const testGroupPolicies = `<Objs Version="1.1.0.1" xmlns="http://schemas.microsoft.com/powershell/2004/04">
+  <Obj RefId="0">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">User</S>
+      <S N="Description">Finance users_x000A_second line</S>
+      <B N="Enabled">true</B>
+      <I32 N="Priority">1</I32>
+    </Props>
+  </Obj>
+  <Obj RefId="1">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">Computer</S>
+      <Nil N="Description" />
+      <B N="Enabled">true</B>
+      <I32 N="Priority">2</I32>
+    </Props>
+  </Obj>
+  <Obj RefId="2">
+    <Props>
+      <S N="PolicyName">Unfiltered</S>
+      <S N="Type">User</S>
+      <B N="Enabled">false</B>
+      <I32 N="Priority">3</I32>
+    </Props>
+  </Obj>
+</Objs>`
+
+const testGroupPolicyConfiguration = `<?xml version="1.0" encoding="utf-16"?>
+<Objs Version="1.1.0.1" xmlns="http://schemas.microsoft.com/powershell/2004/04">
+  <Obj RefId="0">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">User</S>
+      <Obj N="ClipboardRedirection" RefId="1">
+        <Props><S N="State">Prohibited</S><Nil N="Value" /></Props>
+      </Obj>
+      <Obj N="AudioQuality" RefId="2">
+        <Props><S N="State">Enabled</S><S N="Value">High</S></Props>
+      </Obj>
+      <Obj N="ClientPrinterRedirection" RefId="3">
+        <Props><S N="State">UseDefault</S></Props>
+      </Obj>
+      <Obj N="AutoCreationEventLogPreference" RefId="4">
+        <Props><S N="State">NotConfigured</S></Props>
+      </Obj>
+    </Props>
+  </Obj>
+</Objs>`
+
+const testGroupPolicyFilters = `<Objs Version="1.1.0.1" xmlns="http://schemas.microsoft.com/powershell/2004/04">
+  <Obj RefId="0">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">User</S>
+      <S N="FilterName">Finance desktops</S>
+      <S N="FilterType">DesktopGroup</S>
+      <S N="FilterValue">Finance\Desktops</S>
+      <B N="Enabled">true</B>
+      <S N="Mode">Allow</S>
+    </Props>
+  </Obj>
+  <Obj RefId="1">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">Computer</S>
+      <S N="FilterName">Finance desktops</S>
+      <S N="FilterType">DesktopGroup</S>
+      <S N="FilterValue">Finance\Desktops</S>
+      <B N="Enabled">true</B>
+      <S N="Mode">Allow</S>
+    </Props>
+  </Obj>
+  <Obj RefId="2">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">User</S>
+      <S N="FilterType">User</S>
+      <S N="FilterValue">CONTOSO\Contractors</S>
+      <B N="Enabled">true</B>
+      <S N="Mode">Deny</S>
+    </Props>
+  </Obj>
+  <Obj RefId="3">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">User</S>
+      <S N="FilterType">User</S>
+      <S N="FilterValue">S-1-5-21-1004336348-1177238915-682003330-1104</S>
+      <B N="Enabled">true</B>
+      <S N="Mode">Allow</S>
+    </Props>
+  </Obj>
+  <Obj RefId="4">
+    <Props>
+      <S N="PolicyName">Finance</S>
+      <S N="Type">User</S>
+      <S N="FilterType">WorkerGroup</S>
+      <S N="FilterValue">Servers</S>
+      <B N="Enabled">true</B>
+      <S N="Mode">Allow</S>
+    </Props>
+  </Obj>
+</Objs>`
+
+// encodeUtf16 encodes a document the way Windows PowerShell writes CLIXML, as UTF-16 with a byte order mark.
+func encodeUtf16(document string) []byte {
+	encoded := []byte{0xFF, 0xFE}
+	for _, unit := range utf16.Encode([]rune(document)) {
+		encoded = binary.LittleEndian.AppendUint16(encoded, unit)
+	}
+	return encoded
+}
+
+func TestParsePolicyExport(t *testing.T) {
+	t.Parallel()
+
+	policies, err := parsePolicyExport([][]byte{[]byte(testGroupPolicies), encodeUtf16(testGroupPolicyConfiguration), []byte(testGroupPolicyFilters)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(policies) != 2 {
+		t.Fatalf("expected the user and computer policies to be merged into 2 policies, got %d", len(policies))
+	}
+
+	finance := policies[0]
+	if finance.name != "Finance" || finance.priority != 1 || !finance.enabled || finance.description != "Finance users\nsecond line" {
+		t.Errorf("unexpected policy %+v", finance)
+	}
+	if len(finance.settings) != 4 {
+		t.Errorf("expected 4 settings, got %d", len(finance.settings))
+	}
+	if len(finance.filters) != 4 {
+		t.Errorf("expected the duplicate delivery group filter to be merged into 4 filters, got %d", len(finance.filters))
+	}
+	if policies[1].name != "Unfiltered" || policies[1].enabled {
+		t.Errorf("unexpected policy %+v", policies[1])
+	}
+}
+
+func TestWritePolicyExport(t *testing.T) {
+	t.Parallel()
+
+	policies, err := parsePolicyExport([][]byte{[]byte(testGroupPolicies), []byte(strings.Replace(testGroupPolicyConfiguration, `encoding="utf-16"`, `encoding="utf-8"`, 1)), []byte(testGroupPolicyFilters)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var b strings.Builder
+	warnings := writePolicyExport(&b, "Migrated Policies", policies)
+	content := b.String()
+
+	if _, diags := hclsyntax.ParseConfig([]byte(content), "policies.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("generated configuration is not valid HCL: %s\n%s", diags.Error(), content)
+	}
+	expectedWarnings := []string{
+		"policy set \"Migrated Policies\" is not assigned to any delivery group",
+		"user filter for account CONTOSO\\Contractors has no SID",
+		"filter type \"WorkerGroup\" is not supported",
+	}
+	if len(warnings) != len(expectedWarnings) {
+		t.Fatalf("expected %d warnings, got %v", len(expectedWarnings), warnings)
+	}
+	for i, expected := range expectedWarnings {
+		if !strings.Contains(warnings[i], expected) {
+			t.Errorf("expected warning %d to contain %q, got %q", i, expected, warnings[i])
+		}
+	}
+
+	expected := []string{
+		"resource \"citrix_policy_set_v2\" \"migrated_policies\"",
+		"resource \"citrix_policy_setting\" \"finance_clipboardredirection\" {\npolicy_id = citrix_policy.finance.id\nname = \"ClipboardRedirection\"\nuse_default = false\nenabled = false\n}",
+		"name = \"AudioQuality\"\nuse_default = false\nvalue = \"High\"\n",
+		"name = \"ClientPrinterRedirection\"\nuse_default = true\n",
+		"delivery_group_id = data.citrix_delivery_group.finance_desktops.id",
+		"data \"citrix_delivery_group\" \"finance_desktops\" {\nname = \"Desktops\"\ndelivery_group_folder_path = \"Finance\"\n}",
+		"allowed = true\nenabled = true\nsid = \"S-1-5-21-1004336348-1177238915-682003330-1104\"\n",
+		"policy_priority = [\ncitrix_policy.finance.id,\ncitrix_policy.unfiltered.id,\n]",
+	}
+	for _, snippet := range expected {
+		if !strings.Contains(content, snippet) {
+			t.Errorf("expected the configuration to contain %q, got:\n%s", snippet, content)
+		}
+	}
+	if strings.Contains(content, "AutoCreationEventLogPreference") {
+		t.Errorf("expected settings that are not configured to be skipped")
+	}
+	if strings.Contains(content, "TODO") || strings.Contains(content, "Contractors") {
+		t.Errorf("expected the untranslated parts of the export to be reported as warnings only, got:\n%s", content)
+	}
+}
+
+func TestReadPolicyExportDocuments(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	exportDir := filepath.Join(dir, "GroupPolicyExport")
+	if err := os.Mkdir(exportDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		filepath.Join(exportDir, "GroupPolicies.xml"):            []byte(testGroupPolicies),
+		filepath.Join(exportDir, "GroupPolicyConfiguration.xml"): encodeUtf16(testGroupPolicyConfiguration),
+		filepath.Join(exportDir, "readme.txt"):                   []byte("not a policy document"),
+		filepath.Join(dir, "GroupPolicies.xml"):                  encodeUtf16(testGroupPolicies),
+		filepath.Join(dir, "Policies.gpf"):                       []byte("Citrix Studio policy export"),
+		filepath.Join(dir, "Policies.bin"):                       {0x00, 0x01, 0x02},
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, content, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string]struct {
+		policyFile        string
+		expectedDocuments int
+		expectedError     string
+	}{
+		"folder": {
+			policyFile:        exportDir,
+			expectedDocuments: 2,
+		},
+		"clixml file": {
+			policyFile:        filepath.Join(dir, "GroupPolicies.xml"),
+			expectedDocuments: 1,
+		},
+		"studio export": {
+			policyFile:    filepath.Join(dir, "Policies.gpf"),
+			expectedError: "Citrix Studio .gpf export, which is not supported",
+		},
+		"unknown file": {
+			policyFile:    filepath.Join(dir, "Policies.bin"),
+			expectedError: "neither a CLIXML file nor a zip archive",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			documents, err := readPolicyExportDocuments(test.policyFile)
+			if test.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+					t.Fatalf("expected an error containing %q, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(documents) != test.expectedDocuments {
+				t.Errorf("expected %d documents, got %d", test.expectedDocuments, len(documents))
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package site_export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	citrixprovider "github.com/citrix/terraform-provider-citrix/internal/provider"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Options configures a site export.
+type Options struct {
+	// OutputDir is the directory the generated .tf files are written to.
+	OutputDir string
+	// ResourceTypes limits the export to the given resource types. All resource types with a list resource are exported when empty.
+	ResourceTypes []string
+	// NameRegex limits the export to the objects with a matching name.
+	NameRegex string
+	// PolicyFile is a Citrix group policy export to translate into policy set configuration instead of exporting the site.
+	PolicyFile string
+	// PolicySetName is the name of the policy set the group policies of PolicyFile are added to. Defaults to the file name.
+	PolicySetName string
+}
+
+// exportedResource is a site object read through the resource implementation of the provider.
+type exportedResource struct {
+	typeName string
+	name     string
+	id       string
+	schema   schema.Schema
+	state    tftypes.Value
+}
+
+// Run connects to the site configured with the CITRIX_* environment variables, reads every object of the selected
+// resource types and writes one .tf file per resource type, containing the resource configuration and its import block.
+func Run(ctx context.Context, version string, options Options) error {
+	if options.PolicyFile != "" {
+		return runPolicyExport(options)
+	}
+
+	client, diags := citrixprovider.NewDaaSClientFromEnvironment(ctx, version)
+	if diags.HasError() {
+		return util.DiagnosticsToError(diags)
+	}
+
+	p := citrixprovider.New(version)()
+	metadataResp := &provider.MetadataResponse{}
+	p.Metadata(ctx, provider.MetadataRequest{}, metadataResp)
+
+	newResources := map[string]func() resource.Resource{}
+	for _, newResource := range p.Resources(ctx) {
+		typeName := util.GetResourceTypeName(ctx, metadataResp.TypeName, newResource())
+		newResources[typeName] = newResource
+	}
+
+	providerWithListResources, ok := p.(provider.ProviderWithListResources)
+	if !ok {
+		return fmt.Errorf("the provider does not implement list resources")
+	}
+
+	resourcesByType := map[string][]exportedResource{}
+	typeNames := []string{}
+	exportableTypeNames := map[string]bool{}
+	for _, newListResource := range providerWithListResources.ListResources(ctx) {
+		listResource := newListResource()
+		typeName := util.GetResourceTypeName(ctx, metadataResp.TypeName, listResource)
+		newResource, ok := newResources[typeName]
+		if !ok {
+			continue
+		}
+		exportableTypeNames[typeName] = true
+
+		if len(options.ResourceTypes) > 0 && !slices.Contains(options.ResourceTypes, typeName) {
+			continue
+		}
+
+		exportedResources, err := readResources(ctx, client, typeName, listResource, newResource, options.NameRegex)
+		if err != nil {
+			return err
+		}
+		if len(exportedResources) == 0 {
+			continue
+		}
+		resourcesByType[typeName] = exportedResources
+		typeNames = append(typeNames, typeName)
+	}
+
+	for _, resourceType := range options.ResourceTypes {
+		if !exportableTypeNames[resourceType] {
+			fmt.Fprintf(os.Stderr, "Warning: resource type %s is not supported by the export and was skipped\n", resourceType)
+		}
+	}
+
+	// Site objects that reference each other by id are written as Terraform references when both are exported
+	references := map[string]string{}
+	for _, typeName := range typeNames {
+		for _, exported := range resourcesByType[typeName] {
+			if util.IsValidUUID(exported.id) {
+				references[strings.ToLower(exported.id)] = exported.typeName + "." + exported.name + ".id"
+			}
+		}
+	}
+
+	if err := os.MkdirAll(options.OutputDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, typeName := range typeNames {
+		var content strings.Builder
+		for _, exported := range resourcesByType[typeName] {
+			writeImportBlock(&content, exported)
+			writeResourceBlock(&content, exported, references)
+		}
+
+		fileName := filepath.Join(options.OutputDir, typeName+".tf")
+		if err := os.WriteFile(fileName, hclwrite.Format([]byte(content.String())), 0o600); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "Exported %d %s resource(s) to %s\n", len(resourcesByType[typeName]), typeName, fileName)
+	}
+
+	return nil
+}
+
+// readResources lists the objects of a resource type and reads each of them through the resource implementation,
+// the same way `terraform query` does.
+func readResources(ctx context.Context, client *citrixclient.CitrixDaasClient, typeName string, listResource list.ListResource, newResource func() resource.Resource, nameRegex string) ([]exportedResource, error) {
+	if listResourceWithConfigure, ok := listResource.(list.ListResourceWithConfigure); ok {
+		listResourceWithConfigure.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+	}
+
+	r := newResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	resourceWithIdentity, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		return nil, fmt.Errorf("resource type %s does not define a resource identity", typeName)
+	}
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	resourceWithIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+
+	configSchemaResp := &list.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configSchemaResp)
+
+	configType := configSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object) //nolint:forcetypeassert // schema type is always an object
+	configValues := map[string]tftypes.Value{}
+	for attributeName, attributeType := range configType.AttributeTypes {
+		configValues[attributeName] = tftypes.NewValue(attributeType, nil)
+	}
+	if _, ok := configValues["name_regex"]; ok && nameRegex != "" {
+		configValues["name_regex"] = tftypes.NewValue(tftypes.String, nameRegex)
+	}
+	config := tfsdk.Config{
+		Schema: configSchemaResp.Schema,
+		Raw:    tftypes.NewValue(configType, configValues),
+	}
+
+	req := list.ListRequest{
+		Config:                 config,
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+	stream := &list.ListResultsStream{}
+	listResource.List(ctx, req, stream)
+
+	exportedResources := []exportedResource{}
+	usedNames := map[string]bool{}
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			if result.Identity == nil {
+				return nil, fmt.Errorf("error listing %s: %w", typeName, util.DiagnosticsToError(result.Diagnostics))
+			}
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s %q: %s\n", typeName, result.DisplayName, util.DiagnosticsToError(result.Diagnostics))
+			continue
+		}
+
+		var id types.String
+		if diags := result.Identity.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+			return nil, util.DiagnosticsToError(diags)
+		}
+
+		exportedResources = append(exportedResources, exportedResource{
+			typeName: typeName,
+			name:     getUniqueResourceName(result.DisplayName, usedNames),
+			id:       id.ValueString(),
+			schema:   schemaResp.Schema,
+			state:    result.Resource.Raw,
+		})
+	}
+
+	sort.SliceStable(exportedResources, func(i, j int) bool {
+		return exportedResources[i].name < exportedResources[j].name
+	})
+
+	return exportedResources, nil
+}
@@ -0,0 +1,220 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package site_export
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// getUniqueResourceName converts the display name of a site object into a Terraform resource name that is unique within its resource type.
+func getUniqueResourceName(displayName string, usedNames map[string]bool) string {
+	name := invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(displayName), "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		name = "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	uniqueName := name
+	for i := 2; usedNames[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s_%d", name, i)
+	}
+	usedNames[uniqueName] = true
+	return uniqueName
+}
+
+func writeImportBlock(b *strings.Builder, exported exportedResource) {
+	fmt.Fprintf(b, "import {\nto = %s.%s\nid = %s\n}\n\n", exported.typeName, exported.name, quoteString(exported.id))
+}
+
+func writeResourceBlock(b *strings.Builder, exported exportedResource, references map[string]string) {
+	fmt.Fprintf(b, "resource %q %q {\n", exported.typeName, exported.name)
+	writeAttributes(b, exported.schema.Attributes, exported.state, references, strings.ToLower(exported.id))
+	b.WriteString("}\n\n")
+}
+
+// writeAttributes writes the configurable attributes of an object value. Computed only and deprecated attributes are
+// left out, as are null values. Required sensitive attributes are not returned by the API, so a comment is written instead.
+func writeAttributes(b *strings.Builder, attributes map[string]schema.Attribute, value tftypes.Value, references map[string]string, selfId string) {
+	values := map[string]tftypes.Value{}
+	if err := value.As(&values); err != nil {
+		return
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attribute := attributes[name]
+		if !attribute.IsRequired() && !attribute.IsOptional() {
+			continue
+		}
+		if attribute.GetDeprecationMessage() != "" {
+			continue
+		}
+
+		attributeValue, ok := values[name]
+		if !ok || attributeValue.IsNull() || !attributeValue.IsKnown() {
+			if attribute.IsRequired() {
+				fmt.Fprintf(b, "# TODO: set %s, its value is not returned by the API\n", name)
+			}
+			continue
+		}
+
+		fmt.Fprintf(b, "%s = %s\n", name, renderAttributeValue(attribute, attributeValue, references, selfId))
+	}
+}
+
+func renderAttributeValue(attribute schema.Attribute, value tftypes.Value, references map[string]string, selfId string) string {
+	switch nestedAttribute := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return renderObject(nestedAttribute.Attributes, value, references, selfId)
+	case schema.ListNestedAttribute:
+		return renderNestedObjects(nestedAttribute.NestedObject.Attributes, value, references, selfId)
+	case schema.SetNestedAttribute:
+		return renderNestedObjects(nestedAttribute.NestedObject.Attributes, value, references, selfId)
+	case schema.MapNestedAttribute:
+		elements := map[string]tftypes.Value{}
+		if err := value.As(&elements); err != nil {
+			return "null"
+		}
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, key := range sortedKeys(elements) {
+			fmt.Fprintf(&b, "%s = %s\n", quoteString(key), renderObject(nestedAttribute.NestedObject.Attributes, elements[key], references, selfId))
+		}
+		b.WriteString("}")
+		return b.String()
+	}
+
+	return renderValue(value, references, selfId)
+}
+
+func renderObject(attributes map[string]schema.Attribute, value tftypes.Value, references map[string]string, selfId string) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	writeAttributes(&b, attributes, value, references, selfId)
+	b.WriteString("}")
+	return b.String()
+}
+
+func renderNestedObjects(attributes map[string]schema.Attribute, value tftypes.Value, references map[string]string, selfId string) string {
+	elements := []tftypes.Value{}
+	if err := value.As(&elements); err != nil {
+		return "null"
+	}
+	if len(elements) == 0 {
+		return "[]"
+	}
+
+	var b strings.Builder
+	b.WriteString("[\n")
+	for _, element := range elements {
+		b.WriteString(renderObject(attributes, element, references, selfId))
+		b.WriteString(",\n")
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// renderValue renders a primitive or collection value. Strings holding the id of another exported site object are
+// rendered as a reference to that resource.
+func renderValue(value tftypes.Value, references map[string]string, selfId string) string {
+	if value.IsNull() || !value.IsKnown() {
+		return "null"
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		if reference, ok := references[strings.ToLower(s)]; ok && !strings.EqualFold(s, selfId) {
+			return reference
+		}
+		return quoteString(s)
+	case valueType.Is(tftypes.Number):
+		n := new(big.Float)
+		_ = value.As(&n)
+		return n.Text('f', -1)
+	case valueType.Is(tftypes.Bool):
+		var v bool
+		_ = value.As(&v)
+		return fmt.Sprintf("%t", v)
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		elements := []tftypes.Value{}
+		_ = value.As(&elements)
+		rendered := make([]string, 0, len(elements))
+		for _, element := range elements {
+			rendered = append(rendered, renderValue(element, references, selfId))
+		}
+		return "[" + strings.Join(rendered, ", ") + "]"
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		elements := map[string]tftypes.Value{}
+		_ = value.As(&elements)
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, key := range sortedKeys(elements) {
+			if elements[key].IsNull() {
+				continue
+			}
+			fmt.Fprintf(&b, "%s = %s\n", quoteString(key), renderValue(elements[key], references, selfId))
+		}
+		b.WriteString("}")
+		return b.String()
+	}
+
+	return "null"
+}
+
+// quoteString renders s as an HCL quoted string, escaping template sequences so they are kept literally.
+func quoteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, c := range s {
+		switch c {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteRune(c)
+			if strings.HasPrefix(s[i+1:], "{") {
+				b.WriteRune(c)
+			}
+		default:
+			b.WriteRune(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func sortedKeys(m map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,130 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package site_export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGetUniqueResourceName(t *testing.T) {
+	t.Parallel()
+	usedNames := map[string]bool{}
+	testCases := []struct {
+		displayName string
+		expected    string
+	}{
+		{"Finance Catalog", "finance_catalog"},
+		{"Finance-Catalog", "finance_catalog_2"},
+		{"2024 Pool", "_2024_pool"},
+		{"***", "resource"},
+		{"\\Folder\\App", "folder_app"},
+	}
+
+	for _, tc := range testCases {
+		if actual := getUniqueResourceName(tc.displayName, usedNames); actual != tc.expected {
+			t.Errorf("getUniqueResourceName(%q) = %q, expected %q", tc.displayName, actual, tc.expected)
+		}
+	}
+}
+
+func TestQuoteString(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		value    string
+		expected string
+	}{
+		"plain":    {"catalog", `"catalog"`},
+		"escapes":  {"a\"b\\c\nd", `"a\"b\\c\nd"`},
+		"template": {"${var} %{if} $5 100%", `"$${var} %%{if} $5 100%"`},
+	}
+
+	for name, tc := range testCases {
+		if actual := quoteString(tc.value); actual != tc.expected {
+			t.Errorf("%s: quoteString(%q) = %s, expected %s", name, tc.value, actual, tc.expected)
+		}
+	}
+}
+
+func TestWriteResourceBlockResolvesReferences(t *testing.T) {
+	t.Parallel()
+	selfId := "5A2F6E3C-0B4B-4F7C-9E0B-1D2C3B4A5F60"
+	catalogId := "0C1D2E3F-4A5B-4C6D-8E7F-9A0B1C2D3E4F"
+	zoneId := "7E6D5C4B-3A29-4180-9F8E-7D6C5B4A3928"
+	unknownId := "11111111-2222-4333-8444-555555555555"
+	references := map[string]string{
+		strings.ToLower(selfId):    "citrix_delivery_group.finance.id",
+		strings.ToLower(catalogId): "citrix_machine_catalog.finance.id",
+		strings.ToLower(zoneId):    "citrix_zone.primary.id",
+	}
+
+	settingsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"zone": tftypes.String}}
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":           tftypes.String,
+		"name":         tftypes.String,
+		"description":  tftypes.String,
+		"catalog_ids":  tftypes.List{ElementType: tftypes.String},
+		"settings":     settingsType,
+		"scope_ids":    tftypes.Set{ElementType: tftypes.String},
+		"ignored_note": tftypes.String,
+	}}
+	state := tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, selfId),
+		"name": tftypes.NewValue(tftypes.String, "Finance"),
+		// The object's own id is kept literally, so that a resource never references itself.
+		"description": tftypes.NewValue(tftypes.String, selfId),
+		"catalog_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, strings.ToLower(catalogId)),
+			tftypes.NewValue(tftypes.String, unknownId),
+		}),
+		"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+			"zone": tftypes.NewValue(tftypes.String, zoneId),
+		}),
+		"scope_ids":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		"ignored_note": tftypes.NewValue(tftypes.String, catalogId),
+	})
+
+	exported := exportedResource{
+		typeName: "citrix_delivery_group",
+		name:     "finance",
+		id:       selfId,
+		schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":          schema.StringAttribute{Computed: true},
+				"name":        schema.StringAttribute{Required: true},
+				"description": schema.StringAttribute{Optional: true},
+				"catalog_ids": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+				"settings": schema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]schema.Attribute{
+						"zone": schema.StringAttribute{Required: true},
+					},
+				},
+				"scope_ids":    schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				"ignored_note": schema.StringAttribute{Optional: true, DeprecationMessage: "Deprecated"},
+			},
+		},
+		state: state,
+	}
+
+	var b strings.Builder
+	writeResourceBlock(&b, exported, references)
+
+	expected := `resource "citrix_delivery_group" "finance" {
+catalog_ids = [citrix_machine_catalog.finance.id, "` + unknownId + `"]
+description = "` + selfId + `"
+name = "Finance"
+settings = {
+zone = citrix_zone.primary.id
+}
+}
+
+`
+	if actual := b.String(); actual != expected {
+		t.Errorf("writeResourceBlock() =\n%s\nexpected\n%s", actual, expected)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/storefront/stf_deployment"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
@@ -74,6 +75,7 @@ func (r *stfAuthenticationServiceResource) Schema(_ context.Context, _ resource.
 // Create implements resource.Resource.
 func (r *stfAuthenticationServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from plan
 	var plan STFAuthenticationServiceResourceModel
@@ -187,6 +189,7 @@ func (r *stfAuthenticationServiceResource) Create(ctx context.Context, req resou
 // Read implements resource.Resource.
 func (r *stfAuthenticationServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Get current state
 	var state STFAuthenticationServiceResourceModel
@@ -264,6 +267,7 @@ func (r *stfAuthenticationServiceResource) Read(ctx context.Context, req resourc
 // Update implements resource.Resource.
 func (r *stfAuthenticationServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from plan
 	var plan STFAuthenticationServiceResourceModel
@@ -423,6 +427,7 @@ func (r *stfAuthenticationServiceResource) Update(ctx context.Context, req resou
 // Delete implements resource.Resource.
 func (r *stfAuthenticationServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Get current state
 	var state STFAuthenticationServiceResourceModel
@@ -10,6 +10,7 @@ import (
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -107,6 +108,7 @@ func (r *stfDeploymentResource) Configure(_ context.Context, req resource.Config
 // Create creates the resource and sets the initial Terraform state.
 func (r *stfDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 	// Retrieve values from plan
 	var plan STFDeploymentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -201,6 +203,7 @@ func (r *stfDeploymentResource) Create(ctx context.Context, req resource.CreateR
 // Read refreshes the Terraform state with the latest data.
 func (r *stfDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Get current state
 	var state STFDeploymentResourceModel
@@ -266,6 +269,7 @@ func (r *stfDeploymentResource) Read(ctx context.Context, req resource.ReadReque
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *stfDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from plan
 	var plan STFDeploymentResourceModel
@@ -373,6 +377,7 @@ func (r *stfDeploymentResource) Update(ctx context.Context, req resource.UpdateR
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *stfDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from state
 	var state STFDeploymentResourceModel
@@ -9,6 +9,7 @@ import (
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -73,6 +74,7 @@ func (r *stfUserFarmMappingResource) Schema(_ context.Context, _ resource.Schema
 // Create implements resource.Resource.
 func (r *stfUserFarmMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from plan
 	var plan STFUserFarmMappingResourceModel
@@ -126,6 +128,7 @@ func (r *stfUserFarmMappingResource) Create(ctx context.Context, req resource.Cr
 // Read implements resource.ResourceWithConfigure.
 func (r *stfUserFarmMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Get current state
 	var state STFUserFarmMappingResourceModel
@@ -182,6 +185,7 @@ func (r *stfUserFarmMappingResource) Update(_ context.Context, _ resource.Update
 // Delete implements resource.ResourceWithConfigure.
 func (r *stfUserFarmMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from state
 	var state STFUserFarmMappingResourceModel
@@ -8,6 +8,7 @@ import (
 
 	citrixstorefrontModels "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 )
@@ -47,6 +48,7 @@ func (d *STFRoamingServiceDataSource) Configure(ctx context.Context, req datasou
 // Read implements datasource.DataSource.
 func (d *STFRoamingServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, d.client)()
 
 	var data STFRoamingServiceDataSourceModel
 
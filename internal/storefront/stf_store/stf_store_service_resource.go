@@ -10,6 +10,7 @@ import (
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/storefront/stf_deployment"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -87,6 +88,7 @@ func (r *stfStoreServiceResource) ModifyPlan(ctx context.Context, req resource.M
 // Create creates the resource and sets the initial Terraform state.
 func (r *stfStoreServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from plan
 	var plan STFStoreServiceResourceModel
@@ -296,6 +298,7 @@ func (r *stfStoreServiceResource) Create(ctx context.Context, req resource.Creat
 // Read refreshes the Terraform state with the latest data.
 func (r *stfStoreServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Get current state
 	var state STFStoreServiceResourceModel
@@ -429,6 +432,7 @@ func (r *stfStoreServiceResource) Read(ctx context.Context, req resource.ReadReq
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *stfStoreServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from plan
 	var plan STFStoreServiceResourceModel
@@ -663,6 +667,7 @@ func (r *stfStoreServiceResource) Update(ctx context.Context, req resource.Updat
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *stfStoreServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from state
 	var state STFStoreServiceResourceModel
@@ -11,6 +11,7 @@ import (
 
 	citrixstorefront "github.com/citrix/citrix-daas-rest-go/citrixstorefront/models"
 	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -58,6 +59,7 @@ func (*stfXenappDefaultStoreResource) Schema(_ context.Context, _ resource.Schem
 // Create creates the resource and sets the initial Terraform state.
 func (r *stfXenappDefaultStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from plan
 	var plan STFXenappDefaultStoreResourceModel
@@ -120,6 +122,7 @@ func (r *stfXenappDefaultStoreResource) Create(ctx context.Context, req resource
 // Read refreshes the Terraform state with the latest data.
 func (r *stfXenappDefaultStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Get current state
 	var state STFXenappDefaultStoreResourceModel
@@ -169,6 +172,7 @@ func (r *stfXenappDefaultStoreResource) Read(ctx context.Context, req resource.R
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *stfXenappDefaultStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 	// Retrieve values from plan
 	var plan STFXenappDefaultStoreResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -230,6 +234,7 @@ func (r *stfXenappDefaultStoreResource) Update(ctx context.Context, req resource
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *stfXenappDefaultStoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	defer util.PanicHandler(&resp.Diagnostics)
+	defer middleware.LimitStoreFrontOperation(ctx, r.client)()
 
 	// Retrieve values from state
 	var state STFXenappDefaultStoreResourceModel
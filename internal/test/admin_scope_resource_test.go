@@ -12,6 +12,7 @@ import (
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -56,6 +57,8 @@ func TestAdminScopeResourcePreCheck(t *testing.T) {
 }
 
 func TestAdminScopeResource(t *testing.T) {
+	fakeorchestration.Start(t)
+
 	name := os.Getenv("TEST_ADMIN_SCOPE_NAME")
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -0,0 +1,344 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+// Package cassette records the Citrix API traffic of the acceptance tests and replays it without a live site.
+//
+// Set CITRIX_TEST_CASSETTE_MODE to `record` to run a test against a live site and save its requests and responses to
+// internal/test/testdata/cassettes/<test name>.json, or to `replay` to run the test against the saved cassette with no
+// network access. Tests run against the live site without a cassette when CITRIX_TEST_CASSETTE_MODE is not set.
+// Requests are replayed by method, path and request body, where JSON bodies are compared regardless of formatting and
+// property order.
+//
+// The StoreFront resources run PowerShell commands on the StoreFront server instead of calling an HTTP API, so their
+// tests cannot be recorded.
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/citrix/terraform-provider-citrix/internal/middleware"
+)
+
+const (
+	ModeEnvironmentVariable = "CITRIX_TEST_CASSETTE_MODE"
+	ModeRecord              = "record"
+	ModeReplay              = "replay"
+
+	customerIdPlaceholder    = "{customerId}"
+	transactionIdPlaceholder = "00000000-0000-0000-0000-000000000000"
+	redactedValue            = "REDACTED"
+)
+
+// CassetteDirectory is the directory the cassettes are saved to, relative to the internal/test package.
+var CassetteDirectory = filepath.Join("testdata", "cassettes")
+
+var (
+	secretJsonPropertyRegex = regexp.MustCompile(`(?i)("[a-z_]*(?:password|secret|token|sessionid)[a-z_]*"\s*:\s*)"[^"]*"`)
+	secretEnvironmentRegex  = regexp.MustCompile(`(?i)(PASS|SECRET|TOKEN|KEY)`)
+	recordedResponseHeaders = []string{"Content-Type", "Location", "Retry-After"}
+	mutex                   sync.Mutex
+)
+
+// Cassette holds the recorded interactions of a test.
+type Cassette struct {
+	// OnPremises is whether the cassette was recorded against an on-premises site.
+	OnPremises bool `json:"on_premises"`
+	// Environment holds the TEST_* environment variables the test was recorded with. Secrets are redacted.
+	Environment  map[string]string `json:"environment"`
+	Interactions []Interaction     `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Method     string              `json:"method"`
+	Path       string              `json:"path"`
+	Body       string              `json:"body,omitempty"`
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Response   string              `json:"response,omitempty"`
+}
+
+// recorder holds the cassette of a test, shared by the transports of all the API clients.
+type recorder struct {
+	mode       string
+	customerId string
+	cassette   *Cassette
+	// replayed holds the number of interactions replayed per method, path and request body.
+	replayed map[string]int
+	mutex    sync.Mutex
+}
+
+// transport records or replays the requests of an API client with the transport of the client.
+type transport struct {
+	*recorder
+	next http.RoundTripper
+}
+
+// Start records or replays the Citrix API traffic of the test, depending on CITRIX_TEST_CASSETTE_MODE.
+// It must be called at the beginning of the test, before the test reads its environment variables.
+// The tests using a cassette must not run in parallel.
+func Start(t *testing.T) {
+	t.Helper()
+	mode := os.Getenv(ModeEnvironmentVariable)
+	if mode == "" {
+		return
+	}
+
+	fileName := filepath.Join(CassetteDirectory, t.Name()+".json")
+	cassetteRecorder := &recorder{
+		mode:     mode,
+		replayed: map[string]int{},
+	}
+
+	switch mode {
+	case ModeRecord:
+		cassetteRecorder.customerId = os.Getenv("CITRIX_CUSTOMER_ID")
+		cassetteRecorder.cassette = &Cassette{
+			OnPremises:  cassetteRecorder.customerId == "" || cassetteRecorder.customerId == "CitrixOnPremises",
+			Environment: getTestEnvironment(),
+		}
+		t.Cleanup(func() {
+			if !t.Failed() {
+				if err := cassetteRecorder.cassette.save(fileName); err != nil {
+					t.Errorf("error saving cassette %s: %v", fileName, err)
+				}
+			}
+		})
+	case ModeReplay:
+		cassette, err := load(fileName)
+		if os.IsNotExist(err) {
+			t.Skipf("no cassette recorded for %s", t.Name())
+		}
+		if err != nil {
+			t.Fatalf("error loading cassette %s: %v", fileName, err)
+		}
+		cassetteRecorder.cassette = cassette
+		setReplayEnvironment(t, cassette)
+		cassetteRecorder.customerId = os.Getenv("CITRIX_CUSTOMER_ID")
+	default:
+		t.Fatalf("%s must be either %q or %q", ModeEnvironmentVariable, ModeRecord, ModeReplay)
+	}
+
+	mutex.Lock()
+	middleware.TransportWrapper = func(next http.RoundTripper) http.RoundTripper {
+		return &transport{recorder: cassetteRecorder, next: next}
+	}
+	mutex.Unlock()
+	t.Cleanup(func() {
+		mutex.Lock()
+		middleware.TransportWrapper = nil
+		mutex.Unlock()
+	})
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody := ""
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		requestBody = t.scrub(string(body))
+	}
+	path := t.scrub(req.URL.RequestURI())
+
+	if t.mode == ModeReplay {
+		return t.replay(req, path, requestBody)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := Interaction{
+		Method:     req.Method,
+		Path:       path,
+		Body:       requestBody,
+		StatusCode: resp.StatusCode,
+		Headers:    map[string][]string{},
+		Response:   t.scrub(string(responseBody)),
+	}
+	for _, header := range recordedResponseHeaders {
+		if values := resp.Header.Values(header); len(values) > 0 {
+			scrubbedValues := []string{}
+			for _, value := range values {
+				scrubbedValues = append(scrubbedValues, t.scrub(value))
+			}
+			interaction.Headers[header] = scrubbedValues
+		}
+	}
+
+	t.mutex.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.mutex.Unlock()
+	return resp, nil
+}
+
+// replay returns the recorded responses of a method, path and request body in the order they were recorded. The last
+// response is returned again once all responses have been replayed, such as when polling a job or signing in again.
+func (t *transport) replay(req *http.Request, path string, body string) (*http.Response, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	normalizedBody := normalizeBody(body)
+	key := req.Method + " " + path + " " + normalizedBody
+	matches := []Interaction{}
+	for _, interaction := range t.cassette.Interactions {
+		if interaction.Method == req.Method && interaction.Path == path && normalizeBody(interaction.Body) == normalizedBody {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no interaction recorded for %s", key)
+	}
+
+	index := min(t.replayed[key], len(matches)-1)
+	t.replayed[key]++
+	interaction := matches[index]
+
+	header := http.Header{}
+	for name, values := range interaction.Headers {
+		for _, value := range values {
+			header.Add(name, t.unscrub(value))
+		}
+	}
+	header.Set("Citrix-TransactionId", transactionIdPlaceholder)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(t.unscrub(interaction.Response))),
+		ContentLength: int64(len(t.unscrub(interaction.Response))),
+		Request:       req,
+	}, nil
+}
+
+// normalizeBody returns a request body that does not depend on the order of the JSON properties or on whitespace, so
+// that the recorded and the replayed requests match when the provider serializes the same request differently.
+func normalizeBody(body string) string {
+	var value any
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return strings.TrimSpace(body)
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return strings.TrimSpace(body)
+	}
+	return string(normalized)
+}
+
+// scrub removes the secrets, the customer id and the transaction ids from recorded values.
+func (t *recorder) scrub(value string) string {
+	value = secretJsonPropertyRegex.ReplaceAllString(value, `${1}"`+redactedValue+`"`)
+	if t.customerId != "" && t.customerId != "CitrixOnPremises" {
+		value = strings.ReplaceAll(value, t.customerId, customerIdPlaceholder)
+	}
+	return value
+}
+
+func (t *recorder) unscrub(value string) string {
+	if t.customerId != "" && t.customerId != "CitrixOnPremises" {
+		value = strings.ReplaceAll(value, customerIdPlaceholder, t.customerId)
+	}
+	return value
+}
+
+func getTestEnvironment() map[string]string {
+	environment := map[string]string{}
+	for _, variable := range os.Environ() {
+		name, value, _ := strings.Cut(variable, "=")
+		if !strings.HasPrefix(name, "TEST_") {
+			continue
+		}
+		if secretEnvironmentRegex.MatchString(name) {
+			value = redactedValue
+		}
+		environment[name] = value
+	}
+	return environment
+}
+
+// setReplayEnvironment sets the environment variables the provider and the test read, so that a cassette can be
+// replayed without the credentials of the site it was recorded against.
+func setReplayEnvironment(t *testing.T, cassette *Cassette) {
+	t.Helper()
+	names := []string{}
+	for name := range cassette.Environment {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t.Setenv(name, cassette.Environment[name])
+	}
+
+	t.Setenv("CITRIX_CLIENT_ID", "replay-client-id")
+	t.Setenv("CITRIX_CLIENT_SECRET", "replay-client-secret")
+	if cassette.OnPremises {
+		t.Setenv("CITRIX_CUSTOMER_ID", "")
+		t.Setenv("CITRIX_HOSTNAME", "replay.citrix.local")
+		return
+	}
+
+	t.Setenv("CITRIX_CUSTOMER_ID", "replaycustomer")
+	t.Setenv("CITRIX_HOSTNAME", "")
+	// Citrix Cloud signs in with a form post outside of the API clients, so the sign in is skipped with an access token
+	t.Setenv("CITRIX_ACCESS_TOKEN", getReplayAccessToken())
+}
+
+// getReplayAccessToken returns an unsigned JWT that does not expire during the test.
+func getReplayAccessToken() string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, `{"exp":%d}`, time.Now().Add(24*time.Hour).Unix()))
+	return header + "." + payload + "."
+}
+
+func load(fileName string) (*Cassette, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(content, cassette); err != nil {
+		return nil, err
+	}
+	return cassette, nil
+}
+
+func (cassette *Cassette) save(fileName string) error {
+	if err := os.MkdirAll(filepath.Dir(fileName), 0o755); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, content, 0o600)
+}
@@ -0,0 +1,168 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package cassette
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/provider"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Citrix-TransactionId", "11111111-2222-3333-4444-555555555555")
+		_, _ = w.Write([]byte(`{"Id":"catalog-1","CustomerId":"mycustomer","Password":"p@ss","access_token":"abc"}`))
+	}))
+	defer server.Close()
+
+	recording := &transport{
+		recorder: &recorder{
+			mode:       ModeRecord,
+			customerId: "mycustomer",
+			cassette:   &Cassette{},
+			replayed:   map[string]int{},
+		},
+		next: http.DefaultTransport,
+	}
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/mycustomer/site/MachineCatalogs/catalog-1", nil)
+	resp, err := recording.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if len(recording.cassette.Interactions) != 1 {
+		t.Fatalf("expected 1 recorded interaction, got %d", len(recording.cassette.Interactions))
+	}
+	interaction := recording.cassette.Interactions[0]
+	if interaction.Path != "/{customerId}/site/MachineCatalogs/catalog-1" {
+		t.Errorf("customer id was not scrubbed from the path: %s", interaction.Path)
+	}
+	for _, secret := range []string{"mycustomer", "p@ss", "abc", "11111111-2222"} {
+		if strings.Contains(interaction.Response, secret) || strings.Contains(strings.Join(interaction.Headers["Citrix-TransactionId"], ""), secret) {
+			t.Errorf("%q was not scrubbed from the cassette: %s", secret, interaction.Response)
+		}
+	}
+
+	player := &transport{
+		recorder: &recorder{
+			mode:       ModeReplay,
+			customerId: "othercustomer",
+			cassette:   recording.cassette,
+			replayed:   map[string]int{},
+		},
+	}
+	req, _ = http.NewRequest(http.MethodGet, "https://replay.citrix.local/othercustomer/site/MachineCatalogs/catalog-1", nil)
+	for range 2 {
+		resp, err = player.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), `"CustomerId":"othercustomer"`) || resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected replayed response %d: %s", resp.StatusCode, body)
+		}
+	}
+
+	req, _ = http.NewRequest(http.MethodDelete, "https://replay.citrix.local/othercustomer/site/MachineCatalogs/catalog-1", nil)
+	if _, err := player.RoundTrip(req); err == nil {
+		t.Error("expected an error for a request that was not recorded")
+	}
+}
+
+func TestReplayMatchesRequestBody(t *testing.T) {
+	t.Parallel()
+	player := &transport{
+		recorder: &recorder{
+			mode:       ModeReplay,
+			customerId: "mycustomer",
+			cassette: &Cassette{
+				Interactions: []Interaction{
+					{Method: http.MethodPost, Path: "/{customerId}/Zones", Body: `{"Name":"zone-1","Description":"first"}`, StatusCode: http.StatusCreated, Response: `{"Id":"1"}`},
+					{Method: http.MethodPost, Path: "/{customerId}/Zones", Body: `{"Name":"zone-2"}`, StatusCode: http.StatusCreated, Response: `{"Id":"2"}`},
+				},
+			},
+			replayed: map[string]int{},
+		},
+	}
+
+	testCases := map[string]struct {
+		body     string
+		expected string
+	}{
+		"reordered properties": {`{ "Description": "first", "Name": "zone-1" }`, `{"Id":"1"}`},
+		"second request":       {`{"Name":"zone-2"}`, `{"Id":"2"}`},
+		"unrecorded body":      {`{"Name":"zone-3"}`, ""},
+	}
+	for name, tc := range testCases {
+		req, _ := http.NewRequest(http.MethodPost, "https://replay.citrix.local/mycustomer/Zones", strings.NewReader(tc.body))
+		resp, err := player.RoundTrip(req)
+		if tc.expected == "" {
+			if err == nil {
+				t.Errorf("%s: expected an error for a request body that was not recorded", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != tc.expected {
+			t.Errorf("%s: expected response %s, got %s", name, tc.expected, body)
+		}
+	}
+}
+
+// TestReplayZoneCassette creates and reads a zone with the DaaS client from the recorded cassette. The cassette was
+// recorded against the fake Orchestration server, run the test with CITRIX_TEST_CASSETTE_MODE set to `record` to
+// record it again.
+func TestReplayZoneCassette(t *testing.T) {
+	ctx := context.Background()
+	if os.Getenv(ModeEnvironmentVariable) == ModeRecord {
+		server := fakeorchestration.NewServer()
+		defer server.Close()
+		server.SetProviderEnvironment(t)
+	} else {
+		t.Setenv(ModeEnvironmentVariable, ModeReplay)
+	}
+	Start(t)
+
+	client, diags := provider.NewDaaSClientFromEnvironment(ctx, "test")
+	if diags.HasError() {
+		t.Fatalf("error creating the client: %v", diags)
+	}
+
+	body := citrixorchestration.CreateZoneRequestModel{}
+	body.SetName("cassette-zone")
+	body.SetDescription("replayed from the cassette")
+	createZoneRequest := client.ApiClient.ZonesAPIsDAAS.ZonesCreateZone(ctx).CreateZoneRequestModel(body)
+	httpResp, err := citrixdaasclient.AddRequestData(createZoneRequest, client).Async(true).Execute()
+	if err != nil {
+		t.Fatalf("error creating zone: %v", err)
+	}
+	if err := util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error creating zone", &diags, 5); err != nil {
+		t.Fatalf("error waiting for the zone job: %v", err)
+	}
+
+	zone, _, err := citrixdaasclient.AddRequestData(client.ApiClient.ZonesAPIsDAAS.ZonesGetZone(ctx, "cassette-zone"), client).Execute()
+	if err != nil {
+		t.Fatalf("error getting zone: %v", err)
+	}
+	if zone.GetDescription() != "replayed from the cassette" {
+		t.Errorf("expected description %q, got %q", "replayed from the cassette", zone.GetDescription())
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/test/cassette"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -55,6 +56,7 @@ func TestDeliveryGroupPreCheck(t *testing.T) {
 }
 
 func TestDeliveryGroupResourceAzureRM(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_DG_NAME")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AZURE")
 
@@ -0,0 +1,362 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package fakeorchestration
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// collection is a set of site objects served under the same path. Path segments in braces are the ids of the parent objects.
+type collection struct {
+	path      string
+	idField   string
+	nameField string
+	// flattenFields are the fields of a create request whose properties are returned at the top level of the object.
+	flattenFields []string
+	// references are the fields holding the id or name of an object of another collection. They are returned as a
+	// reference with the id and name of the object.
+	references map[string]string
+	// parentReference is the field holding a reference to the parent object.
+	parentReference string
+	// childrenResult makes asynchronous reads of the collection return the objects as the children of a single object,
+	// the way the resources of a hypervisor resource pool are listed.
+	childrenResult bool
+}
+
+var collections = []collection{
+	{path: "Zones", idField: "Id", nameField: "Name"},
+	{path: "Hypervisors", idField: "Id", nameField: "Name", flattenFields: []string{"ConnectionDetails"}, references: map[string]string{"Zone": "Zones"}},
+	{path: "Hypervisors/{}/ResourcePools", idField: "Id", nameField: "Name", parentReference: "Hypervisor"},
+	{path: "Hypervisors/{}/ResourcePools/{}/Resources", idField: "Id", nameField: "Name", childrenResult: true},
+	{path: "MachineCatalogs", idField: "Id", nameField: "Name", references: map[string]string{"Zone": "Zones", "HypervisorConnection": "Hypervisors"}},
+	{path: "DeliveryGroups", idField: "Id", nameField: "Name"},
+	{path: "Machines", idField: "Id", nameField: "Name", references: map[string]string{"MachineCatalog": "MachineCatalogs", "DeliveryGroup": "DeliveryGroups", "Zone": "Zones"}},
+	{path: "Sessions", idField: "Id"},
+	{path: "ImageDefinitions", idField: "Id", nameField: "Name"},
+	{path: "Tags", idField: "Id", nameField: "Name"},
+	{path: "Admin/Scopes", idField: "Id", nameField: "Name"},
+}
+
+// matchCollection returns the collection with the longest path matching the beginning of the request path, the ids of
+// its parent objects, and the rest of the request path.
+func matchCollection(path string) (collection, string, []string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var match collection
+	var matchParentKey string
+	var matchLength int
+	for _, c := range collections {
+		pattern := strings.Split(c.path, "/")
+		if len(pattern) > len(segments) || len(pattern) <= matchLength {
+			continue
+		}
+
+		parentIds := []string{}
+		matched := true
+		for i, segment := range pattern {
+			if segment == "{}" {
+				parentIds = append(parentIds, strings.ToLower(segments[i]))
+			} else if !strings.EqualFold(segment, segments[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			match = c
+			matchParentKey = strings.Join(parentIds, "/")
+			matchLength = len(pattern)
+		}
+	}
+
+	if matchLength == 0 {
+		return collection{}, "", nil, false
+	}
+	return match, matchParentKey, segments[matchLength:], true
+}
+
+func (c collection) key(parentKey string) string {
+	return c.path + "|" + parentKey
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, path string) {
+	c, parentKey, rest, ok := matchCollection(path)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not found: "+r.URL.Path)
+		return
+	}
+
+	if parentKey != "" {
+		if s.findParent(c, parentKey) == nil {
+			writeError(w, http.StatusNotFound, "Parent of "+c.path+" not found")
+			return
+		}
+		parentKey = s.parentIdKey(c, parentKey)
+	}
+
+	if len(rest) > 0 && !strings.HasPrefix(rest[0], "$") {
+		if object := s.findObject(c, parentKey, rest[0]); object != nil {
+			for _, handler := range s.objectHandlers {
+				if strings.EqualFold(handler.method, r.Method) && strings.EqualFold(handler.collectionPath, c.path) {
+					handler.handler(object, rest[1:])
+				}
+			}
+		}
+	}
+
+	switch {
+	case len(rest) == 0:
+		s.serveCollectionRoot(w, r, c, parentKey)
+	case strings.HasPrefix(rest[0], "$"):
+		// Actions such as $search and $validate return no objects
+		writeJson(w, http.StatusOK, map[string]any{"Items": []any{}})
+	case len(rest) == 1:
+		s.serveObject(w, r, c, parentKey, rest[0])
+	default:
+		s.serveSubResource(w, r, c, parentKey, rest)
+	}
+}
+
+func (s *Server) serveCollectionRoot(w http.ResponseWriter, r *http.Request, c collection, parentKey string) {
+	switch r.Method {
+	case http.MethodGet:
+		items := []map[string]any{}
+		for _, object := range s.objects[c.key(parentKey)] {
+			if matchesQuery(object, r) {
+				items = append(items, object)
+			}
+		}
+		if c.childrenResult && isAsync(r) {
+			s.completeRequest(w, r, http.StatusOK, map[string]any{"Children": items})
+			return
+		}
+		writeJson(w, http.StatusOK, map[string]any{"Items": items, "TotalItems": len(items)})
+	case http.MethodPost:
+		request, err := readRequestObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if name, ok := request[c.nameField].(string); ok && s.findObject(c, parentKey, name) != nil {
+			writeError(w, http.StatusConflict, fmt.Sprintf("An object named %s already exists", name))
+			return
+		}
+		id := s.createObject(c, parentKey, request)
+		s.completeRequest(w, r, http.StatusCreated, s.findObject(c, parentKey, id))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, c collection, parentKey string, nameOrId string) {
+	object := s.findObject(c, parentKey, nameOrId)
+	if object == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Object %s not found in %s", nameOrId, c.path))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, object)
+	case http.MethodPatch, http.MethodPut:
+		request, err := readRequestObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		delete(request, c.idField)
+		s.updateObject(c, object, request)
+		s.completeRequest(w, r, http.StatusOK, nil)
+	case http.MethodDelete:
+		s.deleteObject(c, parentKey, object)
+		s.completeRequest(w, r, http.StatusNoContent, nil)
+	case http.MethodPost:
+		// Operations such as $upgrade or rename are accepted without changing the object
+		s.completeRequest(w, r, http.StatusNoContent, nil)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// serveSubResource serves the paths under an object, such as the machines of a catalog or the tags of a delivery group.
+// They are not stored: reads return the objects of another collection referencing the object, such as the machines of a
+// catalog, or no objects, and changes are accepted.
+func (s *Server) serveSubResource(w http.ResponseWriter, r *http.Request, c collection, parentKey string, rest []string) {
+	object := s.findObject(c, parentKey, rest[0])
+	if object == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Object %s not found in %s", rest[0], c.path))
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		items := []map[string]any{}
+		if len(rest) == 2 {
+			items = s.referencingObjects(rest[1], c, object, r)
+		}
+		writeJson(w, http.StatusOK, map[string]any{"Items": items, "TotalItems": len(items)})
+		return
+	}
+	s.completeRequest(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) createObject(c collection, parentKey string, request map[string]any) string {
+	object := map[string]any{}
+	s.updateObject(c, object, request)
+
+	id, _ := object[c.idField].(string)
+	if id == "" {
+		id = uuid.NewString()
+		object[c.idField] = id
+	}
+	if c.parentReference != "" {
+		object[c.parentReference] = s.reference(s.findParent(c, parentKey))
+	}
+
+	s.objects[c.key(parentKey)] = append(s.objects[c.key(parentKey)], object)
+	return id
+}
+
+func (s *Server) updateObject(c collection, object map[string]any, request map[string]any) {
+	for _, field := range c.flattenFields {
+		if nested, ok := request[field].(map[string]any); ok {
+			delete(request, field)
+			for key, value := range nested {
+				request[key] = value
+			}
+		}
+	}
+
+	for key, value := range request {
+		if value == nil {
+			continue
+		}
+		if referencedPath, ok := c.references[key]; ok {
+			if nameOrId, ok := value.(string); ok {
+				referenced, _, _, _ := matchCollection(referencedPath)
+				value = s.reference(s.findObject(referenced, "", nameOrId))
+			}
+		}
+		object[key] = value
+	}
+}
+
+func (s *Server) deleteObject(c collection, parentKey string, object map[string]any) {
+	objects := s.objects[c.key(parentKey)]
+	for i, existing := range objects {
+		if existing[c.idField] == object[c.idField] {
+			s.objects[c.key(parentKey)] = append(objects[:i], objects[i+1:]...)
+			return
+		}
+	}
+}
+
+// referencingObjects returns the objects of the top level collection at the path holding a reference to the object.
+func (s *Server) referencingObjects(path string, c collection, object map[string]any, r *http.Request) []map[string]any {
+	items := []map[string]any{}
+	for _, referencing := range collections {
+		if !strings.EqualFold(referencing.path, path) {
+			continue
+		}
+		for field, referencedPath := range referencing.references {
+			if referencedPath != c.path {
+				continue
+			}
+			for _, candidate := range s.objects[referencing.key("")] {
+				if reference, ok := candidate[field].(map[string]any); ok && reference["Id"] == object[c.idField] && matchesQuery(candidate, r) {
+					items = append(items, candidate)
+				}
+			}
+		}
+	}
+	return items
+}
+
+// findObject returns the object of the collection with the id or name, ignoring case.
+func (s *Server) findObject(c collection, parentKey string, nameOrId string) map[string]any {
+	for _, object := range s.objects[c.key(parentKey)] {
+		for _, field := range []string{c.idField, c.nameField} {
+			if value, ok := object[field].(string); ok && strings.EqualFold(value, nameOrId) {
+				return object
+			}
+		}
+	}
+	return nil
+}
+
+// findParent returns the object whose id or name is the last segment of the parent key.
+func (s *Server) findParent(c collection, parentKey string) map[string]any {
+	parent, ok := parentCollection(c)
+	if !ok {
+		return nil
+	}
+	ids := strings.Split(parentKey, "/")
+	grandParentKey := ""
+	if len(ids) > 1 {
+		grandParentKey = s.parentIdKey(parent, strings.Join(ids[:len(ids)-1], "/"))
+	}
+	return s.findObject(parent, grandParentKey, ids[len(ids)-1])
+}
+
+// parentIdKey returns the parent key with every parent object identified by its id, so that objects are found whether
+// the request names their parents by id or by name.
+func (s *Server) parentIdKey(c collection, parentKey string) string {
+	parentObject := s.findParent(c, parentKey)
+	parent, ok := parentCollection(c)
+	if parentObject == nil || !ok {
+		return parentKey
+	}
+	parentId, _ := parentObject[parent.idField].(string)
+	ids := strings.Split(parentKey, "/")
+	ids[len(ids)-1] = strings.ToLower(parentId)
+	if len(ids) > 1 {
+		ids = append(strings.Split(s.parentIdKey(parent, strings.Join(ids[:len(ids)-1], "/")), "/"), ids[len(ids)-1])
+	}
+	return strings.Join(ids, "/")
+}
+
+func parentCollection(c collection) (collection, bool) {
+	for _, candidate := range collections {
+		if candidate.path == c.path[:strings.LastIndex(c.path, "/{}/")] {
+			return candidate, true
+		}
+	}
+	return collection{}, false
+}
+
+// reference returns the id and name of an object, the way the Orchestration API references other objects.
+func (s *Server) reference(object map[string]any) map[string]any {
+	if object == nil {
+		return nil
+	}
+	return map[string]any{
+		"Id":   object["Id"],
+		"Name": object["Name"],
+	}
+}
+
+// matchesQuery returns whether the object has the values of the query parameters that name one of its fields, such as
+// the name of a machine. The other query parameters, such as limit and async, are ignored.
+func matchesQuery(object map[string]any, r *http.Request) bool {
+	for parameter, values := range r.URL.Query() {
+		for field, value := range object {
+			if !strings.EqualFold(field, parameter) {
+				continue
+			}
+			if s, ok := value.(string); ok && len(values) > 0 && !strings.EqualFold(s, values[0]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func copyObject(object map[string]any) map[string]any {
+	copied := make(map[string]any, len(object))
+	for key, value := range object {
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,442 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+// Package fakeorchestration is an in-process fake of the Citrix Orchestration REST API for hermetic provider tests.
+//
+// The fake serves an on-premises site over TLS. Objects created through the API are kept in memory, so that
+// they can be read, updated and deleted again. Asynchronous requests return a job that is already complete when it is
+// first polled, which is what util.ProcessAsyncJobResponse and client.WaitForJob expect. The items of a batch request
+// are served one after the other, and their responses are returned as the results of the batch job.
+//
+// The provider is pointed at the fake through `cvad_config.hostname`, or with the environment variables set by
+// Server.SetProviderEnvironment. Set CITRIX_TEST_FAKE_ORCHESTRATION to `true` to run the acceptance tests that call
+// Start against the fake instead of a live site.
+package fakeorchestration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/provider"
+	"github.com/google/uuid"
+)
+
+const (
+	EnvironmentVariable = "CITRIX_TEST_FAKE_ORCHESTRATION"
+
+	apiBasePath    = "/citrix/orchestration/api"
+	customerId     = "CitrixOnPremises"
+	productVersion = "7.46.0.0"
+	apiVersion     = 130
+)
+
+// Server is a running fake Orchestration API.
+type Server struct {
+	*httptest.Server
+
+	SiteId string
+
+	mutex          sync.Mutex
+	objects        map[string][]map[string]any
+	jobs           map[string]map[string]any
+	jobResults     map[string]any
+	failNextJob    string
+	requestHistory []string
+	requestBodies  map[string]map[string]any
+	objectHandlers []objectRequestHandler
+}
+
+type objectRequestHandler struct {
+	method         string
+	collectionPath string
+	handler        func(object map[string]any, rest []string)
+}
+
+// Start starts a fake Orchestration API for the test and points the provider at it when CITRIX_TEST_FAKE_ORCHESTRATION
+// is `true`. It returns nil and leaves the environment unchanged otherwise, so the test runs against the live site.
+func Start(t *testing.T) *Server {
+	t.Helper()
+	if !strings.EqualFold(os.Getenv(EnvironmentVariable), "true") {
+		return nil
+	}
+
+	server := NewServer()
+	t.Cleanup(server.Close)
+	server.SetProviderEnvironment(t)
+	return server
+}
+
+// NewServer starts a fake Orchestration API with an empty site. The caller must call Close when done.
+func NewServer() *Server {
+	server := &Server{
+		SiteId:        uuid.NewString(),
+		objects:       map[string][]map[string]any{},
+		jobs:          map[string]map[string]any{},
+		jobResults:    map[string]any{},
+		requestBodies: map[string]map[string]any{},
+	}
+	server.Server = httptest.NewTLSServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// NewClient starts a fake Orchestration API with an empty site for the test and returns it with a client connected to it.
+// The fake is closed when the test ends.
+func NewClient(t *testing.T) (*Server, *citrixdaasclient.CitrixDaasClient) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+	server.SetProviderEnvironment(t)
+
+	client, diags := provider.NewDaaSClientFromEnvironment(context.Background(), "test")
+	if diags.HasError() {
+		t.Fatalf("error creating the client: %v", diags)
+	}
+	return server, client
+}
+
+// Hostname returns the value to use as `cvad_config.hostname`.
+func (s *Server) Hostname() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+// SetProviderEnvironment sets the environment variables of the provider configuration to connect to the fake.
+func (s *Server) SetProviderEnvironment(t *testing.T) {
+	t.Helper()
+	t.Setenv("CITRIX_HOSTNAME", s.Hostname())
+	t.Setenv("CITRIX_CUSTOMER_ID", "")
+	t.Setenv("CITRIX_CLIENT_ID", "fake-admin")
+	t.Setenv("CITRIX_CLIENT_SECRET", "fake-password")
+	t.Setenv("CITRIX_DISABLE_SSL_VERIFICATION", "true")
+	t.Setenv("CITRIX_ACCESS_TOKEN", "")
+}
+
+// AddObject adds an object to a collection, such as `Hypervisors` or `Hypervisors/{hypervisorId}/ResourcePools`, to
+// seed objects that the provider only reads. The id of the object is generated when not set.
+func (s *Server) AddObject(collectionPath string, object map[string]any) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	c, parentKey, _, ok := matchCollection(collectionPath)
+	if !ok {
+		panic("unknown fake Orchestration collection " + collectionPath)
+	}
+	if parentKey != "" {
+		parentKey = s.parentIdKey(c, parentKey)
+	}
+	return s.createObject(c, parentKey, object)
+}
+
+// Objects returns a copy of the objects of a collection, such as `Zones`.
+func (s *Server) Objects(collectionPath string) []map[string]any {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	c, parentKey, _, ok := matchCollection(collectionPath)
+	if !ok {
+		return nil
+	}
+	objects := []map[string]any{}
+	for _, object := range s.objects[c.key(parentKey)] {
+		objects = append(objects, copyObject(object))
+	}
+	return objects
+}
+
+// FailNextJob makes the next asynchronous request fail with the error message, the same way a failed Orchestration job does.
+func (s *Server) FailNextJob(message string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.failNextJob = message
+}
+
+// OnObjectRequest calls handler with the object addressed by every request with the method to an object of the collection,
+// such as the machine of `POST Machines/{machineId}/$reboot`, and the rest of the request path, such as `$reboot`. The
+// handler is called before the request is served, with the fake locked, and may change the object to simulate the
+// changes the site makes on its own, such as a machine registering again after a reboot.
+func (s *Server) OnObjectRequest(method string, collectionPath string, handler func(object map[string]any, rest []string)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.objectHandlers = append(s.objectHandlers, objectRequestHandler{method: method, collectionPath: collectionPath, handler: handler})
+}
+
+// Requests returns the method and path of every request served, in order.
+func (s *Server) Requests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.requestHistory...)
+}
+
+// LastRequestBody returns the body of the last request changing the path under the site, such as
+// `MachineCatalogs/{catalogId}/$UpdateProvisioningScheme`, or nil when the path was not changed.
+func (s *Server) LastRequestBody(sitePath string) map[string]any {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requestBodies[strings.ToLower(strings.Trim(sitePath, "/"))]
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.serve(w, r)
+}
+
+// serve handles a request with the mutex held, so that the items of a batch request are served the same way.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.requestHistory = append(s.requestHistory, r.Method+" "+r.URL.Path)
+	w.Header().Set("Citrix-TransactionId", uuid.NewString())
+
+	path, ok := strings.CutPrefix(r.URL.Path, apiBasePath)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not found: "+r.URL.Path)
+		return
+	}
+	path = strings.Trim(path, "/")
+
+	switch {
+	case r.Method == http.MethodPost && strings.EqualFold(path, "tokens"):
+		s.signIn(w, r)
+		return
+	case r.Header.Get("Authorization") == "":
+		writeError(w, http.StatusUnauthorized, "Missing authorization header")
+		return
+	case r.Method == http.MethodGet && strings.EqualFold(path, "me"):
+		s.getMe(w)
+		return
+	case r.Method == http.MethodGet && strings.EqualFold(path, customerId+"/Sites/"+s.SiteId):
+		s.getSite(w)
+		return
+	}
+
+	sitePath, ok := strings.CutPrefix(path, customerId+"/"+s.SiteId+"/")
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not found: "+r.URL.Path)
+		return
+	}
+	// The relative URL of batch request items joins the site and the path with a double slash
+	sitePath = strings.TrimLeft(sitePath, "/")
+
+	if jobId, ok := cutPrefixFold(sitePath, "Jobs/"); ok {
+		s.getJob(w, r, jobId)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		// Record the body for LastRequestBody, then restore it for the handler
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if object, err := readRequestObject(r); err == nil {
+			s.requestBodies[strings.ToLower(sitePath)] = object
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.Method != http.MethodGet && s.failNextJob != "" && isAsync(r) {
+		// The failed job leaves the site unchanged
+		s.completeRequest(w, r, http.StatusAccepted, nil)
+		return
+	}
+
+	if r.Method == http.MethodPost && strings.EqualFold(sitePath, "$batch") {
+		s.serveBatch(w, r)
+		return
+	}
+
+	s.serveCollection(w, r, sitePath)
+}
+
+// serveBatch serves the items of a batch request one after the other, and returns their responses as the job results.
+func (s *Server) serveBatch(w http.ResponseWriter, r *http.Request) {
+	var batch struct {
+		Items []struct {
+			Reference   string
+			Method      string
+			RelativeUrl string
+			Body        *string
+		}
+	}
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	items := []map[string]any{}
+	for _, item := range batch.Items {
+		body := ""
+		if item.Body != nil {
+			body = *item.Body
+		}
+		itemRequest := httptest.NewRequest(item.Method, apiBasePath+"/"+strings.TrimLeft(item.RelativeUrl, "/"), strings.NewReader(body))
+		itemRequest.Host = r.Host
+		itemRequest.Header.Set("Authorization", r.Header.Get("Authorization"))
+		recorder := httptest.NewRecorder()
+		s.serve(recorder, itemRequest)
+
+		headers := []map[string]any{}
+		for name, values := range recorder.Header() {
+			headers = append(headers, map[string]any{"Name": name, "Value": strings.Join(values, ",")})
+		}
+		items = append(items, map[string]any{
+			"Reference": item.Reference,
+			"Code":      recorder.Code,
+			"Headers":   headers,
+			"Body":      recorder.Body.String(),
+		})
+	}
+	s.completeRequest(w, r, http.StatusOK, map[string]any{"Items": items})
+}
+
+func (s *Server) signIn(w http.ResponseWriter, r *http.Request) {
+	if _, _, ok := r.BasicAuth(); !ok {
+		writeError(w, http.StatusUnauthorized, "Missing credentials")
+		return
+	}
+	writeJson(w, http.StatusOK, map[string]any{
+		"Token":      uuid.NewString(),
+		"Principal":  "fake-admin",
+		"UserId":     uuid.NewString(),
+		"CustomerId": customerId,
+		"ExpiresAt":  time.Now().UTC().Add(time.Hour).Format(time.RFC3339),
+	})
+}
+
+func (s *Server) getMe(w http.ResponseWriter) {
+	writeJson(w, http.StatusOK, map[string]any{
+		"UserId":      uuid.NewString(),
+		"DisplayName": "fake-admin",
+		"Customers": []map[string]any{
+			{
+				"Id":    customerId,
+				"Name":  customerId,
+				"Sites": []map[string]any{{"Id": s.SiteId, "Name": "FakeSite"}},
+			},
+		},
+	})
+}
+
+func (s *Server) getSite(w http.ResponseWriter) {
+	writeJson(w, http.StatusOK, map[string]any{
+		"Id":                     s.SiteId,
+		"Name":                   "FakeSite",
+		"ProductVersion":         productVersion,
+		"OrchestationApiVersion": apiVersion,
+	})
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request, jobPath string) {
+	jobId, rest, _ := strings.Cut(jobPath, "/")
+	job, ok := s.jobs[strings.ToLower(jobId)]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Job "+jobId+" not found")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && strings.EqualFold(rest, "Results"):
+		writeJson(w, http.StatusOK, s.jobResults[strings.ToLower(jobId)])
+	case r.Method == http.MethodGet:
+		writeJson(w, http.StatusOK, job)
+	case r.Method == http.MethodPost || r.Method == http.MethodDelete:
+		// Jobs complete immediately, so cancelling or removing them has no effect
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// completeRequest responds to a request that changed the site. Asynchronous requests respond with a job that is
+// already complete, or failed when FailNextJob was called.
+func (s *Server) completeRequest(w http.ResponseWriter, r *http.Request, status int, body any) {
+	if !isAsync(r) {
+		if body == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJson(w, status, body)
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	job := map[string]any{
+		"Id":                     uuid.NewString(),
+		"Type":                   "Unknown",
+		"Status":                 "Complete",
+		"Parameters":             []any{},
+		"CreationTime":           now,
+		"FormattedCreationTime":  now,
+		"EndTime":                now,
+		"OverallProgressPercent": 100,
+	}
+	if s.failNextJob != "" {
+		job["Status"] = "Failed"
+		job["ErrorString"] = s.failNextJob
+		job["ErrorParameters"] = []map[string]any{{"Name": "ErrorDetails", "Value": "ErrorMessage : " + s.failNextJob}}
+		s.failNextJob = ""
+	}
+	s.jobs[strings.ToLower(job["Id"].(string))] = job //nolint:forcetypeassert // set above
+	if body != nil {
+		// Asynchronous reads, such as the resources of a hypervisor resource pool, return the object as the job results
+		s.jobResults[strings.ToLower(job["Id"].(string))] = body //nolint:forcetypeassert // set above
+	}
+
+	location := url.URL{
+		Scheme: "https",
+		Host:   r.Host,
+		Path:   fmt.Sprintf("%s/%s/%s/Jobs/%s", apiBasePath, customerId, s.SiteId, job["Id"]),
+	}
+	w.Header().Set("Location", location.String())
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func isAsync(r *http.Request) bool {
+	return strings.EqualFold(r.URL.Query().Get("async"), "true")
+}
+
+func readRequestObject(r *http.Request) (map[string]any, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	object := map[string]any{}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return object, nil
+	}
+	if err := json.Unmarshal(body, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func writeJson(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJson(w, status, map[string]any{
+		"ErrorMessage": message,
+		"Detail":       message,
+	})
+}
+
+func cutPrefixFold(s string, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
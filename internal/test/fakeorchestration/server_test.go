@@ -0,0 +1,117 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package fakeorchestration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	citrixorchestration "github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/provider"
+	"github.com/citrix/terraform-provider-citrix/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestServerWithDaaSClient(t *testing.T) {
+	ctx := context.Background()
+	server := NewServer()
+	defer server.Close()
+	server.SetProviderEnvironment(t)
+
+	client, diags := provider.NewDaaSClientFromEnvironment(ctx, "test")
+	if diags.HasError() {
+		t.Fatalf("error creating the client: %v", diags)
+	}
+	if client.ClientConfig.SiteId != server.SiteId {
+		t.Fatalf("expected site id %s, got %s", server.SiteId, client.ClientConfig.SiteId)
+	}
+
+	// Create a zone the way the zone resource does
+	body := citrixorchestration.CreateZoneRequestModel{}
+	body.SetName("fake-zone")
+	body.SetDescription("created by the fake")
+	createZoneRequest := client.ApiClient.ZonesAPIsDAAS.ZonesCreateZone(ctx).CreateZoneRequestModel(body)
+	httpResp, err := citrixdaasclient.AddRequestData(createZoneRequest, client).Async(true).Execute()
+	if err != nil {
+		t.Fatalf("error creating zone: %v", err)
+	}
+	if httpResp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d", http.StatusAccepted, httpResp.StatusCode)
+	}
+	if err := util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error creating zone", &diags, 5); err != nil {
+		t.Fatalf("error waiting for the zone job: %v", err)
+	}
+
+	zone, _, err := citrixdaasclient.AddRequestData(client.ApiClient.ZonesAPIsDAAS.ZonesGetZone(ctx, "FAKE-ZONE"), client).Execute()
+	if err != nil {
+		t.Fatalf("error getting zone by name: %v", err)
+	}
+	if zone.GetDescription() != "created by the fake" {
+		t.Errorf("expected description %q, got %q", "created by the fake", zone.GetDescription())
+	}
+
+	// Catalogs reference the zone by id and return it with its name
+	server.AddObject("MachineCatalogs", map[string]any{"Name": "fake-catalog", "Zone": zone.GetId()})
+	catalog, _, err := citrixdaasclient.AddRequestData(client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalog(ctx, "fake-catalog"), client).Execute()
+	if err != nil {
+		t.Fatalf("error getting catalog: %v", err)
+	}
+	if catalog.Zone.GetName() != "fake-zone" {
+		t.Errorf("expected catalog zone %q, got %q", "fake-zone", catalog.Zone.GetName())
+	}
+
+	// A failed job is reported by the job polling
+	server.FailNextJob("the zone is in use")
+	httpResp, err = citrixdaasclient.AddRequestData(client.ApiClient.ZonesAPIsDAAS.ZonesDeleteZone(ctx, zone.GetId()), client).Async(true).Execute()
+	if err != nil {
+		t.Fatalf("error deleting zone: %v", err)
+	}
+	diags = diag.Diagnostics{}
+	if err := util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error deleting zone", &diags, 5); err == nil {
+		t.Fatalf("expected the failed job to return an error")
+	}
+
+	httpResp, err = citrixdaasclient.AddRequestData(client.ApiClient.ZonesAPIsDAAS.ZonesDeleteZone(ctx, zone.GetId()), client).Async(true).Execute()
+	if err != nil {
+		t.Fatalf("error deleting zone: %v", err)
+	}
+	if err := util.ProcessAsyncJobResponse(ctx, client, httpResp, "Error deleting zone", &diags, 5); err != nil {
+		t.Fatalf("error waiting for the zone job: %v", err)
+	}
+	if zones := server.Objects("Zones"); len(zones) != 0 {
+		t.Errorf("expected no zones after delete, got %d", len(zones))
+	}
+
+	_, httpResp, err = citrixdaasclient.AddRequestData(client.ApiClient.ZonesAPIsDAAS.ZonesGetZone(ctx, "fake-zone"), client).Execute()
+	if err == nil || httpResp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the deleted zone to be not found")
+	}
+}
+
+func TestMatchCollection(t *testing.T) {
+	tests := []struct {
+		path       string
+		collection string
+		parentKey  string
+		rest       int
+	}{
+		{path: "Zones", collection: "Zones"},
+		{path: "zones/abc", collection: "Zones", rest: 1},
+		{path: "Hypervisors/HV1/ResourcePools/pool", collection: "Hypervisors/{}/ResourcePools", parentKey: "hv1", rest: 1},
+		{path: "DeliveryGroups/dg/Tags/tag", collection: "DeliveryGroups", rest: 3},
+		{path: "Admin/Scopes/scope", collection: "Admin/Scopes", rest: 1},
+	}
+
+	for _, test := range tests {
+		c, parentKey, rest, ok := matchCollection(test.path)
+		if !ok || c.path != test.collection || parentKey != test.parentKey || len(rest) != test.rest {
+			t.Errorf("matchCollection(%q) = %q, %q, %v, %t", test.path, c.path, parentKey, rest, ok)
+		}
+	}
+
+	if _, _, _, ok := matchCollection("Unknown"); ok {
+		t.Errorf("expected Unknown not to match a collection")
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/test/cassette"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -118,6 +119,7 @@ func TestMachineCatalogPreCheck_Azure(t *testing.T) {
 	}
 }
 func TestActiveDirectoryMachineCatalogResourceAzure(t *testing.T) {
+	cassette.Start(t)
 	ctx := context.Background()
 	client := sharedClientForSweepers(ctx)
 
@@ -229,6 +231,7 @@ func TestActiveDirectoryMachineCatalogResourceAzure(t *testing.T) {
 }
 
 func TestHybridAzureADMachineCatalogResourceAzure(t *testing.T) {
+	cassette.Start(t)
 	ctx := context.Background()
 	client := sharedClientForSweepers(ctx)
 
@@ -350,6 +353,7 @@ func TestMachineCatalogPreCheck_AzureAd(t *testing.T) {
 }
 
 func TestAzureADMachineCatalogResourceAzure(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME") + "-AAD"
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AZURE")
 
@@ -448,6 +452,7 @@ func TestMachineCatalogPreCheck_Workgroup(t *testing.T) {
 }
 
 func TestWorkgroupMachineCatalogResourceAzure(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME") + "-WRKGRP"
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AZURE")
 
@@ -551,6 +556,7 @@ func TestMachineCatalogPreCheck_GCP(t *testing.T) {
 }
 
 func TestMachineCatalogResourceGCP(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_GCP")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_GCP")
 
@@ -642,6 +648,7 @@ func TestMachineCatalogPreCheck_Vsphere(t *testing.T) {
 }
 
 func TestMachineCatalogResourceVsphere(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_VSPHERE")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_VSPHERE")
 
@@ -733,6 +740,7 @@ func TestMachineCatalogPreCheck_Xenserver(t *testing.T) {
 }
 
 func TestMachineCatalogResourceXenserver(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_XENSERVER")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_XENSERVER")
 
@@ -830,6 +838,7 @@ func TestMachineCatalogPreCheck_Nutanix(t *testing.T) {
 }
 
 func TestMachineCatalogResourceNutanix(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_NUTANIX")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_NUTANIX")
 
@@ -927,6 +936,7 @@ func TestMachineCatalogPreCheck_SCVMM(t *testing.T) {
 }
 
 func TestMachineCatalogResourceSCVMM(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_SCVMM")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_SCVMM")
 	masterImageNote := os.Getenv("TEST_MC_MASTER_IMAGE_NOTE_SCVMM")
@@ -1026,6 +1036,7 @@ func TestMachineCatalogPreCheck_Aws_Ec2(t *testing.T) {
 }
 
 func TestMachineCatalogResourceAwsEc2(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_AWS_EC2")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AWS_EC2")
 
@@ -1091,6 +1102,7 @@ func TestMachineCatalogResourceAwsEc2(t *testing.T) {
 }
 
 func TestMachineCatalogResourceAwsEc2WithWBC(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_AWS_EC2")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AWS_EC2")
 
@@ -1189,6 +1201,7 @@ func TestMachineCatalogPreCheck_Manual_Power_Managed_Azure(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Power_Managed_Azure(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AZURE")
 
@@ -1257,6 +1270,7 @@ func TestMachineCatalogPreCheck_Manual_Power_Managed_GCP(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Power_Managed_GCP(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_GCP")
 
@@ -1325,6 +1339,7 @@ func TestMachineCatalogPreCheck_Manual_Power_Managed_Vsphere(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Power_Managed_Vsphere(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_VSPHERE")
 
@@ -1387,6 +1402,7 @@ func TestMachineCatalogPreCheck_Manual_Power_Managed_Xenserver(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Power_Managed_Xenserver(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_XENSERVER")
 
@@ -1449,6 +1465,7 @@ func TestMachineCatalogPreCheck_Manual_Power_Managed_Nutanix(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Power_Managed_Nutanix(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_NUTANIX")
 
@@ -1514,6 +1531,7 @@ func TestMachineCatalogPreCheck_Manual_Power_Managed_SCVMM(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Power_Managed_SCVMM(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_SCVMM")
 
@@ -1579,6 +1597,7 @@ func TestMachineCatalogPreCheck_Manual_Power_Managed_AWS_EC2(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Power_Managed_Aws_Ec2(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AWS_EC2")
 
@@ -1638,6 +1657,7 @@ func TestMachineCatalogPreCheck_Manual_Non_Power_Managed(t *testing.T) {
 }
 
 func TestMachineCatalogResource_Manual_Non_Power_Managed(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_MANUAL")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT")
 
@@ -1701,6 +1721,7 @@ func TestMachineCatalogPreCheck_RemotePC(t *testing.T) {
 }
 
 func TestMachineCatalogResource_RemotePC(t *testing.T) {
+	cassette.Start(t)
 	name := os.Getenv("TEST_MC_NAME_REMOTE_PC")
 	zoneInput := os.Getenv("TEST_ZONE_INPUT")
 
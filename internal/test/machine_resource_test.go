@@ -0,0 +1,117 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestMachineResourcePreCheck validates the necessary env variable exist in the testing environment
+func TestMachineResourcePreCheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping acceptance test")
+	}
+
+	if v := os.Getenv("TEST_MACHINE_RESOURCE_NAME"); v == "" {
+		t.Fatal("TEST_MACHINE_RESOURCE_NAME must be set for acceptance tests")
+	}
+
+	if v := os.Getenv("TEST_MACHINE_RESOURCE_MACHINE_CATALOG_ID"); v == "" {
+		t.Fatal("TEST_MACHINE_RESOURCE_MACHINE_CATALOG_ID must be set for acceptance tests")
+	}
+
+	if v := os.Getenv("TEST_MACHINE_RESOURCE_DELIVERY_GROUP_ID"); v == "" {
+		t.Fatal("TEST_MACHINE_RESOURCE_DELIVERY_GROUP_ID must be set for acceptance tests")
+	}
+}
+
+func TestMachineResource(t *testing.T) {
+	machineName := os.Getenv("TEST_MACHINE_RESOURCE_NAME")
+	machineCatalogId := os.Getenv("TEST_MACHINE_RESOURCE_MACHINE_CATALOG_ID")
+	deliveryGroupId := os.Getenv("TEST_MACHINE_RESOURCE_DELIVERY_GROUP_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			TestProviderPreCheck(t)
+			TestMachineResourcePreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: BuildMachineResource(t, machineName, machineCatalogId, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the name of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "name", machineName),
+					// Verify the machine catalog id of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "machine_catalog_id", machineCatalogId),
+					// Verify the maintenance mode of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "in_maintenance_mode", "true"),
+					// Verify the default session action of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "session_action", "None"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "citrix_machine.test_machine",
+				ImportState:             true,
+				ImportStateId:           machineName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"in_maintenance_mode"},
+			},
+			// add to delivery group
+			{
+				Config: BuildMachineResourceWithDeliveryGroup(t, machineName, machineCatalogId, deliveryGroupId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the delivery group id of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "delivery_group_id", deliveryGroupId),
+					// Verify the maintenance mode of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "in_maintenance_mode", "false"),
+					// Verify the session action of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "session_action", "Logoff"),
+				),
+			},
+			// remove from delivery group
+			{
+				Config: BuildMachineResource(t, machineName, machineCatalogId, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the delivery group id of the machine resource
+					resource.TestCheckNoResourceAttr("citrix_machine.test_machine", "delivery_group_id"),
+					// Verify the maintenance mode of the machine resource
+					resource.TestCheckResourceAttr("citrix_machine.test_machine", "in_maintenance_mode", "false"),
+				),
+			},
+		},
+	})
+}
+
+func BuildMachineResource(t *testing.T, machineName string, machineCatalogId string, inMaintenanceMode bool) string {
+	return fmt.Sprintf(machine_test_resource, machineName, machineCatalogId, inMaintenanceMode)
+}
+
+func BuildMachineResourceWithDeliveryGroup(t *testing.T, machineName string, machineCatalogId string, deliveryGroupId string) string {
+	return fmt.Sprintf(machine_test_resource_with_delivery_group, machineName, machineCatalogId, deliveryGroupId)
+}
+
+var (
+	machine_test_resource = `
+	resource "citrix_machine" "test_machine" {
+		name = "%s"
+		machine_catalog_id = "%s"
+		in_maintenance_mode = %t
+	}
+	`
+
+	machine_test_resource_with_delivery_group = `
+	resource "citrix_machine" "test_machine" {
+		name = "%s"
+		machine_catalog_id = "%s"
+		delivery_group_id = "%s"
+		in_maintenance_mode = false
+		session_action = "Logoff"
+	}
+	`
+)
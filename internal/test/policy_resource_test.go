@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/citrix/terraform-provider-citrix/internal/test/cassette"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -15,6 +16,7 @@ func TestPolicyResourcePreCheck(t *testing.T) {
 }
 
 func TestPolicyResource(t *testing.T) {
+	cassette.Start(t)
 	policyName := os.Getenv("TEST_POLICY_RESOURCE_NAME") + "-1"
 	policyDescription := os.Getenv("TEST_POLICY_RESOURCE_DESCRIPTION")
 	policyNameUpdated := os.Getenv("TEST_POLICY_RESOURCE_NAME") + "-updated-1"
@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/citrix/terraform-provider-citrix/internal/test/cassette"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -173,6 +174,7 @@ func BuildPolicySetResourceWithDeliveryGroupId(t *testing.T, policySet string, d
 }
 
 func TestPolicySetResource(t *testing.T) {
+	cassette.Start(t)
 	zoneInput := os.Getenv("TEST_ZONE_INPUT_AZURE")
 	deliveryGroupId := os.Getenv("TEST_POLICY_SET_DELIVERY_GROUP_ID")
 	deliveryGroupId_updated := os.Getenv("TEST_POLICY_SET_DELIVERY_GROUP_ID_UPDATED")
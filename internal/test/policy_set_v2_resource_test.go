@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/citrix/terraform-provider-citrix/internal/test/cassette"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -15,6 +16,7 @@ func TestPolicySetV2ResourcePreCheck(t *testing.T) {
 }
 
 func TestPolicySetV2Resource(t *testing.T) {
+	cassette.Start(t)
 	policySetName := os.Getenv("TEST_POLICY_SET_V2_RESOURCE_NAME")
 	policySetDescription := os.Getenv("TEST_POLICY_SET_V2_RESOURCE_DESCRIPTION")
 	policySetNameUpdated := policySetName + "-updated"
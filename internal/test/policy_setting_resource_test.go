@@ -5,10 +5,12 @@ package test
 import (
 	"testing"
 
+	"github.com/citrix/terraform-provider-citrix/internal/test/cassette"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestPolicySettingResource(t *testing.T) {
+	cassette.Start(t)
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		PreCheck: func() {
@@ -123,7 +123,14 @@ func sharedClientForSweepers(ctx context.Context) *citrixclient.CitrixDaasClient
 
 	// Initialize CVAD client
 	client := &citrixclient.CitrixDaasClient{}
-	token, _, _ := client.SetupCitrixClientsContext(ctx, authUrl, ccUrl, hostname, customerId, clientId, clientSecret, onPremises, apiGateway, isGov, disableSslVerification, &userAgent, environment, middleware.MiddlewareAuthFunc, middleware.MiddlewareAuthWithCustomerIdHeaderFunc)
+	if accessToken := os.Getenv("CITRIX_ACCESS_TOKEN"); accessToken != "" && !onPremises {
+		_ = client.SeedAccessToken(accessToken)
+	}
+	client.SetupApiClient(hostname, middleware.MiddlewareAuthFunc, onPremises, disableSslVerification, apiGateway)
+	client.SetupAuthConfig(authUrl, clientId, clientSecret, onPremises, apiGateway, isGov, environment)
+	client.ClientConfig = &citrixclient.ClientConfiguration{CustomerId: customerId}
+	client.ApiClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.ApiClient.GetConfig().HTTPClient, middleware.HttpClientOptions{})
+	token, _, _ := middleware.SignIn(ctx, client)
 	if !onPremises {
 		client.InitializeCitrixCloudClients(ctx, ccUrl, hostname, middleware.MiddlewareAuthFunc, middleware.MiddlewareAuthWithCustomerIdHeaderFunc)
 	}
@@ -132,6 +139,7 @@ func sharedClientForSweepers(ctx context.Context) *citrixclient.CitrixDaasClient
 	// Set Quick Deploy Client
 	if catalogServiceHostname != "" {
 		client.InitializeQuickDeployClient(ctx, catalogServiceHostname, middleware.MiddlewareAuthFunc)
+		client.QuickDeployClient.GetConfig().HTTPClient = middleware.WrapHttpClient(client.QuickDeployClient.GetConfig().HTTPClient, middleware.HttpClientOptions{})
 	}
 
 	return client
@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -27,6 +28,8 @@ func TestTagResourcePreCheck(t *testing.T) {
 }
 
 func TestTagResource(t *testing.T) {
+	fakeorchestration.Start(t)
+
 	tagName := os.Getenv("TEST_TAG_RESOURCE_NAME")
 	tagDescription := os.Getenv("TEST_TAG_RESOURCE_DESCRIPTION")
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
 	citrixclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/citrix/terraform-provider-citrix/internal/test/fakeorchestration"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -75,6 +76,8 @@ func TestCloudZoneCreationPreCheck(t *testing.T) {
 }
 
 func TestZoneResource(t *testing.T) {
+	fakeorchestration.Start(t)
+
 	customerId := os.Getenv("CITRIX_CUSTOMER_ID")
 	isOnPremises := customerId == "" || customerId == "CitrixOnPremises"
 
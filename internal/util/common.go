@@ -149,6 +149,9 @@ const OktaDomainRegex string = `\.okta\.com$|\.okta-eu\.com$|\.oktapreview\.com$
 // Application Category Path
 const AppCategoryPathRegex string = `^([^<>|*?":/\\]+\\)*[^<>|*?":/\\]+\\?$|^$`
 
+// Published Content Location, a URL or a UNC path
+const PublishedContentLocationRegex string = `^[a-zA-Z][a-zA-Z0-9+.-]*://\S+$|^\\\\[^\\]+\\.+$`
+
 // SAML 2.0 Identity Provider Certificate REGEX
 const SamlIdpCertRegex string = `\.[Pp][Ee][Mm]$|\.[Cc][Rr][Tt]$|\.[Cc][Ee][Rr]$`
 
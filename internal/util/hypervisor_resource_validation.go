@@ -0,0 +1,236 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	citrixdaasclient "github.com/citrix/citrix-daas-rest-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const maxHypervisorResourceCloseMatches = 5
+
+// ValidateResourcePoolResourceExists checks at plan time that a resource referenced by name or id exists under the given folder of a hypervisor resource pool.
+// The resource is resolved with the same lookup used at apply time, which retries bypassing the hypervisor cache, and the folder is only listed
+// again to suggest close matches when the resource is not found.
+// Failures to list the resources are only logged so that plans are not blocked by transient hypervisor connectivity issues.
+func ValidateResourcePoolResourceExists(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, attributePath path.Path, hypervisorId, resourcePoolId, resourcePoolName, folderPath, resourceName, resourceType, resourceDescription string) {
+	if _, _, err := GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, &diag.Diagnostics{}, hypervisorId, resourcePoolId, folderPath, resourceName, resourceType, ""); err == nil {
+		return
+	}
+	addResourcePoolResourceNotFoundError(ctx, client, diagnostics, attributePath, hypervisorId, resourcePoolId, resourcePoolName, folderPath, resourceName, resourceType, resourceDescription)
+}
+
+// ValidateAzureMachineProfile checks at plan time that the machine profile VM or template spec version of an Azure catalog exists in the
+// hypervisor resource pool. A template spec version is also checked with the hypervisor machine profile validation, as it is at apply time.
+func ValidateAzureMachineProfile(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, attributePath path.Path, hypervisorId, resourcePoolId, resourcePoolName string, machineProfile AzureMachineProfileModel) {
+	if machineProfile.MachineProfileResourceGroup.IsUnknown() || machineProfile.MachineProfileVmName.IsUnknown() ||
+		machineProfile.MachineProfileTemplateSpecName.IsUnknown() || machineProfile.MachineProfileTemplateSpecVersion.IsUnknown() {
+		return
+	}
+
+	folderPath := fmt.Sprintf("machineprofile.folder\\%s.resourcegroup", machineProfile.MachineProfileResourceGroup.ValueString())
+	if !machineProfile.MachineProfileVmName.IsNull() {
+		ValidateResourcePoolResourceExists(ctx, client, diagnostics, attributePath.AtName("machine_profile_vm_name"), hypervisorId, resourcePoolId, resourcePoolName, folderPath, machineProfile.MachineProfileVmName.ValueString(), VirtualMachineResourceType, "Machine profile VM")
+		return
+	}
+
+	templateSpecName := machineProfile.MachineProfileTemplateSpecName.ValueString()
+	templateSpecVersion := machineProfile.MachineProfileTemplateSpecVersion.ValueString()
+	versionPath := attributePath.AtName("machine_profile_template_spec_version")
+	folderPath = fmt.Sprintf("%s\\%s.templatespec", folderPath, templateSpecName)
+	templateSpecVersionResource, _, err := GetSingleResourceFromHypervisorWithNoCacheRetry(ctx, client, &diag.Diagnostics{}, hypervisorId, resourcePoolId, folderPath, templateSpecVersion, "", "")
+	if err != nil {
+		addResourcePoolResourceNotFoundError(ctx, client, diagnostics, versionPath, hypervisorId, resourcePoolId, resourcePoolName, folderPath, templateSpecVersion, "", "Machine profile template spec version")
+		return
+	}
+
+	isValid, errorMsg, err := validateHypervisorResource(ctx, client, hypervisorId, resourcePoolId, templateSpecVersionResource.GetRelativePath())
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Skipping plan time validation of template spec %s, failed to validate the hypervisor resource: %s", templateSpecName, ReadClientError(err)))
+		return
+	}
+	if !isValid {
+		diagnostics.AddAttributeError(
+			versionPath,
+			"Invalid Machine Profile",
+			fmt.Sprintf("Template spec %s with version %s failed the machine profile validation: %s", templateSpecName, templateSpecVersion, errorMsg),
+		)
+	}
+}
+
+// addResourcePoolResourceNotFoundError lists the resources under the given folder of a hypervisor resource pool to report a resource that could not be resolved.
+// An empty or failed listing cannot be told apart from a failed resource job, so the validation is left to apply time in that case.
+func addResourcePoolResourceNotFoundError(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, attributePath path.Path, hypervisorId, resourcePoolId, resourcePoolName, folderPath, resourceName, resourceType, resourceDescription string) {
+	children, _, err := GetAllChildrenForResourcePath(ctx, client, &diag.Diagnostics{}, hypervisorId, resourcePoolId, folderPath, resourceType, false, false)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Skipping plan time validation of %s %s, failed to list hypervisor resources: %s", resourceDescription, resourceName, ReadClientError(err)))
+		return
+	}
+	if len(children) == 0 {
+		return
+	}
+
+	AddHypervisorResourceNotFoundError(diagnostics, attributePath, resourceDescription, resourceName, "resource pool "+resourcePoolName, getHypervisorResourceNames(children, resourceType, ""))
+}
+
+// ValidateHypervisorResourcesExist checks at plan time that the resources referenced by name exist under the given folder of a hypervisor connection.
+// The resources are resolved with the same lookup used at apply time, and are otherwise reported following the same rules as ValidateResourcePoolResourceExists.
+func ValidateHypervisorResourcesExist(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, attributePath path.Path, hypervisor *citrixorchestration.HypervisorDetailResponseModel, folderPath, resourceType string, resourceNames []string, resourceDescription string) {
+	if len(resourceNames) == 0 {
+		return
+	}
+
+	connectionType := hypervisor.GetConnectionType()
+	if _, err := GetFilteredResourcePathListWithNoCacheRetry(ctx, client, &diag.Diagnostics{}, hypervisor.GetId(), folderPath, resourceType, resourceNames, connectionType, hypervisor.GetPluginId(), false); err == nil {
+		return
+	}
+
+	children, err := getHypervisorResourceChildren(ctx, client, &diag.Diagnostics{}, hypervisor.GetId(), folderPath, resourceType, connectionType, false, false)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Skipping plan time validation of %s, failed to list hypervisor resources: %s", resourceDescription, ReadClientError(err)))
+		return
+	}
+	candidates := getHypervisorResourceNames(children, resourceType, connectionType)
+	if len(candidates) == 0 {
+		// An empty listing cannot be told apart from a failed resource job, leave the validation to apply time
+		return
+	}
+
+	for _, resourceName := range resourceNames {
+		if !slices.ContainsFunc(candidates, func(candidate string) bool { return strings.EqualFold(candidate, resourceName) }) {
+			AddHypervisorResourceNotFoundError(diagnostics, attributePath, resourceDescription, resourceName, "hypervisor "+hypervisor.GetName(), candidates)
+		}
+	}
+}
+
+// ValidateNetworkMappingNetworks checks that every network referenced in the network mappings is available in the resource pool.
+func ValidateNetworkMappingNetworks(diagnostics *diag.Diagnostics, attributePath path.Path, networkMappings []NetworkMappingModel, resourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel, hypervisorPluginId string) {
+	networks := getResourcePoolNetworks(resourcePool, hypervisorPluginId)
+	if len(networks) == 0 {
+		return
+	}
+
+	suffix := getNetworkMappingNetworkName("", resourcePool, hypervisorPluginId)
+	candidates := []string{}
+	for _, network := range networks {
+		candidates = append(candidates, strings.TrimSuffix(network.GetName(), suffix))
+	}
+
+	for _, networkMapping := range networkMappings {
+		if networkMapping.Network.IsUnknown() {
+			continue
+		}
+		networkName := getNetworkMappingNetworkName(networkMapping.Network.ValueString(), resourcePool, hypervisorPluginId)
+		if !slices.ContainsFunc(networks, func(network citrixorchestration.HypervisorResourceRefResponseModel) bool {
+			return strings.EqualFold(network.GetName(), networkName)
+		}) {
+			AddHypervisorResourceNotFoundError(diagnostics, attributePath, "Network", networkMapping.Network.ValueString(), "resource pool "+resourcePool.GetName(), candidates)
+		}
+	}
+}
+
+// AddHypervisorResourceNotFoundError adds an attribute error for a hypervisor resource that could not be found, listing close matches when available.
+func AddHypervisorResourceNotFoundError(diagnostics *diag.Diagnostics, attributePath path.Path, resourceDescription, resourceName, location string, candidates []string) {
+	detail := fmt.Sprintf("%s %q was not found under %s.", resourceDescription, resourceName, location)
+	closeMatches := FindCloseMatches(resourceName, candidates, maxHypervisorResourceCloseMatches)
+	if len(closeMatches) > 0 {
+		detail += fmt.Sprintf(" Did you mean: %s?", strings.Join(closeMatches, ", "))
+	}
+
+	diagnostics.AddAttributeError(
+		attributePath,
+		"Hypervisor Resource Not Found",
+		detail,
+	)
+}
+
+// FindCloseMatches returns up to limit candidates that are similar to name, ordered from closest to furthest.
+// A candidate is considered close when it contains or is contained in name, or when its edit distance to name is small relative to the length of name.
+// The comparison is case-insensitive.
+func FindCloseMatches(name string, candidates []string, limit int) []string {
+	type match struct {
+		candidate string
+		distance  int
+	}
+
+	lowerName := strings.ToLower(name)
+	maxDistance := max(2, len(lowerName)/4)
+	matches := []match{}
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		lowerCandidate := strings.ToLower(candidate)
+		if candidate == "" || seen[lowerCandidate] {
+			continue
+		}
+		seen[lowerCandidate] = true
+
+		distance := levenshteinDistance(lowerName, lowerCandidate)
+		if distance <= maxDistance || strings.Contains(lowerCandidate, lowerName) || strings.Contains(lowerName, lowerCandidate) {
+			matches = append(matches, match{candidate: candidate, distance: distance})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b match) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.candidate, b.candidate)
+	})
+
+	result := []string{}
+	for _, m := range matches {
+		if len(result) == limit {
+			break
+		}
+		result = append(result, m.candidate)
+	}
+	return result
+}
+
+func levenshteinDistance(a, b string) int {
+	source := []rune(a)
+	target := []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
+// getHypervisorResourceNames returns the names of the resources of the given type. AWS resource names are suffixed with their id, which is
+// dropped the same way it is when the resources are resolved.
+func getHypervisorResourceNames(children []citrixorchestration.HypervisorResourceResponseModel, resourceType string, connectionType citrixorchestration.HypervisorConnectionType) []string {
+	names := []string{}
+	for _, child := range children {
+		if resourceType != "" && !strings.EqualFold(child.GetResourceType(), resourceType) {
+			continue
+		}
+		name := child.GetName()
+		if connectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS || connectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE {
+			name = strings.Split(name, " ")[0]
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,107 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+)
+
+func TestFindCloseMatches(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"Standard_D2s_v3", "Standard_D4s_v3", "Standard_B2ms", "datastore-ssd", "datastore-hdd", "VM Network", "vlan-100"}
+
+	tests := map[string]struct {
+		name     string
+		limit    int
+		expected []string
+	}{
+		"single typo": {
+			name:     "Standard_D2s_v4",
+			limit:    5,
+			expected: []string{"Standard_D2s_v3", "Standard_D4s_v3"},
+		},
+		"case insensitive": {
+			name:     "vm network",
+			limit:    5,
+			expected: []string{"VM Network"},
+		},
+		"substring": {
+			name:     "datastore",
+			limit:    5,
+			expected: []string{"datastore-hdd", "datastore-ssd"},
+		},
+		"limit": {
+			name:     "datastore",
+			limit:    1,
+			expected: []string{"datastore-hdd"},
+		},
+		"no match": {
+			name:     "gpu-pool",
+			limit:    5,
+			expected: []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := FindCloseMatches(test.name, candidates, test.limit)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected close matches %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGetHypervisorResourceNames(t *testing.T) {
+	t.Parallel()
+
+	newResource := func(name, resourceType string) citrixorchestration.HypervisorResourceResponseModel {
+		resource := citrixorchestration.HypervisorResourceResponseModel{}
+		resource.SetName(name)
+		resource.SetResourceType(resourceType)
+		return resource
+	}
+	children := []citrixorchestration.HypervisorResourceResponseModel{
+		newResource("subnet-a (subnet-0123)", NetworkResourceType),
+		newResource("subnet-b (subnet-4567)", NetworkResourceType),
+		newResource("vpc-a (vpc-89ab)", VirtualPrivateCloudResourceType),
+	}
+
+	tests := map[string]struct {
+		resourceType   string
+		connectionType citrixorchestration.HypervisorConnectionType
+		expected       []string
+	}{
+		"aws names without id": {
+			resourceType:   NetworkResourceType,
+			connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS,
+			expected:       []string{"subnet-a", "subnet-b"},
+		},
+		"other hypervisors keep the name": {
+			resourceType:   NetworkResourceType,
+			connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM,
+			expected:       []string{"subnet-a (subnet-0123)", "subnet-b (subnet-4567)"},
+		},
+		"any resource type": {
+			connectionType: citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS,
+			expected:       []string{"subnet-a", "subnet-b", "vpc-a"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := getHypervisorResourceNames(children, test.resourceType, test.connectionType)
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected names %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
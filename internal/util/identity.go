@@ -0,0 +1,55 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetIdIdentitySchema returns the identity schema shared by resources that are uniquely identified by their `id` attribute.
+func GetIdIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "GUID identifier of the resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// SetResourceIdentityFromState copies the `id` attribute of the given state into the resource identity.
+// Resources call this in Create once the state has been set, and at the beginning of Read and Update so that
+// the identity is also populated when the resource is removed from state.
+func SetResourceIdentityFromState(ctx context.Context, diagnostics *diag.Diagnostics, state tfsdk.State, identity *tfsdk.ResourceIdentity) {
+	if identity == nil || state.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+	diagnostics.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diagnostics.HasError() || id.IsNull() || id.IsUnknown() {
+		return
+	}
+
+	diagnostics.Append(identity.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// GetImportStateId returns the import identifier of the request, falling back to the `id` attribute of the
+// resource identity when the resource is imported by identity.
+func GetImportStateId(ctx context.Context, diagnostics *diag.Diagnostics, req resource.ImportStateRequest) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	var id types.String
+	diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+	return id.ValueString()
+}
@@ -997,33 +997,11 @@ func ParseAzureMachineProfileResponseToModel(machineProfileResponse citrixorches
 }
 
 func ParseNetworkMappingToClientModel(networkMappings []NetworkMappingModel, resourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel, hypervisorPluginId string) ([]citrixorchestration.NetworkMapRequestModel, error) {
-	var networks []citrixorchestration.HypervisorResourceRefResponseModel
-	if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM {
-		networks = resourcePool.Subnets
-	} else if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS ||
-		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM ||
-		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER ||
-		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER ||
-		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT ||
-		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE ||
-		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM && hypervisorPluginId == NUTANIX_PLUGIN_ID {
-		networks = resourcePool.Networks
-	}
+	networks := getResourcePoolNetworks(resourcePool, hypervisorPluginId)
 
 	var res = []citrixorchestration.NetworkMapRequestModel{}
 	for _, networkMapping := range networkMappings {
-		var networkName string
-		if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM ||
-			resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM ||
-			resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER ||
-			resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER ||
-			resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT ||
-			resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM && hypervisorPluginId == NUTANIX_PLUGIN_ID {
-			networkName = networkMapping.Network.ValueString()
-		} else if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS ||
-			resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE {
-			networkName = fmt.Sprintf("%s (%s)", networkMapping.Network.ValueString(), resourcePool.GetResourcePoolRootId())
-		}
+		networkName := getNetworkMappingNetworkName(networkMapping.Network.ValueString(), resourcePool, hypervisorPluginId)
 		network := slices.IndexFunc(networks, func(c citrixorchestration.HypervisorResourceRefResponseModel) bool {
 			return strings.EqualFold(c.GetName(), networkName)
 		})
@@ -1046,23 +1024,79 @@ func ParseNetworkMappingToClientModel(networkMappings []NetworkMappingModel, res
 	return res, nil
 }
 
+func getResourcePoolNetworks(resourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel, hypervisorPluginId string) []citrixorchestration.HypervisorResourceRefResponseModel {
+	if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM {
+		return resourcePool.Subnets
+	} else if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM && hypervisorPluginId == NUTANIX_PLUGIN_ID {
+		return resourcePool.Networks
+	}
+	return nil
+}
+
+// getNetworkMappingNetworkName returns the name of the network as listed in the resource pool. AWS networks are listed with the resource pool root id as suffix.
+func getNetworkMappingNetworkName(network string, resourcePool *citrixorchestration.HypervisorResourcePoolDetailResponseModel, hypervisorPluginId string) string {
+	if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AZURE_RM ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_GOOGLE_CLOUD_PLATFORM ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM && hypervisorPluginId == NUTANIX_PLUGIN_ID {
+		return network
+	} else if resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS ||
+		resourcePool.ConnectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE {
+		return fmt.Sprintf("%s (%s)", network, resourcePool.GetResourcePoolRootId())
+	}
+	return ""
+}
+
+// BuildAzureImageFolderPath returns the hypervisor resource path of the folder containing an Azure managed disk, snapshot or VHD master image.
+func BuildAzureImageFolderPath(sharedSubscription string, resourceGroup string, storageAccount string, storageContainer string) string {
+	imageBasePath := "image.folder"
+	if sharedSubscription != "" {
+		imageBasePath = fmt.Sprintf("image.folder\\%s.sharedsubscription", sharedSubscription)
+	}
+	if storageAccount != "" && storageContainer != "" {
+		return fmt.Sprintf(
+			"%s\\%s.resourcegroup\\%s.storageaccount\\%s.container",
+			imageBasePath,
+			resourceGroup,
+			storageAccount,
+			storageContainer)
+	}
+	return fmt.Sprintf(
+		"%s\\%s.resourcegroup",
+		imageBasePath,
+		resourceGroup)
+}
+
+// BuildAzureGalleryImagePath returns the hypervisor resource path of an Azure Compute Gallery image definition.
+func BuildAzureGalleryImagePath(sharedSubscription string, resourceGroup string, gallery string, definition string) string {
+	imageBasePath := "image.folder"
+	if sharedSubscription != "" {
+		imageBasePath = fmt.Sprintf("image.folder\\%s.sharedsubscription", sharedSubscription)
+	}
+	return fmt.Sprintf(
+		"%s\\%s.resourcegroup\\%s.gallery\\%s.imagedefinition",
+		imageBasePath,
+		resourceGroup,
+		gallery,
+		definition)
+}
+
 func BuildAzureMasterImagePath(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, galleryImage types.Object, sharedSubscription string, resourceGroup string, storageAccount string, storageContainer string, masterImage string, hypervisor string, hypervisorResourcePool string, errorTitle string) (string, error) {
 	imagePath := ""
-	imageBasePath := "image.folder"
 	var queryPath string
 	var err error
 	var httpResp *http.Response
-	if sharedSubscription != "" {
-		imageBasePath = fmt.Sprintf("image.folder\\%s.sharedsubscription", sharedSubscription)
-	}
 	if masterImage != "" {
+		queryPath = BuildAzureImageFolderPath(sharedSubscription, resourceGroup, storageAccount, storageContainer)
 		if storageAccount != "" && storageContainer != "" {
-			queryPath = fmt.Sprintf(
-				"%s\\%s.resourcegroup\\%s.storageaccount\\%s.container",
-				imageBasePath,
-				resourceGroup,
-				storageAccount,
-				storageContainer)
 			imagePath, httpResp, err = GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor, hypervisorResourcePool, queryPath, masterImage, "", "")
 			if err != nil {
 				diagnostics.AddError(
@@ -1073,10 +1107,6 @@ func BuildAzureMasterImagePath(ctx context.Context, client *citrixdaasclient.Cit
 				return imagePath, err
 			}
 		} else {
-			queryPath = fmt.Sprintf(
-				"%s\\%s.resourcegroup",
-				imageBasePath,
-				resourceGroup)
 			imagePath, httpResp, err = GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor, hypervisorResourcePool, queryPath, masterImage, "", "")
 			if err != nil {
 				diagnostics.AddError(
@@ -1093,12 +1123,7 @@ func BuildAzureMasterImagePath(ctx context.Context, client *citrixdaasclient.Cit
 		definition := azureGalleryImage.Definition.ValueString()
 		version := azureGalleryImage.Version.ValueString()
 		if gallery != "" && definition != "" {
-			queryPath = fmt.Sprintf(
-				"%s\\%s.resourcegroup\\%s.gallery\\%s.imagedefinition",
-				imageBasePath,
-				resourceGroup,
-				gallery,
-				definition)
+			queryPath = BuildAzureGalleryImagePath(sharedSubscription, resourceGroup, gallery, definition)
 			imagePath, httpResp, err = GetSingleResourcePathFromHypervisorWithNoCacheRetry(ctx, client, diagnostics, hypervisor, hypervisorResourcePool, queryPath, version, "", "")
 			if err != nil {
 				diagnostics.AddError(
@@ -0,0 +1,132 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ListResourceItem is a remote object discovered by a list resource.
+type ListResourceItem struct {
+	Id          string
+	DisplayName string
+	// StateAttributes holds additional string attributes that the resource Read requires besides `id`,
+	// such as the parent hypervisor of a resource pool.
+	StateAttributes map[string]string
+}
+
+// ListResourceNameFilterModel is the list resource config shared by list resources that can be filtered by name.
+type ListResourceNameFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// GetListResourceNameRegexAttribute returns the optional `name_regex` list resource config attribute.
+func GetListResourceNameRegexAttribute(objectType string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Description: fmt.Sprintf("Regular expression matched against the name of the %s. When omitted, all %s are returned.", objectType, objectType),
+		Optional:    true,
+	}
+}
+
+func (ListResourceNameFilterModel) GetSchema(objectType string) listschema.Schema {
+	return listschema.Schema{
+		Description: fmt.Sprintf("Lists the %s of the site.", objectType),
+		Attributes: map[string]listschema.Attribute{
+			"name_regex": GetListResourceNameRegexAttribute(objectType),
+		},
+	}
+}
+
+// StreamListResults filters the items by nameRegex and streams them as list results. When the request asks for
+// the full resource, the resource returned by newResource is configured with client and its Read is invoked with a
+// state containing only the identifying attributes of each item, the same way a resource is read after import.
+func StreamListResults(ctx context.Context, diagnostics diag.Diagnostics, req list.ListRequest, stream *list.ListResultsStream, nameRegex types.String, items []ListResourceItem, newResource func() resource.Resource, client any) {
+	if diagnostics.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+
+	var nameFilter *regexp.Regexp
+	if !nameRegex.IsNull() {
+		var err error
+		nameFilter, err = regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"name_regex must be a valid regular expression. Error: "+err.Error(),
+			)
+			stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+			return
+		}
+	}
+
+	var r resource.Resource
+	if req.IncludeResource {
+		r = newResource()
+		if resourceWithConfigure, ok := r.(resource.ResourceWithConfigure); ok {
+			resourceWithConfigure.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, item := range items {
+			if nameFilter != nil && !nameFilter.MatchString(item.DisplayName) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.DisplayName
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), item.Id)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				readListResourceItem(ctx, req, r, item, &result)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func readListResourceItem(ctx context.Context, req list.ListRequest, r resource.Resource, item ListResourceItem, result *list.ListResult) {
+	state := tfsdk.State{
+		Schema: req.ResourceSchema,
+		Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+	}
+	result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), item.Id)...)
+	for attribute, value := range item.StateAttributes {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	readResp := resource.ReadResponse{
+		State:    state,
+		Identity: result.Identity,
+	}
+	r.Read(ctx, resource.ReadRequest{State: state, Identity: result.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Resource.Raw = readResp.State.Raw
+}
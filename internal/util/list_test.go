@@ -0,0 +1,198 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testListedResource reads the name of the listed object from the id and the hypervisor set in the state, the same
+// way a resource is read after import.
+type testListedResource struct {
+	client any
+}
+
+func (r *testListedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test"
+}
+
+func (r *testListedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = testListedResourceSchema()
+}
+
+func (r *testListedResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.client = req.ProviderData
+}
+
+func (r *testListedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var id, hypervisor types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("hypervisor"), &hypervisor)...)
+	if r.client == nil {
+		resp.Diagnostics.AddError("Resource not configured", "the resource was read without a client")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), hypervisor.ValueString()+"/"+strings.TrimPrefix(id.ValueString(), "id-"))...)
+}
+
+func (r *testListedResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *testListedResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *testListedResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func testListedResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{Computed: true},
+			"name":       schema.StringAttribute{Computed: true},
+			"hypervisor": schema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func newTestListRequest(includeResource bool, limit int64) list.ListRequest {
+	return list.ListRequest{
+		IncludeResource: includeResource,
+		Limit:           limit,
+		ResourceSchema:  testListedResourceSchema(),
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"id": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+}
+
+func collectListResults(t *testing.T, stream *list.ListResultsStream) []list.ListResult {
+	t.Helper()
+	results := []list.ListResult{}
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestStreamListResultsFiltersByNameAndLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	items := []ListResourceItem{
+		{Id: "id-1", DisplayName: "prod-catalog"},
+		{Id: "id-2", DisplayName: "test-catalog"},
+		{Id: "id-3", DisplayName: "prod-pool"},
+		{Id: "id-4", DisplayName: "prod-group"},
+	}
+
+	tests := map[string]struct {
+		nameRegex   types.String
+		limit       int64
+		expectedIds []string
+	}{
+		"all items":        {nameRegex: types.StringNull(), expectedIds: []string{"id-1", "id-2", "id-3", "id-4"}},
+		"filtered by name": {nameRegex: types.StringValue("^prod-"), expectedIds: []string{"id-1", "id-3", "id-4"}},
+		"limit applies after filter": {
+			nameRegex:   types.StringValue("^prod-"),
+			limit:       2,
+			expectedIds: []string{"id-1", "id-3"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stream := &list.ListResultsStream{}
+			StreamListResults(ctx, diag.Diagnostics{}, newTestListRequest(false, test.limit), stream, test.nameRegex, items, nil, nil)
+
+			ids := []string{}
+			for _, result := range collectListResults(t, stream) {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %s", result.Diagnostics)
+				}
+				var id types.String
+				result.Identity.GetAttribute(ctx, path.Root("id"), &id)
+				ids = append(ids, id.ValueString())
+			}
+			if strings.Join(ids, ",") != strings.Join(test.expectedIds, ",") {
+				t.Errorf("expected ids %v, got %v", test.expectedIds, ids)
+			}
+		})
+	}
+}
+
+func TestStreamListResultsErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	listingErrors := diag.Diagnostics{}
+	listingErrors.AddError("Error listing Machine Catalogs", "unauthorized")
+
+	tests := map[string]struct {
+		diagnostics   diag.Diagnostics
+		nameRegex     types.String
+		expectedError string
+	}{
+		"listing failed":      {diagnostics: listingErrors, nameRegex: types.StringNull(), expectedError: "Error listing Machine Catalogs"},
+		"invalid name regex":  {diagnostics: diag.Diagnostics{}, nameRegex: types.StringValue("prod-("), expectedError: "Invalid Name Regex"},
+		"listing error first": {diagnostics: listingErrors, nameRegex: types.StringValue("prod-("), expectedError: "Error listing Machine Catalogs"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stream := &list.ListResultsStream{}
+			StreamListResults(ctx, test.diagnostics, newTestListRequest(false, 0), stream, test.nameRegex, []ListResourceItem{{Id: "id-1", DisplayName: "prod-catalog"}}, nil, nil)
+
+			results := collectListResults(t, stream)
+			if len(results) != 1 || !results[0].Diagnostics.HasError() {
+				t.Fatalf("expected a single error result, got %d results", len(results))
+			}
+			if summary := results[0].Diagnostics.Errors()[0].Summary(); summary != test.expectedError {
+				t.Errorf("expected error %q, got %q", test.expectedError, summary)
+			}
+		})
+	}
+}
+
+func TestStreamListResultsIncludeResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	items := []ListResourceItem{
+		{Id: "id-1", DisplayName: "pool-1", StateAttributes: map[string]string{"hypervisor": "azure"}},
+		{Id: "id-2", DisplayName: "pool-2", StateAttributes: map[string]string{"hypervisor": "vsphere"}},
+	}
+	newResource := func() resource.Resource { return &testListedResource{} }
+
+	stream := &list.ListResultsStream{}
+	StreamListResults(ctx, diag.Diagnostics{}, newTestListRequest(true, 0), stream, types.StringNull(), items, newResource, "client")
+
+	names := []string{}
+	for _, result := range collectListResults(t, stream) {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", result.Diagnostics)
+		}
+		var name types.String
+		result.Resource.GetAttribute(ctx, path.Root("name"), &name)
+		names = append(names, result.DisplayName+"="+name.ValueString())
+	}
+	expected := []string{"pool-1=azure/1", "pool-2=vsphere/2"}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected resources %v, got %v", expected, names)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+)
+
+// NamingSchemeWildcard is the placeholder character replaced by the variable part of MCS machine account names.
+const NamingSchemeWildcard string = "#"
+
+// MaxNamingSchemeNameCount is the largest number of names ExpandNamingScheme returns. It is well above the number of
+// machines of a machine catalog, and keeps a mistyped count from allocating the names of a whole naming scheme.
+const MaxNamingSchemeNameCount int = 100000
+
+const alphabeticNamingSchemeCharacters string = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+const numericNamingSchemeCharacters string = "0123456789"
+
+// ExpandNamingScheme returns the first count machine account names MCS generates for the given naming scheme.
+// The wildcard (#) characters in namingScheme are replaced, left to right, with the numeric or alphabetic
+// sequence defined by namingSchemeType, beginning at startsWith. An empty startsWith begins the sequence at 1
+// for Numeric and at A for Alphabetic naming schemes.
+func ExpandNamingScheme(namingScheme string, namingSchemeType string, startsWith string, count int) ([]string, error) {
+	wildcardCount := strings.Count(namingScheme, NamingSchemeWildcard)
+	if wildcardCount == 0 {
+		return nil, fmt.Errorf("naming_scheme %q must contain at least one wildcard (%s) character", namingScheme, NamingSchemeWildcard)
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("count must not be negative")
+	}
+	if count > MaxNamingSchemeNameCount {
+		return nil, fmt.Errorf("count must not be greater than %d, %d requested", MaxNamingSchemeNameCount, count)
+	}
+
+	var characters string
+	switch {
+	case strings.EqualFold(namingSchemeType, string(citrixorchestration.NAMINGSCHEMETYPE_NUMERIC)):
+		characters = numericNamingSchemeCharacters
+		if startsWith == "" {
+			startsWith = "1"
+		}
+	case strings.EqualFold(namingSchemeType, string(citrixorchestration.NAMINGSCHEMETYPE_ALPHABETIC)):
+		characters = alphabeticNamingSchemeCharacters
+		if startsWith == "" {
+			startsWith = "A"
+		}
+	default:
+		return nil, fmt.Errorf("naming_scheme_type must be one of `%s` or `%s`, got %q", citrixorchestration.NAMINGSCHEMETYPE_NUMERIC, citrixorchestration.NAMINGSCHEMETYPE_ALPHABETIC, namingSchemeType)
+	}
+
+	base := big.NewInt(int64(len(characters)))
+	start := big.NewInt(0)
+	for _, c := range startsWith {
+		digit := strings.IndexRune(characters, c)
+		if digit < 0 {
+			return nil, fmt.Errorf("starts_with %q contains characters that are not valid for a %s naming scheme", startsWith, namingSchemeType)
+		}
+		start.Mul(start, base)
+		start.Add(start, big.NewInt(int64(digit)))
+	}
+
+	capacity := new(big.Int).Exp(base, big.NewInt(int64(wildcardCount)), nil)
+	last := new(big.Int).Add(start, big.NewInt(int64(count)))
+	if count > 0 && last.Cmp(capacity) > 0 {
+		return nil, fmt.Errorf("naming_scheme %q can only produce %s names starting from %q, %d requested", namingScheme, new(big.Int).Sub(capacity, start).String(), startsWith, count)
+	}
+
+	names := make([]string, 0, count)
+	current := new(big.Int).Set(start)
+	for i := 0; i < count; i++ {
+		names = append(names, fillNamingSchemeWildcards(namingScheme, formatNamingSchemeIndex(current, characters, wildcardCount)))
+		current.Add(current, big.NewInt(1))
+	}
+
+	return names, nil
+}
+
+// formatNamingSchemeIndex renders value in the base defined by characters, left padded with the zero character to width.
+func formatNamingSchemeIndex(value *big.Int, characters string, width int) string {
+	base := big.NewInt(int64(len(characters)))
+	remaining := new(big.Int).Set(value)
+	digits := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		digit := new(big.Int)
+		remaining.DivMod(remaining, base, digit)
+		digits[i] = characters[digit.Int64()]
+	}
+	return string(digits)
+}
+
+func fillNamingSchemeWildcards(namingScheme string, variablePart string) string {
+	var builder strings.Builder
+	position := 0
+	for _, c := range namingScheme {
+		if string(c) == NamingSchemeWildcard {
+			builder.WriteByte(variablePart[position])
+			position++
+			continue
+		}
+		builder.WriteRune(c)
+	}
+	return builder.String()
+}
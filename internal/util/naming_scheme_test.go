@@ -0,0 +1,132 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExpandNamingScheme(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		namingScheme     string
+		namingSchemeType string
+		startsWith       string
+		count            int
+		expected         []string
+		expectError      bool
+	}
+	tests := map[string]testCase{
+		"numeric default start": {
+			namingScheme:     "VDA-###",
+			namingSchemeType: "Numeric",
+			count:            3,
+			expected:         []string{"VDA-001", "VDA-002", "VDA-003"},
+		},
+		"numeric with starts_with": {
+			namingScheme:     "VDA##",
+			namingSchemeType: "Numeric",
+			startsWith:       "09",
+			count:            2,
+			expected:         []string{"VDA09", "VDA10"},
+		},
+		"alphabetic default start": {
+			namingScheme:     "WIN-##",
+			namingSchemeType: "Alphabetic",
+			count:            2,
+			expected:         []string{"WIN-AA", "WIN-AB"},
+		},
+		"alphabetic carries over": {
+			namingScheme:     "WIN-##",
+			namingSchemeType: "alphabetic",
+			startsWith:       "AZ",
+			count:            2,
+			expected:         []string{"WIN-AZ", "WIN-BA"},
+		},
+		"wildcards in the middle": {
+			namingScheme:     "A#B#",
+			namingSchemeType: "Numeric",
+			startsWith:       "12",
+			count:            1,
+			expected:         []string{"A1B2"},
+		},
+		"zero count": {
+			namingScheme:     "VDA-#",
+			namingSchemeType: "Numeric",
+			count:            0,
+			expected:         []string{},
+		},
+		"no wildcard": {
+			namingScheme:     "VDA",
+			namingSchemeType: "Numeric",
+			count:            1,
+			expectError:      true,
+		},
+		"unsupported naming scheme type": {
+			namingScheme:     "VDA-#",
+			namingSchemeType: "None",
+			count:            1,
+			expectError:      true,
+		},
+		"lowercase starts_with for alphabetic": {
+			namingScheme:     "VDA-#",
+			namingSchemeType: "Alphabetic",
+			startsWith:       "a",
+			count:            1,
+			expectError:      true,
+		},
+		"count exceeds the maximum": {
+			namingScheme:     "VDA-##########",
+			namingSchemeType: "Numeric",
+			count:            MaxNamingSchemeNameCount + 1,
+			expectError:      true,
+		},
+		"count exceeds capacity": {
+			namingScheme:     "VDA-#",
+			namingSchemeType: "Numeric",
+			startsWith:       "8",
+			count:            3,
+			expectError:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			names, err := ExpandNamingScheme(test.namingScheme, test.namingSchemeType, test.startsWith, test.count)
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got names %v", names)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !slices.Equal(names, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, names)
+			}
+		})
+	}
+}
+
+func TestSplitResourcePath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string][2]string{
+		"Catalog1":                   {"", "Catalog1"},
+		"Folder1\\Catalog1":          {"Folder1", "Catalog1"},
+		"Folder1\\Folder2\\Catalog1": {"Folder1\\Folder2", "Catalog1"},
+		"\\Folder1\\Catalog1\\":      {"Folder1", "Catalog1"},
+	}
+
+	for input, expected := range tests {
+		folderPath, name := SplitResourcePath(input)
+		if folderPath != expected[0] || name != expected[1] {
+			t.Errorf("SplitResourcePath(%q) = (%q, %q), expected (%q, %q)", input, folderPath, name, expected[0], expected[1])
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// plannedImpactMaxNamesInWarning limits the names listed per category in the planned impact warning.
+const plannedImpactMaxNamesInWarning = 20
+
+// PlannedImpactModel maps the impact of an update that is computed at plan time.
+type PlannedImpactModel struct {
+	MachinesToDelete     types.List  `tfsdk:"machines_to_delete"`   // List[string]
+	MachinesToReassign   types.List  `tfsdk:"machines_to_reassign"` // List[string]
+	MachinesToReboot     types.List  `tfsdk:"machines_to_reboot"`   // List[string]
+	UsersToRemove        types.List  `tfsdk:"users_to_remove"`      // List[string]
+	AffectedSessionCount types.Int64 `tfsdk:"affected_session_count"`
+}
+
+func (PlannedImpactModel) GetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Impact of the most recently planned update, computed from the current machines and sessions of the site. " +
+			"The impact is also reported as a warning during plan, so that it can be reviewed before the update is applied." +
+			"\n\n~> **Please Note** The impact is computed at plan time. Machines and sessions can change before the plan is applied.",
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"machines_to_delete": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Names of the machines that will be deleted.",
+				Computed:    true,
+			},
+			"machines_to_reassign": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Names of the machines that will be removed from their delivery group.",
+				Computed:    true,
+			},
+			"machines_to_reboot": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Names of the machines that will be rebooted.",
+				Computed:    true,
+			},
+			"users_to_remove": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Users that will lose access, either because they are removed from the allow list or because their assigned machine is deleted or reassigned.",
+				Computed:    true,
+			},
+			"affected_session_count": schema.Int64Attribute{
+				Description: "Number of sessions running on the machines that will be deleted, reassigned or rebooted.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (PlannedImpactModel) GetAttributes() map[string]schema.Attribute {
+	return PlannedImpactModel{}.GetSchema().Attributes
+}
+
+// PlannedImpact collects the machines, users and sessions affected by an update.
+type PlannedImpact struct {
+	MachinesToDelete   []string
+	MachinesToReassign []string
+	MachinesToReboot   []string
+	UsersToRemove      []string
+
+	// machineSessions tracks the session count per machine, so that a machine affected in several ways counts once
+	machineSessions map[string]int32
+}
+
+// DeleteMachine records a machine that will be deleted, along with its sessions and assigned users.
+func (p *PlannedImpact) DeleteMachine(machine citrixorchestration.MachineResponseModel) {
+	p.MachinesToDelete = append(p.MachinesToDelete, machine.GetName())
+	p.addMachineUsers(machine)
+	p.addMachineSessions(machine)
+}
+
+// ReassignMachine records a machine that will be removed from its delivery group, along with its sessions and assigned users.
+func (p *PlannedImpact) ReassignMachine(machine citrixorchestration.MachineResponseModel) {
+	p.MachinesToReassign = append(p.MachinesToReassign, machine.GetName())
+	p.addMachineUsers(machine)
+	p.addMachineSessions(machine)
+}
+
+// RebootMachine records a machine that will be rebooted, along with its sessions.
+func (p *PlannedImpact) RebootMachine(machine citrixorchestration.MachineResponseModel) {
+	p.MachinesToReboot = append(p.MachinesToReboot, machine.GetName())
+	p.addMachineSessions(machine)
+}
+
+// RemoveUser records a user that will lose access.
+func (p *PlannedImpact) RemoveUser(user string) {
+	if user == "" || slices.ContainsFunc(p.UsersToRemove, func(existing string) bool { return strings.EqualFold(existing, user) }) {
+		return
+	}
+	p.UsersToRemove = append(p.UsersToRemove, user)
+}
+
+// AffectedSessionCount returns the number of sessions on the deleted, reassigned and rebooted machines.
+func (p PlannedImpact) AffectedSessionCount() int64 {
+	count := int64(0)
+	for _, sessionCount := range p.machineSessions {
+		count += int64(sessionCount)
+	}
+	return count
+}
+
+// IsEmpty returns whether the update affects no machines, users or sessions.
+func (p PlannedImpact) IsEmpty() bool {
+	return len(p.MachinesToDelete) == 0 && len(p.MachinesToReassign) == 0 && len(p.MachinesToReboot) == 0 && len(p.UsersToRemove) == 0
+}
+
+func (p *PlannedImpact) addMachineUsers(machine citrixorchestration.MachineResponseModel) {
+	for _, user := range machine.GetAssignedUsers() {
+		userName := user.GetSamName()
+		if userName == "" {
+			userName = user.GetPrincipalName()
+		}
+		p.RemoveUser(userName)
+	}
+}
+
+func (p *PlannedImpact) addMachineSessions(machine citrixorchestration.MachineResponseModel) {
+	if p.machineSessions == nil {
+		p.machineSessions = map[string]int32{}
+	}
+	machineKey := machine.GetId()
+	if machineKey == "" {
+		machineKey = machine.GetName()
+	}
+	p.machineSessions[machineKey] = machine.GetSessionCount()
+}
+
+// ToModel converts the planned impact into its Terraform model.
+func (p PlannedImpact) ToModel(ctx context.Context, diagnostics *diag.Diagnostics) types.Object {
+	model := PlannedImpactModel{
+		MachinesToDelete:     StringArrayToStringList(ctx, diagnostics, p.MachinesToDelete),
+		MachinesToReassign:   StringArrayToStringList(ctx, diagnostics, p.MachinesToReassign),
+		MachinesToReboot:     StringArrayToStringList(ctx, diagnostics, p.MachinesToReboot),
+		UsersToRemove:        StringArrayToStringList(ctx, diagnostics, p.UsersToRemove),
+		AffectedSessionCount: types.Int64Value(p.AffectedSessionCount()),
+	}
+	return TypedObjectToObjectValue(ctx, diagnostics, model)
+}
+
+// GetPlannedImpactNull returns a null planned impact object.
+func GetPlannedImpactNull() types.Object {
+	attributesMap, _ := ResourceAttributeMapFromObject(PlannedImpactModel{})
+	return types.ObjectNull(attributesMap)
+}
+
+// AddWarning reports the planned impact as a warning diagnostic, so that it shows up in the plan output.
+func (p PlannedImpact) AddWarning(diagnostics *diag.Diagnostics, resourceDisplayName string) {
+	if p.IsEmpty() {
+		return
+	}
+
+	details := []string{}
+	if len(p.MachinesToDelete) > 0 {
+		details = append(details, fmt.Sprintf("%d machine(s) will be deleted: %s.", len(p.MachinesToDelete), joinPlannedImpactNames(p.MachinesToDelete)))
+	}
+	if len(p.MachinesToReassign) > 0 {
+		details = append(details, fmt.Sprintf("%d machine(s) will be removed from the delivery group: %s.", len(p.MachinesToReassign), joinPlannedImpactNames(p.MachinesToReassign)))
+	}
+	if len(p.MachinesToReboot) > 0 {
+		details = append(details, fmt.Sprintf("%d machine(s) will be rebooted: %s.", len(p.MachinesToReboot), joinPlannedImpactNames(p.MachinesToReboot)))
+	}
+	if len(p.UsersToRemove) > 0 {
+		details = append(details, fmt.Sprintf("%d user(s) will lose access: %s.", len(p.UsersToRemove), joinPlannedImpactNames(p.UsersToRemove)))
+	}
+	details = append(details, fmt.Sprintf("%d session(s) will be affected.", p.AffectedSessionCount()))
+
+	diagnostics.AddWarning(
+		"Planned impact on "+resourceDisplayName,
+		strings.Join(details, "\n"),
+	)
+}
+
+func joinPlannedImpactNames(names []string) string {
+	if len(names) <= plannedImpactMaxNamesInWarning {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(names[:plannedImpactMaxNamesInWarning], ", "), len(names)-plannedImpactMaxNamesInWarning)
+}
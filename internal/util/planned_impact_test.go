@@ -0,0 +1,77 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/citrix/citrix-daas-rest-go/citrixorchestration"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func newPlannedImpactTestMachine(id string, sessionCount int32, assignedUser string) citrixorchestration.MachineResponseModel {
+	machine := citrixorchestration.MachineResponseModel{}
+	machine.SetId(id)
+	machine.SetName("DOMAIN\\" + id)
+	machine.SetSessionCount(sessionCount)
+	if assignedUser != "" {
+		user := citrixorchestration.IdentityUserResponseModel{}
+		user.SetSamName(assignedUser)
+		machine.SetAssignedUsers([]citrixorchestration.IdentityUserResponseModel{user})
+	}
+	return machine
+}
+
+func TestPlannedImpact(t *testing.T) {
+	t.Parallel()
+
+	impact := PlannedImpact{}
+	if !impact.IsEmpty() {
+		t.Fatalf("expected an empty planned impact")
+	}
+
+	impact.DeleteMachine(newPlannedImpactTestMachine("vm-1", 2, "DOMAIN\\user1"))
+	impact.ReassignMachine(newPlannedImpactTestMachine("vm-2", 1, "domain\\USER1"))
+	impact.RebootMachine(newPlannedImpactTestMachine("vm-3", 4, ""))
+	// A machine affected twice counts its sessions once
+	impact.RebootMachine(newPlannedImpactTestMachine("vm-1", 2, ""))
+	impact.RemoveUser("DOMAIN\\user2")
+
+	if impact.IsEmpty() {
+		t.Fatalf("expected a planned impact")
+	}
+	if got := impact.AffectedSessionCount(); got != 7 {
+		t.Errorf("expected 7 affected sessions, got %d", got)
+	}
+	if got := strings.Join(impact.UsersToRemove, ","); got != "DOMAIN\\user1,DOMAIN\\user2" {
+		t.Errorf("unexpected users to remove %s", got)
+	}
+
+	diagnostics := diag.Diagnostics{}
+	impact.AddWarning(&diagnostics, "Machine Catalog test")
+	if len(diagnostics.Warnings()) != 1 {
+		t.Fatalf("expected a single warning, got %v", diagnostics)
+	}
+	detail := diagnostics.Warnings()[0].Detail()
+	for _, expected := range []string{"1 machine(s) will be deleted: DOMAIN\\vm-1.", "2 machine(s) will be rebooted", "2 user(s) will lose access", "7 session(s) will be affected."} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("expected warning detail to contain %q, got %q", expected, detail)
+		}
+	}
+}
+
+func TestJoinPlannedImpactNames(t *testing.T) {
+	t.Parallel()
+
+	names := []string{}
+	for i := 0; i < plannedImpactMaxNamesInWarning+3; i++ {
+		names = append(names, "vm")
+	}
+	if got := joinPlannedImpactNames(names); !strings.HasSuffix(got, "vm and 3 more") {
+		t.Errorf("unexpected joined names %s", got)
+	}
+	if got := joinPlannedImpactNames([]string{"vm-1", "vm-2"}); got != "vm-1, vm-2" {
+		t.Errorf("unexpected joined names %s", got)
+	}
+}
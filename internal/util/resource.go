@@ -25,6 +25,57 @@ const OPENSHIFT_PLUGIN_ID string = "OpenShiftPluginFactory"
 const HPE_MOONSHOT_PLUGIN_ID = "HPMoonshotFactory"
 const REMOTE_PC_WAKE_ON_LAN_PLUGIN_ID string = "VdaWOLMachineManagerFactory"
 
+func GetZones(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics) ([]citrixorchestration.ZoneResponseModel, error) {
+	req := client.ApiClient.ZonesAPIsDAAS.ZonesGetZones(ctx)
+	req = req.Limit(250)
+
+	zones := []citrixorchestration.ZoneResponseModel{}
+	continuationToken := ""
+	for {
+		req = req.ContinuationToken(continuationToken)
+
+		responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ZoneResponseModelCollection](req, client)
+		if err != nil {
+			diagnostics.AddError(
+				"Error reading zones",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return zones, err
+		}
+		zones = append(zones, responseModel.GetItems()...)
+
+		if responseModel.GetContinuationToken() == "" {
+			return zones, nil
+		}
+		continuationToken = responseModel.GetContinuationToken()
+	}
+}
+
+func GetHypervisors(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics) ([]citrixorchestration.HypervisorResponseModel, error) {
+	req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisors(ctx)
+	responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.HypervisorResponseModelCollection](req, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading hypervisors",
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+ReadClientError(err),
+		)
+		return nil, err
+	}
+
+	return responseModel.GetItems(), nil
+}
+
+// IsHypervisorOfConnectionType checks whether the hypervisor uses the given connection type.
+// Custom connections are matched by pluginId as well when it is not empty.
+func IsHypervisorOfConnectionType(hypervisor citrixorchestration.HypervisorResponseModel, connectionType citrixorchestration.HypervisorConnectionType, pluginId string) bool {
+	if hypervisor.GetConnectionType() != connectionType {
+		return false
+	}
+	return pluginId == "" || strings.EqualFold(hypervisor.GetPluginId(), pluginId)
+}
+
 // Gets the hypervisor and logs any errors
 func GetHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId string) (*citrixorchestration.HypervisorDetailResponseModel, error) {
 	// Resolve resource path for service offering and master image
@@ -41,6 +92,21 @@ func GetHypervisor(ctx context.Context, client *citrixdaasclient.CitrixDaasClien
 	return hypervisor, err
 }
 
+func GetHypervisorResourcePools(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId string) ([]citrixorchestration.HypervisorResourcePoolDetailResponseModel, error) {
+	req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorResourcePools(ctx, hypervisorId)
+	responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.HypervisorResourcePoolResponseModelCollection](req, client)
+	if err != nil {
+		diagnostics.AddError(
+			"Error reading resource pools of hypervisor "+hypervisorId,
+			"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+				"\nError message: "+ReadClientError(err),
+		)
+		return nil, err
+	}
+
+	return responseModel.GetItems(), nil
+}
+
 func GetHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId, hypervisorResourcePoolId string) (*citrixorchestration.HypervisorResourcePoolDetailResponseModel, error) {
 	getResourcePoolsRequest := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorResourcePool(ctx, hypervisorId, hypervisorResourcePoolId)
 	resourcePool, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.HypervisorResourcePoolDetailResponseModel](getResourcePoolsRequest, client)
@@ -55,6 +121,36 @@ func GetHypervisorResourcePool(ctx context.Context, client *citrixdaasclient.Cit
 	return resourcePool, err
 }
 
+func GetMachineCatalogs(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, fields string) ([]citrixorchestration.MachineCatalogResponseModel, error) {
+	req := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogs(ctx)
+	req = req.Limit(250)
+	if fields != "" {
+		req = req.Fields(fields)
+	}
+
+	machineCatalogs := []citrixorchestration.MachineCatalogResponseModel{}
+	continuationToken := ""
+	for {
+		req = req.ContinuationToken(continuationToken)
+
+		responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.MachineCatalogResponseModelCollection](req, client)
+		if err != nil {
+			diagnostics.AddError(
+				"Error reading machine catalogs",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return machineCatalogs, err
+		}
+		machineCatalogs = append(machineCatalogs, responseModel.GetItems()...)
+
+		if responseModel.GetContinuationToken() == "" {
+			return machineCatalogs, nil
+		}
+		continuationToken = responseModel.GetContinuationToken()
+	}
+}
+
 func GetMachineCatalog(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineCatalogId string, addErrorToDiagnostics bool) (*citrixorchestration.MachineCatalogDetailResponseModel, error) {
 	return GetMachineCatalogWithFieldsOverride(ctx, client, diagnostics, machineCatalogId, addErrorToDiagnostics, "Id,Name,Description,ProvisioningType,PersistChanges,Zone,AllocationType,SessionSupport,TotalCount,HypervisorConnection,ProvisioningScheme,RemotePCEnrollmentScopes,IsPowerManaged,MinimumFunctionalLevel,IsRemotePC,Metadata,Scopes,UpgradeInfo,AdminFolder")
 }
@@ -170,6 +266,66 @@ func GetDeliveryGroupMachines(ctx context.Context, client *citrixdaasclient.Citr
 	}
 }
 
+func GetApplications(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, fields string) ([]citrixorchestration.ApplicationResponseModel, error) {
+	req := client.ApiClient.ApplicationsAPIsDAAS.ApplicationsGetApplications(ctx)
+	req = req.Limit(250)
+	if fields != "" {
+		req = req.Fields(fields)
+	}
+
+	applications := []citrixorchestration.ApplicationResponseModel{}
+	continuationToken := ""
+	for {
+		req = req.ContinuationToken(continuationToken)
+
+		responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ApplicationResponseModelCollection](req, client)
+		if err != nil {
+			diagnostics.AddError(
+				"Error reading applications",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return applications, err
+		}
+		applications = append(applications, responseModel.GetItems()...)
+
+		if responseModel.GetContinuationToken() == "" {
+			return applications, nil
+		}
+		continuationToken = responseModel.GetContinuationToken()
+	}
+}
+
+func GetApplicationGroups(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, fields string) ([]citrixorchestration.ApplicationGroupResponseModel, error) {
+	req := client.ApiClient.ApplicationGroupsAPIsDAAS.ApplicationGroupsGetApplicationGroups(ctx)
+	req = req.Limit(250)
+	if fields != "" {
+		req = req.Fields(fields)
+	}
+
+	applicationGroups := []citrixorchestration.ApplicationGroupResponseModel{}
+	continuationToken := ""
+	for {
+		req = req.ContinuationToken(continuationToken)
+
+		responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ApplicationGroupResponseModelCollection](req, client)
+		if err != nil {
+			diagnostics.AddError(
+				"Error reading application groups",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return applicationGroups, err
+		}
+		applicationGroups = append(applicationGroups, responseModel.GetItems()...)
+
+		if responseModel.GetContinuationToken() == "" {
+			return applicationGroups, nil
+		}
+		continuationToken = responseModel.GetContinuationToken()
+	}
+}
+
 func GetApplicationGroupIdWithPath(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, appGroupPath string) (string, error) {
 	getAppGroupRequest := client.ApiClient.ApplicationGroupsAPIsDAAS.ApplicationGroupsGetApplicationGroup(ctx, appGroupPath).Fields("Id")
 	appGroup, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.ApplicationGroupDetailResponseModel](getAppGroupRequest, client)
@@ -185,7 +341,12 @@ func GetApplicationGroupIdWithPath(ctx context.Context, client *citrixdaasclient
 }
 
 func GetMachineCatalogMachines(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineCatalogId string) ([]citrixorchestration.MachineResponseModel, error) {
-	req := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMachines(ctx, machineCatalogId).Fields("Id,Name,Hosting,DeliveryGroup,InMaintenanceMode,AssignedUsers,AssociatedUsers,AllocationType,Sid")
+	return GetMachineCatalogMachinesWithFields(ctx, client, diagnostics, machineCatalogId, "Id,Name,Hosting,DeliveryGroup,InMaintenanceMode,AssignedUsers,AssociatedUsers,AllocationType,Sid")
+}
+
+// GetMachineCatalogMachinesWithFields returns the machines of the catalog with the given fields only.
+func GetMachineCatalogMachinesWithFields(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, machineCatalogId string, fields string) ([]citrixorchestration.MachineResponseModel, error) {
+	req := client.ApiClient.MachineCatalogsAPIsDAAS.MachineCatalogsGetMachineCatalogMachines(ctx, machineCatalogId).Fields(fields)
 	req = req.Limit(250)
 
 	responses := []citrixorchestration.MachineResponseModel{}
@@ -209,6 +370,31 @@ func GetMachineCatalogMachines(ctx context.Context, client *citrixdaasclient.Cit
 	}
 }
 
+func GetSessions(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics) ([]citrixorchestration.SessionResponseModel, error) {
+	req := client.ApiClient.SessionsAPIsDAAS.SessionsGetSessions(ctx)
+	req = req.Limit(1000)
+
+	responses := []citrixorchestration.SessionResponseModel{}
+	continuationToken := ""
+	for {
+		req = req.ContinuationToken(continuationToken)
+		responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.SessionResponseModelCollection](req, client)
+		if err != nil {
+			diagnostics.AddError(
+				"Error reading Sessions",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return responses, err
+		}
+		responses = append(responses, responseModel.GetItems()...)
+		if responseModel.GetContinuationToken() == "" {
+			return responses, nil
+		}
+		continuationToken = responseModel.GetContinuationToken()
+	}
+}
+
 func GetSingleResourceFromHypervisorWithNoCacheRetry(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorName, hypervisorPoolName, folderPath, resourceName, resourceType, resourceGroupName string) (*citrixorchestration.HypervisorResourceResponseModel, *http.Response, error) {
 	resource, httpResp, err := getSingleResourceFromHypervisor(ctx, client, diagnostics, hypervisorName, hypervisorPoolName, folderPath, resourceName, resourceType, resourceGroupName, false, false)
 	if err != nil {
@@ -450,31 +636,11 @@ func GetFilteredResourcePathListWithNoCacheRetry(ctx context.Context, client *ci
 }
 
 func getFilteredResourcePathList(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId, folderPath, resourceType string, filter []string, connectionType citrixorchestration.HypervisorConnectionType, pluginId string, addToDiagnostics bool, noCache bool, isFilterCaseSensitive bool) ([]string, error) {
-	req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorAllResources(ctx, hypervisorId)
-	req = req.Children(1)
-	req = req.Path(folderPath)
-	req = req.NoCache(noCache)
-	// Skip resource type filter for on-prem hypervisors to avoid server side filtering timeout
-	if connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM &&
-		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER &&
-		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER &&
-		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM &&
-		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT {
-		req = req.Type_([]string{resourceType})
-	}
-
-	req = req.Async(true)
-
-	_, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.HypervisorResourceResponseModel](req, client)
+	children, err := getHypervisorResourceChildren(ctx, client, diagnostics, hypervisorId, folderPath, resourceType, connectionType, addToDiagnostics, noCache)
 	if err != nil {
 		return []string{}, err
 	}
 
-	resources, err := GetAsyncJobResultWithAddToDiagsOption[citrixorchestration.HypervisorResourceResponseModel](ctx, client, httpResp, "Error getting Hypervisor resources", diagnostics, 5, addToDiagnostics)
-	if errors.Is(err, &JobPollError{}) {
-		return []string{}, err
-	}
-
 	result := []string{}
 	if filter != nil {
 		filterMap := map[string]bool{}
@@ -485,7 +651,7 @@ func getFilteredResourcePathList(ctx context.Context, client *citrixdaasclient.C
 			filterMap[f] = false
 		}
 
-		for _, child := range resources.Children {
+		for _, child := range children {
 			if strings.EqualFold(child.ResourceType, resourceType) {
 				name := child.GetName()
 				if connectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AWS || connectionType == citrixorchestration.HYPERVISORCONNECTIONTYPE_AMAZON_WORK_SPACES_CORE {
@@ -517,7 +683,7 @@ func getFilteredResourcePathList(ctx context.Context, client *citrixdaasclient.C
 		}
 	} else {
 		//when the filter is empty
-		for _, child := range resources.Children {
+		for _, child := range children {
 			if strings.EqualFold(child.ResourceType, resourceType) {
 				result = append(result, child.GetXDPath())
 			}
@@ -527,7 +693,44 @@ func getFilteredResourcePathList(ctx context.Context, client *citrixdaasclient.C
 	return result, nil
 }
 
+func getHypervisorResourceChildren(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, hypervisorId, folderPath, resourceType string, connectionType citrixorchestration.HypervisorConnectionType, addToDiagnostics bool, noCache bool) ([]citrixorchestration.HypervisorResourceResponseModel, error) {
+	req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsGetHypervisorAllResources(ctx, hypervisorId)
+	req = req.Children(1)
+	req = req.Path(folderPath)
+	req = req.NoCache(noCache)
+	// Skip resource type filter for on-prem hypervisors to avoid server side filtering timeout
+	if connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_CUSTOM &&
+		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_XEN_SERVER &&
+		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_V_CENTER &&
+		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_SCVMM &&
+		connectionType != citrixorchestration.HYPERVISORCONNECTIONTYPE_OPEN_SHIFT {
+		req = req.Type_([]string{resourceType})
+	}
+
+	req = req.Async(true)
+
+	_, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.HypervisorResourceResponseModel](req, client)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := GetAsyncJobResultWithAddToDiagsOption[citrixorchestration.HypervisorResourceResponseModel](ctx, client, httpResp, "Error getting Hypervisor resources", diagnostics, 5, addToDiagnostics)
+	if errors.Is(err, &JobPollError{}) {
+		return nil, err
+	}
+
+	return resources.Children, nil
+}
+
 func ValidateHypervisorResource(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, hypervisorName string, hypervisorPoolName string, resourcePath string) (bool, string) {
+	isValid, errorMsg, err := validateHypervisorResource(ctx, client, hypervisorName, hypervisorPoolName, resourcePath)
+	if err != nil {
+		return false, ReadClientError(err)
+	}
+	return isValid, errorMsg
+}
+
+func validateHypervisorResource(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, hypervisorName string, hypervisorPoolName string, resourcePath string) (bool, string, error) {
 	req := client.ApiClient.HypervisorsAPIsDAAS.HypervisorsValidateHypervisorResourcePoolResource(ctx, hypervisorName, hypervisorPoolName)
 	var validationRequestModel citrixorchestration.HypervisorResourceValidationRequestModel
 	validationRequestModel.SetPath(resourcePath)
@@ -535,13 +738,16 @@ func ValidateHypervisorResource(ctx context.Context, client *citrixdaasclient.Ci
 
 	responseModel, _, err := citrixdaasclient.AddRequestData(req, client).Execute()
 	if err != nil {
-		return false, ReadClientError(err)
+		return false, "", err
 	}
 
 	reports := responseModel.GetReports()
 	index := slices.IndexFunc(reports, func(report citrixorchestration.ResourceValidationReportModel) bool {
 		return report.GetCategory() == citrixorchestration.RESOURCEVALIDATIONCATEGORY_MACHINE_PROFILE
 	})
+	if index < 0 {
+		return true, "", nil
+	}
 
 	report := reports[index]
 	if report.GetResult() == citrixorchestration.RESOURCEVALIDATIONRESULT_FAILED {
@@ -549,11 +755,71 @@ func ValidateHypervisorResource(ctx context.Context, client *citrixdaasclient.Ci
 		errIndex := slices.IndexFunc(violations, func(violation citrixorchestration.ResourceValidationViolationModel) bool {
 			return violation.GetLevel() == citrixorchestration.RESOURCEVIOLATIONLEVEL_ERROR
 		})
+		if errIndex < 0 {
+			return true, "", nil
+		}
 		violation := violations[errIndex]
-		return false, violation.GetMessage()
+		return false, violation.GetMessage(), nil
+	}
+
+	return true, "", nil
+}
+
+func GetAdminRoles(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics) ([]citrixorchestration.RoleResponseModel, error) {
+	req := client.ApiClient.AdminAPIsDAAS.AdminGetAdminRoles(ctx)
+	req = req.Limit(250)
+
+	adminRoles := []citrixorchestration.RoleResponseModel{}
+	continuationToken := ""
+	for {
+		req = req.ContinuationToken(continuationToken)
+
+		responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.RoleResponseModelCollection](req, client)
+		if err != nil {
+			diagnostics.AddError(
+				"Error reading admin roles",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return adminRoles, err
+		}
+		adminRoles = append(adminRoles, responseModel.GetItems()...)
+
+		if responseModel.GetContinuationToken() == "" {
+			return adminRoles, nil
+		}
+		continuationToken = responseModel.GetContinuationToken()
+	}
+}
+
+func GetTags(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, fields string) ([]citrixorchestration.TagResponseModel, error) {
+	req := client.ApiClient.TagsAPIsDAAS.TagsGetTags(ctx)
+	req = req.Limit(250)
+	if fields != "" {
+		req = req.Fields(fields)
 	}
 
-	return true, ""
+	tags := []citrixorchestration.TagResponseModel{}
+	continuationToken := ""
+	for {
+		req = req.ContinuationToken(continuationToken)
+
+		responseModel, httpResp, err := citrixdaasclient.ExecuteWithRetry[*citrixorchestration.TagResponseModelCollection](req, client)
+		if err != nil {
+			diagnostics.AddError(
+				"Error reading tags",
+				"TransactionId: "+citrixdaasclient.GetTransactionIdFromHttpResponse(httpResp)+
+					"\nError message: "+ReadClientError(err),
+			)
+			return tags, err
+		}
+		tags = append(tags, responseModel.GetItems()...)
+
+		if responseModel.GetContinuationToken() == "" {
+			return tags, nil
+		}
+		continuationToken = responseModel.GetContinuationToken()
+	}
 }
 
 func CategorizeScopes(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, scopeResponses []citrixorchestration.ScopeResponseModel, parentObjectType citrixorchestration.ScopedObjectType, parentObjectIds []string, scopeIdsInPlan []string) ([]string, []string, []string, error) {
@@ -648,6 +914,17 @@ func BuildResourcePathForGetRequest(resourcePathInput string, resourceName strin
 	}
 }
 
+// SplitResourcePath splits a backslash separated admin folder path to a resource, such as `Folder1\Folder2\Name`,
+// into the folder path and the resource name.
+func SplitResourcePath(resourcePath string) (string, string) {
+	resourcePath = strings.Trim(resourcePath, "\\")
+	lastSeparator := strings.LastIndex(resourcePath, "\\")
+	if lastSeparator < 0 {
+		return "", resourcePath
+	}
+	return resourcePath[:lastSeparator], resourcePath[lastSeparator+1:]
+}
+
 func MoveItemsToZone(ctx context.Context, client *citrixdaasclient.CitrixDaasClient, diagnostics *diag.Diagnostics, zoneId string, items []citrixorchestration.ZonedItemRequestModel) error {
 	moveItemRequest := client.ApiClient.ZonesAPIsDAAS.ZonesMoveItemsIntoZone(ctx, zoneId)
 	moveItemRequest = moveItemRequest.ZonedItemsRequestModel(citrixorchestration.ZonedItemsRequestModel{
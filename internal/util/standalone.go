@@ -0,0 +1,42 @@
+// Copyright © 2026. Citrix Systems, Inc.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The helpers below are used when the provider binary runs outside of Terraform, such as in site export or drift report mode.
+
+// GetResourceTypeName returns the Terraform type name of a resource or list resource.
+func GetResourceTypeName(ctx context.Context, providerTypeName string, r interface {
+	Metadata(context.Context, resource.MetadataRequest, *resource.MetadataResponse)
+}) string {
+	metadataResp := &resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, metadataResp)
+	return metadataResp.TypeName
+}
+
+// GetNullAttributesObject returns an object value of objectType with every attribute set to null.
+func GetNullAttributesObject(objectType tftypes.Object) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for attributeName, attributeType := range objectType.AttributeTypes {
+		values[attributeName] = tftypes.NewValue(attributeType, nil)
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// DiagnosticsToError joins the error diagnostics into a single error.
+func DiagnosticsToError(diags diag.Diagnostics) error {
+	messages := []string{}
+	for _, d := range diags.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "\n"))
+}
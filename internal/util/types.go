@@ -230,6 +230,81 @@ func dataSourceAttributeToTerraformType(attribute datasourceSchema.Attribute) (a
 	return nil, fmt.Errorf("unsupported attribute type: %s", attribute)
 }
 
+// ResourceAttributesToDataSourceAttributes converts resource schema attributes to computed data source attributes of the same types, so that a
+// data source can expose a resource model and reuse the parsing of the resource. Descriptions and sensitivity are kept, validators and plan
+// modifiers are dropped. Will recurse if an attribute contains a nested object or list of nested objects.
+func ResourceAttributesToDataSourceAttributes(attributes map[string]resourceSchema.Attribute) map[string]datasourceSchema.Attribute {
+	dataSourceAttributes := map[string]datasourceSchema.Attribute{}
+	for name, attribute := range attributes {
+		dataSourceAttributes[name] = resourceAttributeToDataSourceAttribute(attribute)
+	}
+	return dataSourceAttributes
+}
+
+func resourceAttributeToDataSourceAttribute(attribute resourceSchema.Attribute) datasourceSchema.Attribute {
+	description := attribute.GetDescription()
+	markdownDescription := attribute.GetMarkdownDescription()
+	sensitive := attribute.IsSensitive()
+	switch attrib := attribute.(type) {
+	case resourceSchema.StringAttribute:
+		return datasourceSchema.StringAttribute{Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.BoolAttribute:
+		return datasourceSchema.BoolAttribute{Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.NumberAttribute:
+		return datasourceSchema.NumberAttribute{Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.Int64Attribute:
+		return datasourceSchema.Int64Attribute{Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.Int32Attribute:
+		return datasourceSchema.Int32Attribute{Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.Float64Attribute:
+		return datasourceSchema.Float64Attribute{Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.Float32Attribute:
+		return datasourceSchema.Float32Attribute{Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.ListAttribute:
+		return datasourceSchema.ListAttribute{ElementType: attrib.ElementType, Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.ListNestedAttribute:
+		return datasourceSchema.ListNestedAttribute{
+			NestedObject:        datasourceSchema.NestedAttributeObject{Attributes: ResourceAttributesToDataSourceAttributes(attrib.NestedObject.Attributes)},
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			Sensitive:           sensitive,
+			Computed:            true,
+		}
+	case resourceSchema.ObjectAttribute:
+		return datasourceSchema.ObjectAttribute{AttributeTypes: attrib.AttributeTypes, Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.SingleNestedAttribute:
+		return datasourceSchema.SingleNestedAttribute{
+			Attributes:          ResourceAttributesToDataSourceAttributes(attrib.Attributes),
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			Sensitive:           sensitive,
+			Computed:            true,
+		}
+	case resourceSchema.SetAttribute:
+		return datasourceSchema.SetAttribute{ElementType: attrib.ElementType, Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.SetNestedAttribute:
+		return datasourceSchema.SetNestedAttribute{
+			NestedObject:        datasourceSchema.NestedAttributeObject{Attributes: ResourceAttributesToDataSourceAttributes(attrib.NestedObject.Attributes)},
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			Sensitive:           sensitive,
+			Computed:            true,
+		}
+	case resourceSchema.MapAttribute:
+		return datasourceSchema.MapAttribute{ElementType: attrib.ElementType, Description: description, MarkdownDescription: markdownDescription, Sensitive: sensitive, Computed: true}
+	case resourceSchema.MapNestedAttribute:
+		return datasourceSchema.MapNestedAttribute{
+			NestedObject:        datasourceSchema.NestedAttributeObject{Attributes: ResourceAttributesToDataSourceAttributes(attrib.NestedObject.Attributes)},
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			Sensitive:           sensitive,
+			Computed:            true,
+		}
+	}
+	// Only reached when a resource model uses an attribute type not handled above, which is a programming error
+	panic(fmt.Sprintf("unsupported attribute type: %s", attribute))
+}
+
 // Helper function to get and cache the default object including populating nested types.List and types.Object so they aren't nil
 func defaultObjectFromObjectValue[objTyp any](ctx context.Context, v types.Object) objTyp {
 	var temp objTyp
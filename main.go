@@ -6,8 +6,12 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"strings"
 
+	"github.com/citrix/terraform-provider-citrix/internal/drift_report"
 	"github.com/citrix/terraform-provider-citrix/internal/provider"
+	"github.com/citrix/terraform-provider-citrix/internal/site_export"
 	"github.com/citrix/terraform-provider-citrix/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -26,12 +30,70 @@ var (
 
 func main() {
 	var debug bool
+	var export bool
+	var exportDir string
+	var exportResourceTypes string
+	var exportNameRegex string
+	var exportPolicyFile string
+	var exportPolicySetName string
+	var driftReport bool
+	var driftStateFile string
+	var driftReportJson string
+	var driftReportJunit string
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&export, "export", false, "set to true to export the site configured with the CITRIX_* environment variables to Terraform configuration instead of serving the provider")
+	flag.StringVar(&exportDir, "export-dir", ".", "directory the exported .tf files are written to")
+	flag.StringVar(&exportResourceTypes, "export-resource-types", "", "comma separated list of resource types to export, such as citrix_machine_catalog,citrix_delivery_group. All supported resource types are exported when empty")
+	flag.StringVar(&exportNameRegex, "export-name-regex", "", "regular expression matched against the name of the exported site objects")
+	flag.StringVar(&exportPolicyFile, "export-policy-file", "", "Citrix group policy export to translate into policy set configuration instead of exporting the site: the folder written by Export-CtxGroupPolicy, a zip archive of it, or a single CLIXML file. Citrix Studio .gpf exports are not supported")
+	flag.StringVar(&exportPolicySetName, "export-policy-set-name", "", "name of the policy set the group policies of -export-policy-file are added to. Defaults to the name of the policy export file")
+	flag.BoolVar(&driftReport, "drift-report", false, "set to true to compare the resources of a Terraform state with the live site configuration instead of serving the provider")
+	flag.StringVar(&driftStateFile, "drift-state", "terraform.tfstate", "Terraform state file to compare, as written by `terraform state pull`")
+	flag.StringVar(&driftReportJson, "drift-report-json", "drift-report.json", "path of the JSON drift report, no JSON report is written when empty")
+	flag.StringVar(&driftReportJunit, "drift-report-junit", "", "path of the JUnit drift report, no JUnit report is written when empty")
 	flag.Parse()
 
 	util.DebugMode = debug
 
+	if export {
+		options := site_export.Options{
+			OutputDir:     exportDir,
+			NameRegex:     exportNameRegex,
+			PolicyFile:    exportPolicyFile,
+			PolicySetName: exportPolicySetName,
+		}
+		for _, resourceType := range strings.Split(exportResourceTypes, ",") {
+			if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+				options.ResourceTypes = append(options.ResourceTypes, resourceType)
+			}
+		}
+
+		if err := site_export.Run(context.Background(), version, options); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
+	if driftReport {
+		report, err := drift_report.Run(context.Background(), version, drift_report.Options{
+			StateFile:       driftStateFile,
+			JsonReportFile:  driftReportJson,
+			JunitReportFile: driftReportJunit,
+		})
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		// Exit codes follow `terraform plan -detailed-exitcode` so that CI pipelines can gate on drift
+		if report.HasErrors() {
+			os.Exit(1)
+		}
+		if report.DriftDetected {
+			os.Exit(2)
+		}
+		return
+	}
+
 	err := providerserver.Serve(context.Background(), provider.New(version), providerserver.ServeOpts{
 		Address: "registry.terraform.io/citrix/citrix",
 		Debug:   debug,